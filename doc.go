@@ -0,0 +1,2 @@
+// Package methods implements numerical methods over GoCalculate values, vectors and matrices
+package methods
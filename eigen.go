@@ -0,0 +1,498 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// RayleighQuotient returns x* A x / x* x, the eigenvalue estimate that best fits the approximate eigenvector x
+func RayleighQuotient(A m.Matrix, x v.Vector) (gcv.Value, error) {
+	if err := validateEigenInput(A, x); err != nil {
+		return nil, err
+	}
+
+	return gcvops.Div(innerProduct(x, multiply(A, x)), innerProduct(x, x)), nil
+}
+
+// PowerIteration returns the eigenvalue of largest magnitude of A and a unit eigenvector starting from x0,
+// the eigenvalue is estimated by the rayleigh quotient and the iteration stops once the residual |A x - lambda x| < TOL
+// Algorithm from Numerical Analysis - By Burden and Faires
+func PowerIteration(A m.Matrix, x0 v.Vector, TOL float64, maxIteration int) (gcv.Value, v.Vector, error) {
+	if err := validateEigenIteration(A, x0, TOL, maxIteration); err != nil {
+		return nil, nil, err
+	}
+
+	x := normalize(x0)
+	for i := 0; i < maxIteration; i++ {
+		y := multiply(A, x)
+		eigenvalue := innerProduct(x, y)
+		if eigenResidual(y, x, eigenvalue) < TOL {
+			return eigenvalue, x, nil
+		}
+
+		if norm(y) == 0 {
+			break
+		}
+		x = normalize(y)
+	}
+
+	return nil, nil, &IterationError{Method: "PowerIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// InverseIteration returns the eigenvalue of A nearest to shift and a unit eigenvector starting from x0,
+// A - shift I is factorized once and the iteration stops once the residual |A x - lambda x| < TOL,
+// a shift that is an eigenvalue is moved by the machine epsilon so that the factorization exists
+// Algorithm from Numerical Analysis - By Burden and Faires
+func InverseIteration(A m.Matrix, shift gcv.Value, x0 v.Vector, TOL float64, maxIteration int) (gcv.Value, v.Vector, error) {
+	if err := validateEigenIteration(A, x0, TOL, maxIteration); err != nil {
+		return nil, nil, err
+	}
+
+	L, U, P, _, err := LUPivot(shifted(A, shift), PartialPivoting)
+	if err != nil {
+		shift = gcvops.Add(shift, gcv.MakeValue(machineEpsilon*math.Max(1, maxNorm(A))))
+		if L, U, P, _, err = LUPivot(shifted(A, shift), PartialPivoting); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	x := normalize(x0)
+	for i := 0; i < maxIteration; i++ {
+		y, err := SolveLU(L, U, P, x)
+		if err != nil {
+			return nil, nil, err
+		}
+		x = normalize(y)
+
+		product := multiply(A, x)
+		eigenvalue := innerProduct(x, product)
+		if eigenResidual(product, x, eigenvalue) < TOL {
+			return eigenvalue, x, nil
+		}
+	}
+
+	return nil, nil, &IterationError{Method: "InverseIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// RayleighQuotientIteration refines the approximate eigenvector x0 of A with inverse iteration shifted by the rayleigh quotient
+// of each iterate, it converges cubically for hermitian matrices and stops once the residual |A x - lambda x| < TOL,
+// a rayleigh quotient that is an eigenvalue is moved by the machine epsilon so that the step can be taken
+func RayleighQuotientIteration(A m.Matrix, x0 v.Vector, TOL float64, maxIteration int) (gcv.Value, v.Vector, error) {
+	if err := validateEigenIteration(A, x0, TOL, maxIteration); err != nil {
+		return nil, nil, err
+	}
+
+	x := normalize(x0)
+	for i := 0; i < maxIteration; i++ {
+		product := multiply(A, x)
+		eigenvalue := innerProduct(x, product)
+		if eigenResidual(product, x, eigenvalue) < TOL {
+			return eigenvalue, x, nil
+		}
+
+		y, err := Solve(shifted(A, eigenvalue), x)
+		if errors.Is(err, ErrSingular) {
+			// the rayleigh quotient is an eigenvalue but x need not be its eigenvector,
+			// the shift is moved by the machine epsilon as in InverseIteration to take the step towards it
+			y, err = Solve(shifted(A, gcvops.Add(eigenvalue, gcv.MakeValue(machineEpsilon*math.Max(1, maxNorm(A))))), x)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		x = normalize(y)
+	}
+
+	return nil, nil, &IterationError{Method: "RayleighQuotientIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// Hessenberg will return the reduction A = Q H Q* of the square matrix A to the upper hessenberg matrix H by householder
+// reflections, where Q is unitary, H is tridiagonal if A is hermitian, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func Hessenberg(A m.Matrix) (Q, H m.Matrix, err error) {
+	if !A.IsSquare() {
+		return nil, nil, ErrNotSquare
+	}
+
+	h := toComplex(A)
+	q := hessenberg(h)
+	return fromComplex(q), fromComplex(h), nil
+}
+
+// SymmetricEigen returns the real eigenvalues of the hermitian matrix A in increasing order and the unitary matrix
+// whose columns are the matching eigenvectors, A is reduced to a real tridiagonal matrix and diagonalized by the
+// implicit symmetric QR algorithm with wilkinson shifts, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func SymmetricEigen(A m.Matrix) (eigenvalues v.Vector, eigenvectors m.Matrix, err error) {
+	if err := validateHermitian(A); err != nil {
+		return nil, nil, err
+	}
+
+	degree, _ := A.Dim()
+	h := toComplex(A)
+	z := hessenberg(h)
+
+	// the unitary diagonal scaling D makes the off diagonal of D* H D real and non-negative
+	diagonal := make([]float64, degree)
+	offDiagonal := make([]float64, degree)
+	phase := complex(1, 0)
+	for i := 0; i < degree; i++ {
+		diagonal[i] = real(h[i][i])
+		if i > 0 {
+			if magnitude := cmplx.Abs(h[i][i-1]); magnitude != 0 {
+				phase *= h[i][i-1] / complex(magnitude, 0)
+				offDiagonal[i-1] = magnitude
+			}
+			for row := range z {
+				z[row][i] *= phase
+			}
+		}
+	}
+
+	if err := tridiagonalQR(diagonal, offDiagonal, z); err != nil {
+		return nil, nil, err
+	}
+
+	order := make([]int, degree)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return diagonal[order[i]] < diagonal[order[j]] })
+
+	eigenvalues = v.NewVector(v.ColSpace, degree)
+	vectors := make([][]complex128, degree)
+	for i := range vectors {
+		vectors[i] = make([]complex128, degree)
+	}
+	for j, k := range order {
+		eigenvalues.Set(j, diagonal[k])
+		for i := 0; i < degree; i++ {
+			vectors[i][j] = z[i][k]
+		}
+	}
+
+	return eigenvalues, fromComplex(vectors), nil
+}
+
+// Eigen returns the eigenvalues of the real or complex square matrix A and the matrix whose columns are the matching unit
+// eigenvectors, A is reduced to hessenberg form and then to the complex schur form A = Z T Z* by the shifted QR algorithm
+// with wilkinson shifts, the eigenvalues are the diagonal of T in the order they are found and the eigenvectors are
+// found by back substitution in T, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func Eigen(A m.Matrix) (eigenvalues v.Vector, eigenvectors m.Matrix, err error) {
+	if !A.IsSquare() {
+		return nil, nil, ErrNotSquare
+	}
+
+	degree, _ := A.Dim()
+	t := toComplex(A)
+	z := hessenberg(t)
+	if err := schur(t, z); err != nil {
+		return nil, nil, err
+	}
+
+	// a repeated eigenvalue is separated by a tiny multiple of the norm of T to keep the back substitution finite
+	var largest float64
+	for i := range t {
+		for j := i; j < degree; j++ {
+			largest = math.Max(largest, cmplx.Abs(t[i][j]))
+		}
+	}
+	smallest := machineEpsilon * math.Max(largest, math.SmallestNonzeroFloat64)
+
+	values := make([]complex128, degree)
+	vectors := make([][]complex128, degree)
+	for i := range vectors {
+		vectors[i] = make([]complex128, degree)
+	}
+	for k := 0; k < degree; k++ {
+		values[k] = t[k][k]
+
+		y := make([]complex128, k+1)
+		y[k] = 1
+		for i := k - 1; i >= 0; i-- {
+			var sum complex128
+			for j := i + 1; j <= k; j++ {
+				sum += t[i][j] * y[j]
+			}
+			difference := t[i][i] - t[k][k]
+			if cmplx.Abs(difference) < smallest {
+				difference = complex(smallest, 0)
+			}
+			y[i] = -sum / difference
+		}
+
+		x := make([]complex128, degree)
+		for i := range x {
+			for j, entry := range y {
+				x[i] += z[i][j] * entry
+			}
+		}
+
+		size := vectorNorm(x)
+		for i, entry := range x {
+			vectors[i][k] = entry / complex(size, 0)
+		}
+	}
+
+	return fromComplexVector(values), fromComplex(vectors), nil
+}
+
+// hessenberg reduces h in place to upper hessenberg form by householder reflections and returns the unitary q
+// with the original h = q h q*
+func hessenberg(h [][]complex128) [][]complex128 {
+	degree := len(h)
+	q := identity(degree)
+	for k := 0; k < degree-2; k++ {
+		reflector, scale, value := householderReflector(h, k+1, k)
+		if reflector == nil {
+			continue
+		}
+
+		reflectRows(h, reflector, scale, k+1, k)
+		reflectColumns(h, reflector, scale, k+1)
+		reflectColumns(q, reflector, scale, k+1)
+
+		h[k+1][k] = value
+		for i := k + 2; i < degree; i++ {
+			h[i][k] = 0
+		}
+	}
+	return q
+}
+
+// tridiagonalQR diagonalizes the real symmetric tridiagonal matrix with the given diagonal and off diagonal in place
+// by implicit QR steps with wilkinson shifts, accumulating the rotations into the columns of z
+func tridiagonalQR(diagonal, offDiagonal []float64, z [][]complex128) error {
+	degree := len(diagonal)
+	negligible := func(i int) bool {
+		return math.Abs(offDiagonal[i]) <= machineEpsilon*(math.Abs(diagonal[i])+math.Abs(diagonal[i+1]))
+	}
+
+	iterations := 0
+	for upper := degree - 1; upper > 0; {
+		if negligible(upper - 1) {
+			offDiagonal[upper-1] = 0
+			upper--
+			continue
+		}
+
+		lower := upper - 1
+		for lower > 0 && !negligible(lower-1) {
+			lower--
+		}
+		if lower > 0 {
+			offDiagonal[lower-1] = 0
+		}
+
+		if iterations == 30*degree {
+			return &IterationError{Method: "SymmetricEigen", Iterations: iterations, Err: ErrMaxIterations}
+		}
+		iterations++
+
+		// the wilkinson shift is the eigenvalue of the trailing 2 x 2 block nearer to its last diagonal entry
+		delta := (diagonal[upper-1] - diagonal[upper]) / 2
+		sign := 1.0
+		if delta < 0 {
+			sign = -1
+		}
+		coupling := offDiagonal[upper-1]
+		shift := diagonal[upper] - coupling*coupling/(delta+sign*math.Hypot(delta, coupling))
+
+		x, y := diagonal[lower]-shift, offDiagonal[lower]
+		var bulge float64
+		for k := lower; k < upper; k++ {
+			if k > lower {
+				x, y = offDiagonal[k-1], bulge
+			}
+
+			radius := math.Hypot(x, y)
+			c, s := 1.0, 0.0
+			if radius != 0 {
+				c, s = x/radius, y/radius
+			}
+			if k > lower {
+				offDiagonal[k-1] = radius
+			}
+
+			a, b, d := diagonal[k], offDiagonal[k], diagonal[k+1]
+			diagonal[k] = c*c*a + 2*c*s*b + s*s*d
+			diagonal[k+1] = s*s*a - 2*c*s*b + c*c*d
+			offDiagonal[k] = c*s*(d-a) + (c*c-s*s)*b
+
+			if k < upper-1 {
+				bulge = s * offDiagonal[k+1]
+				offDiagonal[k+1] *= c
+			}
+
+			for row := range z {
+				z[row][k], z[row][k+1] = complex(c, 0)*z[row][k]+complex(s, 0)*z[row][k+1], complex(-s, 0)*z[row][k]+complex(c, 0)*z[row][k+1]
+			}
+		}
+	}
+
+	return nil
+}
+
+// schur reduces the upper hessenberg h in place to upper triangular form by single shifted QR steps
+// with wilkinson shifts, accumulating the rotations into the columns of z
+func schur(h, z [][]complex128) error {
+	degree := len(h)
+	iterations, stalled := 0, 0
+	for upper := degree - 1; upper > 0; {
+		lower := upper
+		for lower > 0 {
+			if cmplx.Abs(h[lower][lower-1]) <= machineEpsilon*(cmplx.Abs(h[lower-1][lower-1])+cmplx.Abs(h[lower][lower])) {
+				h[lower][lower-1] = 0
+				break
+			}
+			lower--
+		}
+
+		if lower == upper {
+			upper--
+			stalled = 0
+			continue
+		}
+
+		if iterations == 30*degree {
+			return &IterationError{Method: "Eigen", Iterations: iterations, Err: ErrMaxIterations}
+		}
+		iterations++
+		stalled++
+
+		var shift complex128
+		if stalled%10 == 0 {
+			// an exceptional shift breaks the cycles an unlucky shift can fall into
+			shift = h[upper][upper] + complex(0.75*math.Abs(real(h[upper][upper-1])), 0)
+		} else {
+			a, b, c, d := h[upper-1][upper-1], h[upper-1][upper], h[upper][upper-1], h[upper][upper]
+			root := cmplx.Sqrt((a-d)*(a-d)/4 + b*c)
+			shift = (a+d)/2 + root
+			if cmplx.Abs(shift-d) > cmplx.Abs((a+d)/2-root-d) {
+				shift = (a+d)/2 - root
+			}
+		}
+
+		x, y := h[lower][lower]-shift, h[lower+1][lower]
+		for k := lower; k < upper; k++ {
+			if k > lower {
+				x, y = h[k][k-1], h[k+1][k-1]
+			}
+
+			c, s := givensCoefficients(x, y)
+			column := lower
+			if k > lower {
+				column = k - 1
+			}
+			rotateRows(h, c, s, k, k+1, column)
+			if k > lower {
+				h[k+1][k-1] = 0
+			}
+
+			end := k + 3
+			if end > upper+1 {
+				end = upper + 1
+			}
+			rotateColumns(h, c, s, k, k+1, 0, end)
+			rotateColumns(z, c, s, k, k+1, 0, degree)
+		}
+	}
+
+	return nil
+}
+
+// validateEigenInput checks that A is square and that x has a matching length
+func validateEigenInput(A m.Matrix, x v.Vector) error {
+	if !A.IsSquare() {
+		return ErrNotSquare
+	}
+
+	if degree, _ := A.Dim(); x.Len() != degree {
+		return &DimensionError{Name: "x0", Expected: degree, Received: x.Len()}
+	}
+
+	return nil
+}
+
+// validateEigenIteration checks the inputs of the vector iterations, x0 must not be zero
+func validateEigenIteration(A m.Matrix, x0 v.Vector, TOL float64, maxIteration int) error {
+	if err := validateEigenInput(A, x0); err != nil {
+		return err
+	}
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return err
+	}
+
+	if norm(x0) == 0 {
+		return &ArgumentError{Name: "x0", Value: x0, Reason: "must not be zero"}
+	}
+
+	return nil
+}
+
+// innerProduct returns x* y
+func innerProduct(x, y v.Vector) gcv.Value {
+	sum := gcv.Zero()
+	for i := 0; i < x.Len(); i++ {
+		sum = gcvops.Add(sum, gcvops.Mult(conjugate(x.Get(i)), y.Get(i)))
+	}
+	return sum
+}
+
+// norm returns the euclidean norm of x
+func norm(x v.Vector) float64 {
+	var size float64
+	for i := 0; i < x.Len(); i++ {
+		size = math.Hypot(size, cmplx.Abs(x.Get(i).Complex()))
+	}
+	return size
+}
+
+// normalize returns x divided by its euclidean norm
+func normalize(x v.Vector) v.Vector {
+	size := gcv.MakeValue(norm(x))
+	unit := v.NewVector(v.ColSpace, x.Len())
+	for i := 0; i < x.Len(); i++ {
+		unit.Set(i, gcvops.Div(x.Get(i), size))
+	}
+	return unit
+}
+
+// eigenResidual returns |product - eigenvalue x| where product is A x
+func eigenResidual(product, x v.Vector, eigenvalue gcv.Value) float64 {
+	residual := v.NewVector(v.ColSpace, x.Len())
+	for i := 0; i < x.Len(); i++ {
+		residual.Set(i, gcvops.Sub(product.Get(i), gcvops.Mult(eigenvalue, x.Get(i))))
+	}
+	return norm(residual)
+}
+
+// shifted returns A - shift I
+func shifted(A m.Matrix, shift gcv.Value) m.Matrix {
+	degree, _ := A.Dim()
+	B := A.Copy()
+	for i := 0; i < degree; i++ {
+		B.Set(i, i, gcvops.Sub(A.Get(i, i), shift))
+	}
+	return B
+}
+
+// maxNorm returns the largest magnitude of an entry of A
+func maxNorm(A m.Matrix) float64 {
+	rows, cols := A.Dim()
+	var largest float64
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			largest = math.Max(largest, cmplx.Abs(A.Get(i, j).Complex()))
+		}
+	}
+	return largest
+}
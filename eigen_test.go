@@ -0,0 +1,417 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// eigenpairsResidual returns the largest |A x - lambda x| over the eigenvalues and the columns of eigenvectors
+func eigenpairsResidual(A m.Matrix, eigenvalues v.Vector, eigenvectors m.Matrix) float64 {
+	degree, _ := A.Dim()
+	var largest float64
+	for k := 0; k < eigenvalues.Len(); k++ {
+		x := v.NewVector(v.ColSpace, degree)
+		for i := 0; i < degree; i++ {
+			x.Set(i, eigenvectors.Get(i, k))
+		}
+		largest = math.Max(largest, eigenResidual(multiply(A, x), x, eigenvalues.Get(k)))
+	}
+	return largest
+}
+
+// sortedEigenvalues returns the eigenvalues ordered by real and then imaginary part
+func sortedEigenvalues(eigenvalues v.Vector) v.Vector {
+	values := make([]complex128, eigenvalues.Len())
+	for i := range values {
+		values[i] = eigenvalues.Get(i).Complex()
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if math.Abs(real(values[i])-real(values[j])) > 1e-9 {
+			return real(values[i]) < real(values[j])
+		}
+		return imag(values[i]) < imag(values[j])
+	})
+
+	sorted := v.NewVector(v.ColSpace, len(values))
+	for i, value := range values {
+		sorted.Set(i, value)
+	}
+	return sorted
+}
+
+func TestRayleighQuotient(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 2, 1),
+		v.MakeVector(v.RowSpace, 1, 2)))
+
+	if quotient, err := RayleighQuotient(A, v.MakeVector(v.RowSpace, 1, 1)); err != nil || quotient.Complex() != 3 {
+		t.Errorf("Expected 3, received %v and %v", quotient, err)
+	}
+
+	if quotient, err := RayleighQuotient(A, v.MakeVector(v.RowSpace, 1, 0)); err != nil || quotient.Complex() != 2 {
+		t.Errorf("Expected 2, received %v and %v", quotient, err)
+	}
+
+	_, errA := RayleighQuotient(A, v.MakeVector(v.RowSpace, 1, 0, 0))
+
+	if !errors.Is(errA, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errA)
+	}
+}
+
+func TestPowerIteration(t *testing.T) {
+	// the eigenvalues are 6, 3 and 1
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 3, -2),
+		v.MakeVector(v.RowSpace, 1, -2, 3)))
+
+	eigenvalue, eigenvector, errA := PowerIteration(A, v.MakeVector(v.RowSpace, 1, 0, 0), 1e-10, 200)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if math.Abs(eigenvalue.Real()-6) > 1e-10 {
+		t.Errorf("Expected 6, received %v", eigenvalue)
+	}
+
+	if math.Abs(norm(eigenvector)-1) > 1e-12 || eigenResidual(multiply(A, eigenvector), eigenvector, eigenvalue) > 1e-10 {
+		t.Errorf("Expected a unit eigenvector, received %v", eigenvector)
+	}
+
+	// a dominant negative eigenvalue flips the sign of every iterate
+	negative := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, -5, 1i),
+		v.MakeVector(v.RowSpace, -1i, 1)))
+
+	if eigenvalue, _, err := PowerIteration(negative, v.MakeVector(v.RowSpace, 1, 1), 1e-10, 200); err != nil || math.Abs(eigenvalue.Real()-(-2-math.Sqrt(10))) > 1e-10 {
+		t.Errorf("Expected %v, received %v and %v", -2-math.Sqrt(10), eigenvalue, err)
+	}
+
+	// the eigenvalues 1 and -1 have the same magnitude
+	swap := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 0, 1),
+		v.MakeVector(v.RowSpace, 1, 0)))
+
+	_, _, errB := PowerIteration(swap, v.MakeVector(v.RowSpace, 1, 0), 1e-10, 50)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	_, _, errC := PowerIteration(A, v.MakeVector(v.RowSpace, 0, 0, 0), 1e-10, 50)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+
+	_, _, errD := PowerIteration(m.NewMatrix(2, 3), v.MakeVector(v.RowSpace, 1, 0), 1e-10, 50)
+
+	if !errors.Is(errD, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errD)
+	}
+}
+
+func TestInverseIteration(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 3, -2),
+		v.MakeVector(v.RowSpace, 1, -2, 3)))
+
+	for _, expected := range []float64{1, 3, 6} {
+		shift := gcv.MakeValue(expected + 0.4)
+		eigenvalue, eigenvector, err := InverseIteration(A, shift, v.MakeVector(v.RowSpace, 1, 1, 1), 1e-10, 100)
+
+		if err != nil || math.Abs(eigenvalue.Real()-expected) > 1e-10 {
+			t.Errorf("Expected %v, received %v and %v", expected, eigenvalue, err)
+		}
+
+		if eigenResidual(multiply(A, eigenvector), eigenvector, eigenvalue) > 1e-10 {
+			t.Errorf("Expected an eigenvector of %v, received %v", expected, eigenvector)
+		}
+	}
+
+	// a shift that is an eigenvalue makes A - shift I singular
+	if eigenvalue, _, err := InverseIteration(A, gcv.MakeValue(3), v.MakeVector(v.RowSpace, 1, 1, 1), 1e-10, 100); err != nil || math.Abs(eigenvalue.Real()-3) > 1e-10 {
+		t.Errorf("Expected 3, received %v and %v", eigenvalue, err)
+	}
+
+	// the eigenvalues of a rotation are i and -i
+	rotation := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 0, -1),
+		v.MakeVector(v.RowSpace, 1, 0)))
+
+	if eigenvalue, _, err := InverseIteration(rotation, gcv.MakeValue(0.5i), v.MakeVector(v.RowSpace, 1, 0), 1e-10, 100); err != nil || cmplx.Abs(eigenvalue.Complex()-1i) > 1e-10 {
+		t.Errorf("Expected i, received %v and %v", eigenvalue, err)
+	}
+
+	_, _, errA := InverseIteration(A, gcv.MakeValue(1.2), v.MakeVector(v.RowSpace, 1, 1, 1), 0, 100)
+
+	if !errors.Is(errA, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errA)
+	}
+}
+
+func TestRayleighQuotientIteration(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 3, -2),
+		v.MakeVector(v.RowSpace, 1, -2, 3)))
+
+	// the eigenvector of 6 is (1, -1, 1)
+	eigenvalue, eigenvector, errA := RayleighQuotientIteration(A, v.MakeVector(v.RowSpace, 1, -0.9, 1.1), 1e-12, 10)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if math.Abs(eigenvalue.Real()-6) > 1e-12 {
+		t.Errorf("Expected 6, received %v", eigenvalue)
+	}
+
+	if eigenResidual(multiply(A, eigenvector), eigenvector, eigenvalue) > 1e-12 {
+		t.Errorf("Expected an eigenvector of 6, received %v", eigenvector)
+	}
+
+	// an exact eigenvector is returned at once
+	if eigenvalue, _, err := RayleighQuotientIteration(A, v.MakeVector(v.RowSpace, 1, -1, 1), 1e-12, 1); err != nil || math.Abs(eigenvalue.Real()-6) > 1e-12 {
+		t.Errorf("Expected 6, received %v and %v", eigenvalue, err)
+	}
+
+	// the rayleigh quotient 0 of (1, 0.5, 1) is an eigenvalue of diag(-1, 0, 1) that (1, 0.5, 1) is not an eigenvector of
+	D := diagonal(3, 3, []float64{-1, 0, 1})
+	zero, unit, errC := RayleighQuotientIteration(D, v.MakeVector(v.RowSpace, 1, 0.5, 1), 1e-12, 10)
+
+	if errC != nil {
+		t.Errorf("Unexpected error: %v", errC)
+	}
+
+	if math.Abs(zero.Real()) > 1e-12 || eigenResidual(multiply(D, unit), unit, zero) > 1e-12 {
+		t.Errorf("Expected the eigenvalue 0 and an eigenvector of it, received %v and %v", zero, unit)
+	}
+
+	// without a component along the eigenvector of 0 the singular shift is no eigenpair
+	_, _, errD := RayleighQuotientIteration(D, v.MakeVector(v.RowSpace, 1, 0, 1), 1e-12, 10)
+
+	if !errors.Is(errD, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errD)
+	}
+
+	_, _, errB := RayleighQuotientIteration(A, v.MakeVector(v.RowSpace, 1, -0.9, 1.1), 1e-12, 0)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestHessenberg(t *testing.T) {
+	example := [][]complex128{{4, 1, -2, 2}, {1i, 2, 0, 1}, {-2, 0, 3 + 1i, -2}, {2, 1, -2, -1}}
+	A := fromComplex(example)
+
+	Q, H, errA := Hessenberg(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	for i := 2; i < 4; i++ {
+		for j := 0; j < i-1; j++ {
+			if H.Get(i, j).Complex() != 0 {
+				t.Errorf("Expected H to be upper hessenberg, received %v", entries(H))
+			}
+		}
+	}
+
+	if product := mops.MustMultSimple(conjugateTranspose(Q), Q); !matrixApproxEqual([][]complex128{{1}, {0, 1}, {0, 0, 1}, {0, 0, 0, 1}}, product, 1e-12) {
+		t.Errorf("Expected a unitary Q, received Q* Q = %v", entries(product))
+	}
+
+	if product := mops.MustMultSimple(mops.MustMultSimple(Q, H), conjugateTranspose(Q)); !matrixApproxEqual(example, product, 1e-12) {
+		t.Errorf("Expected Q H Q* = %v, received %v", example, entries(product))
+	}
+
+	// a symmetric matrix is reduced to a tridiagonal one
+	_, T, _ := Hessenberg(fromComplex([][]complex128{{4, 1, -2, 2}, {1, 2, 0, 1}, {-2, 0, 3, -2}, {2, 1, -2, -1}}))
+
+	for i := 0; i < 4; i++ {
+		for j := i + 2; j < 4; j++ {
+			if cmplx.Abs(T.Get(i, j).Complex()) > 1e-12 {
+				t.Errorf("Expected T to be tridiagonal, received %v", entries(T))
+			}
+		}
+	}
+
+	_, _, errB := Hessenberg(m.NewMatrix(2, 3))
+
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
+	}
+}
+
+func TestSymmetricEigen(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 3, -2),
+		v.MakeVector(v.RowSpace, 1, -2, 3)))
+
+	eigenvalues, eigenvectors, errA := SymmetricEigen(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1, 3, 6}, eigenvalues, 1e-12) {
+		t.Errorf("Expected [1 3 6], received %v", eigenvalues)
+	}
+
+	if residual := eigenpairsResidual(A, eigenvalues, eigenvectors); residual > 1e-12 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	if product := mops.MustMultSimple(conjugateTranspose(eigenvectors), eigenvectors); !matrixApproxEqual([][]complex128{{1}, {0, 1}, {0, 0, 1}}, product, 1e-12) {
+		t.Errorf("Expected orthonormal eigenvectors, received %v", entries(product))
+	}
+
+	// the eigenvalues of a complex hermitian matrix are real
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 2, 1-1i, 0),
+		v.MakeVector(v.RowSpace, 1+1i, 3, 1i),
+		v.MakeVector(v.RowSpace, 0, -1i, 1)))
+
+	complexEigenvalues, complexEigenvectors, errB := SymmetricEigen(complexA)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if residual := eigenpairsResidual(complexA, complexEigenvalues, complexEigenvectors); residual > 1e-12 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	for i := 1; i < 3; i++ {
+		if complexEigenvalues.Get(i).Real() < complexEigenvalues.Get(i-1).Real() {
+			t.Errorf("Expected increasing eigenvalues, received %v", complexEigenvalues)
+		}
+	}
+
+	// the eigenvalues of the second difference matrix are 2 - 2 cos(k pi / (n + 1))
+	degree := 12
+	difference := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		difference.Set(i, i, 2)
+		if i > 0 {
+			difference.Set(i, i-1, -1)
+			difference.Set(i-1, i, -1)
+		}
+	}
+
+	differenceEigenvalues, differenceEigenvectors, _ := SymmetricEigen(difference)
+	for k := 0; k < degree; k++ {
+		expected := 2 - 2*math.Cos(float64(k+1)*math.Pi/float64(degree+1))
+		if math.Abs(differenceEigenvalues.Get(k).Real()-expected) > 1e-12 {
+			t.Errorf("Expected %v, received %v", expected, differenceEigenvalues.Get(k))
+		}
+	}
+
+	if residual := eigenpairsResidual(difference, differenceEigenvalues, differenceEigenvectors); residual > 1e-12 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	_, _, errC := SymmetricEigen(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 3, 4))))
+
+	if !errors.Is(errC, ErrNotHermitian) {
+		t.Errorf("Expected %v, received %v", ErrNotHermitian, errC)
+	}
+}
+
+func TestEigen(t *testing.T) {
+	// the companion matrix of (x - 1)(x - 2)(x - 3)
+	companion := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 6, -11, 6),
+		v.MakeVector(v.RowSpace, 1, 0, 0),
+		v.MakeVector(v.RowSpace, 0, 1, 0)))
+
+	eigenvalues, eigenvectors, errA := Eigen(companion)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if values := sortedEigenvalues(eigenvalues); !vectorApproxEqual([]complex128{1, 2, 3}, values, 1e-10) {
+		t.Errorf("Expected [1 2 3], received %v", values)
+	}
+
+	if residual := eigenpairsResidual(companion, eigenvalues, eigenvectors); residual > 1e-10 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	// a real matrix with the complex eigenvalues 1 + 2i and 1 - 2i
+	realA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, -2, 0),
+		v.MakeVector(v.RowSpace, 2, 1, 0),
+		v.MakeVector(v.RowSpace, 3, -1, 4)))
+
+	realEigenvalues, realEigenvectors, errB := Eigen(realA)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if values := sortedEigenvalues(realEigenvalues); !vectorApproxEqual([]complex128{1 - 2i, 1 + 2i, 4}, values, 1e-12) {
+		t.Errorf("Expected [1-2i 1+2i 4], received %v", values)
+	}
+
+	if residual := eigenpairsResidual(realA, realEigenvalues, realEigenvectors); residual > 1e-12 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	complexA := fromComplex([][]complex128{
+		{1 + 1i, 2, 0, -1i},
+		{0.5, -1i, 3, 1},
+		{2i, 1, 2, 0.5},
+		{1, 0, -1 + 1i, 4}})
+
+	complexEigenvalues, complexEigenvectors, errC := Eigen(complexA)
+
+	if errC != nil {
+		t.Errorf("Unexpected error: %v", errC)
+	}
+
+	if residual := eigenpairsResidual(complexA, complexEigenvalues, complexEigenvectors); residual > 1e-12 {
+		t.Errorf("Expected eigenpairs, received a residual of %v", residual)
+	}
+
+	var trace complex128
+	for k := 0; k < 4; k++ {
+		trace += complexEigenvalues.Get(k).Complex()
+	}
+	if cmplx.Abs(trace-(7+0i)) > 1e-12 {
+		t.Errorf("Expected the eigenvalues to sum to the trace 7, received %v", trace)
+	}
+
+	// a defective matrix still gets finite eigenvectors
+	defective := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 0, 1)))
+
+	if defectiveEigenvalues, defectiveEigenvectors, err := Eigen(defective); err != nil || !vectorApproxEqual([]complex128{1, 1}, defectiveEigenvalues, 1e-12) || eigenpairsResidual(defective, defectiveEigenvalues, defectiveEigenvectors) > 1e-12 {
+		t.Errorf("Expected [1 1], received %v and %v", defectiveEigenvalues, err)
+	}
+
+	_, _, errD := Eigen(m.NewMatrix(3, 2))
+
+	if !errors.Is(errD, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errD)
+	}
+}
@@ -21,3 +21,25 @@ func evalV(f *gcf.Function, inputs ...interface{}) (gcv.Value, error) {
 
 	return solution.Value(), nil
 }
+
+// realEvaluator evaluates a gcf function to the real part of its gcv Value and keeps the first error,
+// so formulas with many evaluations only need to check for an error once
+type realEvaluator struct {
+	f   *gcf.Function
+	err error
+}
+
+// eval returns the real part of f evaluated at inputs, or 0 once an error has occurred
+func (e *realEvaluator) eval(inputs ...interface{}) float64 {
+	if e.err != nil {
+		return 0
+	}
+
+	solution, err := evalV(e.f, inputs...)
+	if err != nil {
+		e.err = err
+		return 0
+	}
+
+	return solution.Real()
+}
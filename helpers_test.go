@@ -18,3 +18,23 @@ func TestEvalV(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestRealEvaluator(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	regVars := []gcfargs.Var{x}
+	testFunction := gcf.MakeFuncPanic(regVars, x, "^", 2)
+
+	evaluator := &realEvaluator{f: testFunction}
+	if result := evaluator.eval(3.0); result != 9 || evaluator.err != nil {
+		t.Errorf("Expected 9, received %v with error %v", result, evaluator.err)
+	}
+
+	if result := evaluator.eval(3.0, 4.0); result != 0 || evaluator.err == nil {
+		t.Errorf("Expected 0 with error, received %v", result)
+	}
+
+	firstErr := evaluator.err
+	if result := evaluator.eval(3.0); result != 0 || evaluator.err != firstErr {
+		t.Errorf("Expected evaluator to keep first error, received %v and %v", result, evaluator.err)
+	}
+}
@@ -1,7 +1,6 @@
 package methods
 
 import (
-	"errors"
 	"math"
 
 	gcf "github.com/NumberXNumbers/types/gc/functions"
@@ -11,69 +10,127 @@ import (
 )
 
 // Euler1D is for solving the numerical integration 1D euler method
-func Euler1D(a float64, b float64, N int, initValue float64, f *gcf.Function) gcv.Value {
+func Euler1D(a float64, b float64, N int, initValue float64, f *gcf.Function) (gcv.Value, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	h := (b - a) / float64(N)
 	x := a
 	omega := initValue
 
 	for i := 0; i < N; i++ {
-		omega += h * f.MustEval(x, omega).Value().Real()
+		omega += h * evaluator.eval(x, omega)
 		x += h
 	}
 
-	return gcv.MakeValue(omega)
+	if evaluator.err != nil {
+		return nil, evaluator.err
+	}
+
+	return gcv.MakeValue(omega), nil
 }
 
 // TrapezoidRule is for solving the numerical integration using the trapezoid rule
-func TrapezoidRule(a float64, b float64, f *gcf.Function) gcv.Value {
+func TrapezoidRule(a float64, b float64, f *gcf.Function) (gcv.Value, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	var omega float64
 	h := (b - a)
 	x := a
 
-	omega = f.MustEval(x+h).Value().Real() + f.MustEval(x).Value().Real()
+	omega = evaluator.eval(x+h) + evaluator.eval(x)
+
+	if evaluator.err != nil {
+		return nil, evaluator.err
+	}
 
-	return gcv.MakeValue(h / 2 * omega)
+	return gcv.MakeValue(h / 2 * omega), nil
 }
 
 // SimpsonRule for solving numerical integration
-func SimpsonRule(a float64, b float64, f *gcf.Function) gcv.Value {
+func SimpsonRule(a float64, b float64, f *gcf.Function) (gcv.Value, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	var omega float64
 	h := (b - a) / 2
 	x := a
 
-	omega = f.MustEval(x).Value().Real() + 4*f.MustEval(x+h).Value().Real() + f.MustEval(x+2*h).Value().Real()
+	omega = evaluator.eval(x) + 4*evaluator.eval(x+h) + evaluator.eval(x+2*h)
+
+	if evaluator.err != nil {
+		return nil, evaluator.err
+	}
 
-	return gcv.MakeValue(h / 3 * omega)
+	return gcv.MakeValue(h / 3 * omega), nil
 }
 
 // Simpson38Rule is Simpson's 3/8ths rule for solving numerical integration
-func Simpson38Rule(a float64, b float64, f *gcf.Function) gcv.Value {
+func Simpson38Rule(a float64, b float64, f *gcf.Function) (gcv.Value, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	var omega float64
 	h := (b - a) / 3
 	x := a
 
-	omega = f.MustEval(x).Value().Real() + 3*f.MustEval(x+h).Value().Real() + 3*f.MustEval(x+2*h).Value().Real() + f.MustEval(x+3*h).Value().Real()
+	omega = evaluator.eval(x) + 3*evaluator.eval(x+h) + 3*evaluator.eval(x+2*h) + evaluator.eval(x+3*h)
+
+	if evaluator.err != nil {
+		return nil, evaluator.err
+	}
 
-	return gcv.MakeValue(3 * h / 8 * omega)
+	return gcv.MakeValue(3 * h / 8 * omega), nil
 }
 
 // BooleRule is Boole's rule for solving numerical integration
-func BooleRule(a float64, b float64, f *gcf.Function) gcv.Value {
+func BooleRule(a float64, b float64, f *gcf.Function) (gcv.Value, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	var omega float64
 	h := (b - a) / 4
 	x := a
 
-	omega = 7*f.MustEval(x).Value().Real() +
-		32*f.MustEval(x+h).Value().Real() +
-		12*f.MustEval(x+2*h).Value().Real() +
-		32*f.MustEval(x+3*h).Value().Real() +
-		7*f.MustEval(x+4*h).Value().Real()
+	omega = 7*evaluator.eval(x) +
+		32*evaluator.eval(x+h) +
+		12*evaluator.eval(x+2*h) +
+		32*evaluator.eval(x+3*h) +
+		7*evaluator.eval(x+4*h)
+
+	if evaluator.err != nil {
+		return nil, evaluator.err
+	}
 
-	return gcv.MakeValue(2 * h / 45 * omega)
+	return gcv.MakeValue(2 * h / 45 * omega), nil
 }
 
 // RungeKutta2 or midpoint method returns a solution found using the 2nd order runge-kutta
-func RungeKutta2(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func RungeKutta2(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -87,21 +144,34 @@ func RungeKutta2(a float64, b float64, N int, initialCondition float64, f *gcf.F
 	var kappa2 float64
 
 	for i := 0; i < N; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/2.0, omega+kappa/2.0).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/2.0, omega+kappa/2.0)
 
 		omega += kappa2
 		theta += stepSize
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // ModifiedEuler returns a solution to the ModifiedEuler method
-func ModifiedEuler(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func ModifiedEuler(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -115,21 +185,34 @@ func ModifiedEuler(a float64, b float64, N int, initialCondition float64, f *gcf
 	var kappa2 float64
 
 	for i := 0; i < N; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
 		theta += stepSize
-		kappa2 = stepSize * f.MustEval(theta, omega+kappa).Value().Real()
+		kappa2 = stepSize * evaluator.eval(theta, omega+kappa)
 
 		omega += (kappa + kappa2) / 2.0
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // Heun returns a solution to the 3rd order runge-kutta method (Heun method)
-func Heun(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func Heun(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -144,22 +227,35 @@ func Heun(a float64, b float64, N int, initialCondition float64, f *gcf.Function
 	var kappa3 float64
 
 	for i := 0; i < N; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/3.0, omega+kappa/3.0).Value().Real()
-		kappa3 = stepSize * f.MustEval(theta+2.0*stepSize/3.0, omega+2.0*kappa2/3.0).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/3.0, omega+kappa/3.0)
+		kappa3 = stepSize * evaluator.eval(theta+2.0*stepSize/3.0, omega+2.0*kappa2/3.0)
 
 		omega += (kappa + 3.0*kappa3) / 4.0
 		theta += stepSize
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // RungeKutta4 returns a solution found using the 4th order runge-kutta method
-func RungeKutta4(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func RungeKutta4(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -175,29 +271,39 @@ func RungeKutta4(a float64, b float64, N int, initialCondition float64, f *gcf.F
 	var kappa4 float64
 
 	for i := 0; i < N; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/2.0, omega+kappa/2.0).Value().Real()
-		kappa3 = stepSize * f.MustEval(theta+stepSize/2.0, omega+kappa2/2.0).Value().Real()
-		kappa4 = stepSize * f.MustEval(theta+stepSize, omega+kappa3).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/2.0, omega+kappa/2.0)
+		kappa3 = stepSize * evaluator.eval(theta+stepSize/2.0, omega+kappa2/2.0)
+		kappa4 = stepSize * evaluator.eval(theta+stepSize, omega+kappa3)
 
 		omega += (kappa + 2.0*kappa2 + 2.0*kappa3 + kappa4) / 6.0
 		theta += stepSize
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // RungeKuttaFehlbery returns a solution to the runge-kutta-fehlbery method
 // Algorithm from Numerical Analysis - By Burden and Faires
 func RungeKuttaFehlbery(a float64, b float64, initialCondition float64,
-	TOL float64, maxStep float64, minStep float64, f *gcf.Function) m.Matrix {
+	TOL float64, maxStep float64, minStep float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateAdaptive(a, b, TOL, maxStep, minStep); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := maxStep
 	theta := a
 	omega := initialCondition
 	done := false
+	finalStep := false
 
 	solutionSet := v.MakeVectors(v.RowSpace, v.MakeVector(v.RowSpace, theta, omega))
 
@@ -211,20 +317,38 @@ func RungeKuttaFehlbery(a float64, b float64, initialCondition float64,
 	var remainder float64
 	var delta float64
 
+	if theta+stepSize >= b {
+		stepSize = b - theta
+		finalStep = true
+	}
+
 	for !done {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/4.0, omega+kappa/4.0).Value().Real()
-		kappa3 = stepSize * f.MustEval(theta+3.0*stepSize/8.0, omega+3.0*kappa/32.0+9.0*kappa2/32.0).Value().Real()
-		kappa4 = stepSize * f.MustEval(theta+12.0*stepSize/13.0, omega+1932.0*kappa/2197.0-
-			7200.0*kappa2/2197.0+7296.0*kappa3/2197.0).Value().Real()
-		kappa5 = stepSize * f.MustEval(theta+stepSize, omega+439.0*kappa/216.0-8.0*kappa2+
-			3680.0*kappa3/513.0-845.0*kappa4/4104.0).Value().Real()
-		kappa6 = stepSize * f.MustEval(theta+stepSize/2.0, omega-8.0*kappa/27.0+2.0*kappa2-
-			3544.0*kappa3/2565.0+1859.0*kappa4/4104.0-11.0*kappa5/40.0).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/4.0, omega+kappa/4.0)
+		kappa3 = stepSize * evaluator.eval(theta+3.0*stepSize/8.0, omega+3.0*kappa/32.0+9.0*kappa2/32.0)
+		kappa4 = stepSize * evaluator.eval(theta+12.0*stepSize/13.0, omega+1932.0*kappa/2197.0-
+			7200.0*kappa2/2197.0+7296.0*kappa3/2197.0)
+		kappa5 = stepSize * evaluator.eval(theta+stepSize, omega+439.0*kappa/216.0-8.0*kappa2+
+			3680.0*kappa3/513.0-845.0*kappa4/4104.0)
+		kappa6 = stepSize * evaluator.eval(theta+stepSize/2.0, omega-8.0*kappa/27.0+2.0*kappa2-
+			3544.0*kappa3/2565.0+1859.0*kappa4/4104.0-11.0*kappa5/40.0)
+
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
 
 		remainder = math.Abs(kappa/360.0-128.0*kappa3/4275.0-2197.0*kappa4/75240.0+kappa5/50.0+2.0*kappa6/55.0) / stepSize
+
+		if math.IsNaN(remainder) || math.IsInf(remainder, 0) {
+			return nil, ErrDivergence
+		}
 		if remainder <= TOL {
-			theta += stepSize
+			if finalStep {
+				theta = b
+			} else {
+				theta += stepSize
+			}
+
 			omega += 25.0*kappa/216.0 + 1408.0*kappa3/2565.0 + 2197.0*kappa4/4104.0 - kappa5/5.0
 
 			solutionSet.Append(v.MakeVector(v.RowSpace, theta, omega))
@@ -244,21 +368,32 @@ func RungeKuttaFehlbery(a float64, b float64, initialCondition float64,
 			stepSize = maxStep
 		}
 
+		finalStep = false
 		if theta >= b {
 			done = true
-		} else if theta+stepSize > b {
-			stepSize = b - theta
 		} else if stepSize < minStep {
-			done = true
+			return nil, &StepSizeError{Theta: theta, StepSize: stepSize, MinStep: minStep}
+		} else if theta+stepSize >= b {
+			stepSize = b - theta
+			finalStep = true
 		}
 	}
 
-	return m.MakeMatrixAlt(solutionSet)
+	return m.MakeMatrixAlt(solutionSet), nil
 }
 
 // AdamsBashforth2 returns a solution found using the 2nd order Adams-Bashforth method
 func AdamsBashforth2(a float64, b float64, N int, initialCondition1 float64,
-	initialCondition2 float64, f *gcf.Function) m.Matrix {
+	initialCondition2 float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega1 := initialCondition1
@@ -280,22 +415,35 @@ func AdamsBashforth2(a float64, b float64, N int, initialCondition1 float64,
 	var kappa2 float64
 
 	for i := 1; i < N; i++ {
-		kappa = 3.0 * f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()
-		kappa2 = f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()
+		kappa = 3.0 * evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))
+		kappa2 = evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))
 
 		omega += stepSize * (kappa - kappa2) / 2.0
 		theta = stepSize + solutionSet.Get(i, 0).Real()
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforth3 returns a solution found using the 3rd order Adams-Bashforth method
 func AdamsBashforth3(a float64, b float64, N int, initialCondition1 float64,
-	initialCondition2 float64, initialCondition3 float64, f *gcf.Function) m.Matrix {
+	initialCondition2 float64, initialCondition3 float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 2); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega1 := initialCondition1
@@ -320,24 +468,37 @@ func AdamsBashforth3(a float64, b float64, N int, initialCondition1 float64,
 	var kappa3 float64
 
 	for i := 2; i < N; i++ {
-		kappa = 23.0 * f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()
-		kappa2 = 16.0 * f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()
-		kappa3 = 5.0 * f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real()
+		kappa = 23.0 * evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))
+		kappa2 = 16.0 * evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))
+		kappa3 = 5.0 * evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1))
 
 		omega += stepSize * (kappa - kappa2 + kappa3) / 12.0
 		theta = stepSize + solutionSet.Get(i, 0).Real()
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforth4 returns a solution found using the 4th order Adams-Bashforth method
 func AdamsBashforth4(a float64, b float64, N int, initialCondition1 float64,
 	initialCondition2 float64, initialCondition3 float64, initialCondition4 float64,
-	f *gcf.Function) m.Matrix {
+	f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 3); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega1 := initialCondition1
@@ -365,25 +526,38 @@ func AdamsBashforth4(a float64, b float64, N int, initialCondition1 float64,
 	var kappa4 float64
 
 	for i := 3; i < N; i++ {
-		kappa = 55.0 * f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()
-		kappa2 = 59.0 * f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()
-		kappa3 = 37.0 * f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real()
-		kappa4 = 9.0 * f.MustEval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1)).Value().Real()
+		kappa = 55.0 * evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))
+		kappa2 = 59.0 * evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))
+		kappa3 = 37.0 * evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1))
+		kappa4 = 9.0 * evaluator.eval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1))
 
 		omega += stepSize * (kappa - kappa2 + kappa3 - kappa4) / 24.0
 		theta = stepSize + solutionSet.Get(i, 0).Real()
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforth5 returns a solution found using the 5th order Adams-Bashforth method
 func AdamsBashforth5(a float64, b float64, N int, initialCondition1 float64,
 	initialCondition2 float64, initialCondition3 float64, initialCondition4 float64,
-	initialCondition5 float64, f *gcf.Function) m.Matrix {
+	initialCondition5 float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 4); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega1 := initialCondition1
@@ -414,24 +588,37 @@ func AdamsBashforth5(a float64, b float64, N int, initialCondition1 float64,
 	var kappa5 float64
 
 	for i := 4; i < N; i++ {
-		kappa = 1901.0 * f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()
-		kappa2 = 2774.0 * f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()
-		kappa3 = 2616.0 * f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real()
-		kappa4 = 1274.0 * f.MustEval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1)).Value().Real()
-		kappa5 = 251.0 * f.MustEval(solutionSet.Get(i-4, 0), solutionSet.Get(i-4, 1)).Value().Real()
+		kappa = 1901.0 * evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))
+		kappa2 = 2774.0 * evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))
+		kappa3 = 2616.0 * evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1))
+		kappa4 = 1274.0 * evaluator.eval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1))
+		kappa5 = 251.0 * evaluator.eval(solutionSet.Get(i-4, 0), solutionSet.Get(i-4, 1))
 
 		omega += stepSize * (kappa - kappa2 + kappa3 - kappa4 + kappa5) / 720.0
 		theta = stepSize + solutionSet.Get(i, 0).Real()
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforthMoulton3 returns solutions for the third order Adams-Bashforth-Moulton predictor-corrector method
-func AdamsBashforthMoulton3(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func AdamsBashforthMoulton3(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 2); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -446,35 +633,52 @@ func AdamsBashforthMoulton3(a float64, b float64, N int, initialCondition float6
 	var kappa3 float64
 
 	for i := 0; i < 2; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/3.0, omega+kappa/3.0).Value().Real()
-		kappa3 = stepSize * f.MustEval(theta+2.0*stepSize/3.0, omega+2.0*kappa2/3.0).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/3.0, omega+kappa/3.0)
+		kappa3 = stepSize * evaluator.eval(theta+2.0*stepSize/3.0, omega+2.0*kappa2/3.0)
 
 		omega += (kappa + 3.0*kappa3) / 4.0
 		theta += stepSize
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
 	for i := 2; i < N; i++ {
 		theta = stepSize + solutionSet.Get(i, 0).Real()
-		omega = solutionSet.Get(i, 1).Real() + stepSize*(23.0*f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()-
-			16.0*f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()+
-			5.0*f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real())/12.0
-		omega = solutionSet.Get(i, 1).Real() + stepSize*(5.0*f.MustEval(theta, omega).Value().Real()+
-			8.0*f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()-
-			f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real())/12.0
+		omega = solutionSet.Get(i, 1).Real() + stepSize*(23.0*evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))-
+			16.0*evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))+
+			5.0*evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)))/12.0
+		omega = solutionSet.Get(i, 1).Real() + stepSize*(5.0*evaluator.eval(theta, omega)+
+			8.0*evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))-
+			evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)))/12.0
+
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
 
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforthMoulton4 returns solutions for the fourth order Adams-Bashforth-Moulton predictor-corrector method
-func AdamsBashforthMoulton4(a float64, b float64, N int, initialCondition float64, f *gcf.Function) m.Matrix {
+func AdamsBashforthMoulton4(a float64, b float64, N int, initialCondition float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 3); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := (b - a) / float64(N)
 	theta := a
 	omega := initialCondition
@@ -490,39 +694,52 @@ func AdamsBashforthMoulton4(a float64, b float64, N int, initialCondition float6
 	var kappa4 float64
 
 	for i := 0; i < N; i++ {
-		kappa = stepSize * f.MustEval(theta, omega).Value().Real()
-		kappa2 = stepSize * f.MustEval(theta+stepSize/2.0, omega+kappa/2.0).Value().Real()
-		kappa3 = stepSize * f.MustEval(theta+stepSize/2.0, omega+kappa2/2.0).Value().Real()
-		kappa4 = stepSize * f.MustEval(theta+stepSize, omega+kappa3).Value().Real()
+		kappa = stepSize * evaluator.eval(theta, omega)
+		kappa2 = stepSize * evaluator.eval(theta+stepSize/2.0, omega+kappa/2.0)
+		kappa3 = stepSize * evaluator.eval(theta+stepSize/2.0, omega+kappa2/2.0)
+		kappa4 = stepSize * evaluator.eval(theta+stepSize, omega+kappa3)
 
 		omega += (kappa + 2.0*kappa2 + 2.0*kappa3 + kappa4) / 6.0
 		theta += stepSize
 
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
+
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
 	for i := 3; i < N; i++ {
 		theta = stepSize + solutionSet.Get(i, 0).Real()
-		omega = solutionSet.Get(i, 1).Real() + stepSize*(55.0*f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()-
-			59.0*f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()+
-			37.0*f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real()-
-			9.0*f.MustEval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1)).Value().Real())/24.0
-		omega = solutionSet.Get(i, 1).Real() + stepSize*(9.0*f.MustEval(theta, omega).Value().Real()+
-			19.0*f.MustEval(solutionSet.Get(i, 0), solutionSet.Get(i, 1)).Value().Real()-
-			5.0*f.MustEval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1)).Value().Real()+
-			f.MustEval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)).Value().Real())/24.0
+		omega = solutionSet.Get(i, 1).Real() + stepSize*(55.0*evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))-
+			59.0*evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))+
+			37.0*evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1))-
+			9.0*evaluator.eval(solutionSet.Get(i-3, 0), solutionSet.Get(i-3, 1)))/24.0
+		omega = solutionSet.Get(i, 1).Real() + stepSize*(9.0*evaluator.eval(theta, omega)+
+			19.0*evaluator.eval(solutionSet.Get(i, 0), solutionSet.Get(i, 1))-
+			5.0*evaluator.eval(solutionSet.Get(i-1, 0), solutionSet.Get(i-1, 1))+
+			evaluator.eval(solutionSet.Get(i-2, 0), solutionSet.Get(i-2, 1)))/24.0
+
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
 
 		solutionSet.Set(i+1, 0, theta)
 		solutionSet.Set(i+1, 1, omega)
 	}
 
-	return solutionSet
+	return solutionSet, nil
 }
 
 // AdamsBashforthMoulton returns a solution from the variable step Adams-Bashforth-Moulton method
 func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 	TOL float64, maxStep float64, minStep float64, f *gcf.Function) (m.Matrix, error) {
+	if err := validateAdaptive(a, b, TOL, maxStep, minStep); err != nil {
+		return nil, err
+	}
+
+	evaluator := &realEvaluator{f: f}
 	stepSize := maxStep
 	theta := a
 	omega := initialCondition
@@ -532,7 +749,7 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 
 	set := v.MakeVectors(v.RowSpace, v.MakeVector(v.RowSpace, theta, omega))
 
-	RK4 := func(h float64, set v.Vectors, f *gcf.Function) v.Vectors {
+	RK4 := func(h float64, set v.Vectors) (v.Vectors, error) {
 		var kappa float64
 		var kappa2 float64
 		var kappa3 float64
@@ -544,21 +761,28 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 			t = set.Get(set.Len() - 1).Get(0).Real()
 			o = set.Get(set.Len() - 1).Get(1).Real()
 
-			kappa = h * f.MustEval(t, o).Value().Real()
-			kappa2 = h * f.MustEval(t+h/2.0, o+kappa/2.0).Value().Real()
-			kappa3 = h * f.MustEval(t+h/2.0, o+kappa2/2.0).Value().Real()
-			kappa4 = h * f.MustEval(t+h, o+kappa3).Value().Real()
+			kappa = h * evaluator.eval(t, o)
+			kappa2 = h * evaluator.eval(t+h/2.0, o+kappa/2.0)
+			kappa3 = h * evaluator.eval(t+h/2.0, o+kappa2/2.0)
+			kappa4 = h * evaluator.eval(t+h, o+kappa3)
 
 			o += (kappa + 2.0*kappa2 + 2.0*kappa3 + kappa4) / 6.0
 			t += h
 
+			if evaluator.err != nil {
+				return nil, evaluator.err
+			}
+
 			set.Append(v.MakeVector(v.RowSpace, t, o))
 		}
 
-		return set
+		return set, nil
 	}
 
-	set = RK4(stepSize, set, f)
+	set, err := RK4(stepSize, set)
+	if err != nil {
+		return nil, err
+	}
 	rk4Done = true
 
 	theta = set.Get(set.Len()-1).Get(0).Real() + stepSize
@@ -573,16 +797,25 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 		theta2, omega2 := set.Get(set.Len()-2).Get(0).Real(), set.Get(set.Len()-2).Get(1).Real()
 		theta3, omega3 := set.Get(set.Len()-3).Get(0).Real(), set.Get(set.Len()-3).Get(1).Real()
 		theta4, omega4 := set.Get(set.Len()-4).Get(0).Real(), set.Get(set.Len()-4).Get(1).Real()
-		predictor = omega1 + stepSize*(55.0*f.MustEval(theta1, omega1).Value().Real()-
-			59.0*f.MustEval(theta2, omega2).Value().Real()+
-			37.0*f.MustEval(theta3, omega3).Value().Real()-
-			9.0*f.MustEval(theta4, omega4).Value().Real())/24.0
-		corrector = omega1 + stepSize*(9.0*f.MustEval(theta, predictor).Value().Real()+
-			19.0*f.MustEval(theta1, omega1).Value().Real()-
-			5.0*f.MustEval(theta2, omega2).Value().Real()+
-			f.MustEval(theta3, omega3).Value().Real())/24.0
+		predictor = omega1 + stepSize*(55.0*evaluator.eval(theta1, omega1)-
+			59.0*evaluator.eval(theta2, omega2)+
+			37.0*evaluator.eval(theta3, omega3)-
+			9.0*evaluator.eval(theta4, omega4))/24.0
+		corrector = omega1 + stepSize*(9.0*evaluator.eval(theta, predictor)+
+			19.0*evaluator.eval(theta1, omega1)-
+			5.0*evaluator.eval(theta2, omega2)+
+			evaluator.eval(theta3, omega3))/24.0
+
+		if evaluator.err != nil {
+			return nil, evaluator.err
+		}
 
 		sigma = 19.0 * math.Abs(corrector-predictor) / (270.0 * stepSize)
+
+		if math.IsNaN(sigma) || math.IsInf(sigma, 0) {
+			return nil, ErrDivergence
+		}
+
 		if sigma <= TOL {
 			omega = corrector
 
@@ -608,7 +841,9 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 						lastValueCalc = true
 					}
 
-					set = RK4(stepSize, set, f)
+					if set, err = RK4(stepSize, set); err != nil {
+						return nil, err
+					}
 					rk4Done = true
 				}
 			}
@@ -628,7 +863,9 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 					set = set.Subset(0, set.Len()-4)
 				}
 
-				set = RK4(stepSize, set, f)
+				if set, err = RK4(stepSize, set); err != nil {
+					return nil, err
+				}
 				rk4Done = true
 			}
 		}
@@ -637,7 +874,7 @@ func AdamsBashforthMoulton(a float64, b float64, initialCondition float64,
 	}
 
 	if !lastValueCalc {
-		return nil, errors.New("Minimum step size exceeded")
+		return nil, &StepSizeError{Theta: set.Get(set.Len() - 1).Get(0).Real(), StepSize: stepSize, MinStep: minStep}
 	}
 
 	return m.MakeMatrixAlt(set), nil
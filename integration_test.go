@@ -1,6 +1,7 @@
 package methods
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -17,7 +18,10 @@ func TestEuler1D(t *testing.T) {
 	b := 2.0
 	N := 10
 	initValue := 0.5
-	result := Euler1D(a, b, N, initValue, f)
+	result, err := Euler1D(a, b, N, initValue, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result.Real() - 4.865784) > 0.000001 {
 		t.Fail()
 	}
@@ -29,7 +33,10 @@ func TestTrapezoidRule(t *testing.T) {
 	f := gcf.MakeFuncPanic(regVars, "Sin", "(", x, ")")
 	a := 0.0
 	b := math.Pi / 4
-	result := TrapezoidRule(a, b, f)
+	result, err := TrapezoidRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result.Real() - 0.2776801) > 0.0000001 {
 		t.Fail()
 	}
@@ -41,7 +48,10 @@ func TestSimpsonRule(t *testing.T) {
 	f := gcf.MakeFuncPanic(regVars, "Sin", "(", x, ")")
 	a := 0.0
 	b := math.Pi / 4
-	result := SimpsonRule(a, b, f)
+	result, err := SimpsonRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result.Real() - 0.2929326) > 0.0000001 {
 		t.Fail()
 	}
@@ -53,7 +63,10 @@ func TestSimpson38Rule(t *testing.T) {
 	f := gcf.MakeFuncPanic(regVars, "Sin", "(", x, ")")
 	a := 0.0
 	b := math.Pi / 4
-	result := Simpson38Rule(a, b, f)
+	result, err := Simpson38Rule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result.Real() - 0.2929107) > 0.0000001 {
 		t.Fail()
 	}
@@ -65,7 +78,10 @@ func TestBooleRule(t *testing.T) {
 	f := gcf.MakeFuncPanic(regVars, "Sin", "(", x, ")")
 	a := 0.0
 	b := math.Pi / 4
-	result := BooleRule(a, b, f)
+	result, err := BooleRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result.Real() - 0.29289318) > 0.0000001 {
 		t.Fail()
 	}
@@ -80,21 +96,39 @@ func TestRungeKutta(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrixA := RungeKutta2(a, b, N, initialCondition, f)
+	solutionMatrixA, err := RungeKutta2(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := RungeKutta4(a, b, N, initialCondition, f)
+	solutionMatrixB, err := RungeKutta4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-2 {
 		t.Fail()
 	}
 	TOL := 1e-5
 	maxStep := 0.25
 	minStep := 0.01
-	solutionMatrixC := RungeKuttaFehlbery(a, b, initialCondition, TOL, maxStep, minStep, f)
+	solutionMatrixC, err := RungeKuttaFehlbery(a, b, initialCondition, TOL, maxStep, minStep, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixC.Get(9, 1).Real(); math.Abs(result-5.3054720) > 1e-4 {
 		t.Fail()
 	}
+	rows, _ := solutionMatrixC.Dim()
+	if last := solutionMatrixC.Get(rows-1, 0).Real(); last != b {
+		t.Errorf("Expected last mesh point %v, received %v", b, last)
+	}
+	_, errD := RungeKuttaFehlbery(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
 }
 
 func TestModifiedEuler(t *testing.T) {
@@ -106,7 +140,10 @@ func TestModifiedEuler(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrix := ModifiedEuler(a, b, N, initialCondition, f)
+	solutionMatrix, err := ModifiedEuler(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrix.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -121,7 +158,10 @@ func TestHeun(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrix := Heun(a, b, N, initialCondition, f)
+	solutionMatrix, err := Heun(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrix.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -140,21 +180,33 @@ func TestAdamsBashforth(t *testing.T) {
 	initialCondition3 := 1.2140877
 	initialCondition4 := 1.6489406
 	initialCondition5 := 2.1272295
-	solutionMatrixA := AdamsBashforth2(a, b, N, initialCondition1, initialCondition2, f)
+	solutionMatrixA, err := AdamsBashforth2(a, b, N, initialCondition1, initialCondition2, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := AdamsBashforth3(a, b, N, initialCondition1, initialCondition2, initialCondition3, f)
+	solutionMatrixB, err := AdamsBashforth3(a, b, N, initialCondition1, initialCondition2, initialCondition3, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixC := AdamsBashforth4(a, b, N, initialCondition1, initialCondition2, initialCondition3, initialCondition4, f)
+	solutionMatrixC, err := AdamsBashforth4(a, b, N, initialCondition1, initialCondition2, initialCondition3, initialCondition4, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 
 	if result := solutionMatrixC.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixD := AdamsBashforth5(a, b, N, initialCondition1,
+	solutionMatrixD, err := AdamsBashforth5(a, b, N, initialCondition1,
 		initialCondition2, initialCondition3, initialCondition4, initialCondition5, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixD.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -169,11 +221,17 @@ func TestAdamsBashforthMoulton(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrixA := AdamsBashforthMoulton3(a, b, N, initialCondition, f)
+	solutionMatrixA, err := AdamsBashforthMoulton3(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := AdamsBashforthMoulton4(a, b, N, initialCondition, f)
+	solutionMatrixB, err := AdamsBashforthMoulton4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB.Get(10, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -187,4 +245,131 @@ func TestAdamsBashforthMoulton(t *testing.T) {
 	if result := solutionMatrixC.Get(21, 1).Real(); math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
+	_, errD := AdamsBashforthMoulton(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
+}
+
+func TestIntegrationEvalError(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	y := gcfargs.NewVar(gcfargs.Value)
+	// quadrature rules evaluate with a single input and the ode methods with two
+	quadratureFunction := gcf.MakeFuncPanic([]gcfargs.Var{x, y}, x, "+", y)
+	odeFunction := gcf.MakeFuncPanic([]gcfargs.Var{x}, x)
+	a := 0.0
+	b := 2.0
+	N := 10
+	initialCondition := 0.5
+
+	if _, err := TrapezoidRule(a, b, quadratureFunction); err == nil {
+		t.Error("Expected error from TrapezoidRule")
+	}
+
+	if _, err := SimpsonRule(a, b, quadratureFunction); err == nil {
+		t.Error("Expected error from SimpsonRule")
+	}
+
+	if _, err := Simpson38Rule(a, b, quadratureFunction); err == nil {
+		t.Error("Expected error from Simpson38Rule")
+	}
+
+	if _, err := BooleRule(a, b, quadratureFunction); err == nil {
+		t.Error("Expected error from BooleRule")
+	}
+
+	if _, err := Euler1D(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from Euler1D")
+	}
+
+	if _, err := RungeKutta2(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from RungeKutta2")
+	}
+
+	if _, err := ModifiedEuler(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from ModifiedEuler")
+	}
+
+	if _, err := Heun(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from Heun")
+	}
+
+	if _, err := RungeKutta4(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from RungeKutta4")
+	}
+
+	if _, err := RungeKuttaFehlbery(a, b, initialCondition, 1e-5, 0.25, 0.01, odeFunction); err == nil {
+		t.Error("Expected error from RungeKuttaFehlbery")
+	}
+
+	if _, err := AdamsBashforth2(a, b, N, initialCondition, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforth2")
+	}
+
+	if _, err := AdamsBashforth3(a, b, N, initialCondition, initialCondition, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforth3")
+	}
+
+	if _, err := AdamsBashforth4(a, b, N, initialCondition, initialCondition, initialCondition,
+		initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforth4")
+	}
+
+	if _, err := AdamsBashforth5(a, b, N, initialCondition, initialCondition, initialCondition,
+		initialCondition, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforth5")
+	}
+
+	if _, err := AdamsBashforthMoulton3(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforthMoulton3")
+	}
+
+	if _, err := AdamsBashforthMoulton4(a, b, N, initialCondition, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforthMoulton4")
+	}
+
+	if _, err := AdamsBashforthMoulton(a, b, initialCondition, 1e-5, 0.2, 0.01, odeFunction); err == nil {
+		t.Error("Expected error from AdamsBashforthMoulton")
+	}
+}
+
+func TestIntegrationValidation(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	y := gcfargs.NewVar(gcfargs.Value)
+	f := gcf.MakeFuncPanic([]gcfargs.Var{x, y}, y, "-", x, "^", 2, "+", 1)
+	g := gcf.MakeFuncPanic([]gcfargs.Var{x}, "Sin", "(", x, ")")
+
+	if _, err := Euler1D(0, 2, 0, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for N = 0, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := SimpsonRule(1, 1, g); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a = b, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := RungeKutta4(2, 0, 10, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a > b, received %v", ErrInvalidArgument, err)
+	}
+
+	var argumentErr *ArgumentError
+	if _, err := AdamsBashforth4(0, 2, 2, 0.5, 0.5, 0.5, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton4(0, 2, 2, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 0, 0.25, 0.01, f); !errors.As(err, &argumentErr) || argumentErr.Name != "TOL" {
+		t.Errorf("Expected ArgumentError for TOL, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 1e-5, 0.01, 0.25, f); !errors.As(err, &argumentErr) || argumentErr.Name != "maxStep" {
+		t.Errorf("Expected ArgumentError for maxStep, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton(0, 2, 0.5, 1e-5, 0.2, 0, f); !errors.As(err, &argumentErr) || argumentErr.Name != "minStep" {
+		t.Errorf("Expected ArgumentError for minStep, received %v", err)
+	}
 }
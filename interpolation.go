@@ -0,0 +1,264 @@
+package methods
+
+import (
+	"fmt"
+	"math/cmplx"
+	"sort"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// SortNodes sorts xValues into increasing order of their real parts in place and applies the same permutation
+// to each of values, so that data given in any order can be passed to the cubic splines
+func SortNodes(xValues v.Vector, values ...v.Vector) error {
+	size := xValues.Len()
+
+	for k, series := range values {
+		if series.Len() != size {
+			return &DimensionError{Name: fmt.Sprintf("values[%d]", k), Expected: size, Received: series.Len()}
+		}
+	}
+
+	for i := 0; i < size; i++ {
+		if cmplx.IsNaN(xValues.Get(i).Complex()) {
+			return &ArgumentError{Name: fmt.Sprintf("xValues[%d]", i), Value: xValues.Get(i), Reason: "must not be NaN"}
+		}
+	}
+
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return xValues.Get(order[i]).Real() < xValues.Get(order[j]).Real() })
+
+	permuted := make([]gcv.Value, size)
+	for _, series := range append([]v.Vector{xValues}, values...) {
+		for i, k := range order {
+			permuted[i] = series.Get(k)
+		}
+		for i, value := range permuted {
+			series.Set(i, value)
+		}
+	}
+
+	return nil
+}
+
+// NewtonDividedDifference is for calculating the coefficients for newton's divided-difference interpolating polynomial,
+// row i of the returned table holds the divided differences ending at xValues[i] and the diagonal holds the coefficients
+func NewtonDividedDifference(xValues v.Vector, functionValues v.Vector) (m.Matrix, error) {
+	size := xValues.Len()
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	tableValues := m.NewMatrix(size, size)
+
+	for i := 0; i < size; i++ {
+		tableValues.Set(i, 0, functionValues.Get(i))
+	}
+
+	for i := 1; i < size; i++ {
+		for j := 1; j <= i; j++ {
+			difference := gcvops.Sub(tableValues.Get(i, j-1), tableValues.Get(i-1, j-1))
+			tableValues.Set(i, j, gcvops.Div(difference, gcvops.Sub(xValues.Get(i), xValues.Get(i-j))))
+		}
+	}
+
+	return tableValues, nil
+}
+
+// NevilleIterated is for determining the table values of neville iterated interpolation,
+// the last diagonal entry is the approximation at valueToApprox
+func NevilleIterated(valueToApprox gcv.Value, xValues v.Vector, functionValues v.Vector) (m.Matrix, error) {
+	size := xValues.Len()
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	tableValues := m.NewMatrix(size, size)
+
+	for i := 0; i < size; i++ {
+		tableValues.Set(i, 0, functionValues.Get(i))
+	}
+
+	for i := 1; i < size; i++ {
+		for j := 1; j <= i; j++ {
+			upper := gcvops.Mult(gcvops.Sub(valueToApprox, xValues.Get(i-j)), tableValues.Get(i, j-1))
+			lower := gcvops.Mult(gcvops.Sub(valueToApprox, xValues.Get(i)), tableValues.Get(i-1, j-1))
+			tableValues.Set(i, j, gcvops.Div(gcvops.Sub(upper, lower), gcvops.Sub(xValues.Get(i), xValues.Get(i-j))))
+		}
+	}
+
+	return tableValues, nil
+}
+
+// Hermite is for determining the divided-difference table of the hermite interpolation polynomial,
+// the nodes are xValues with each value repeated twice and the diagonal holds the coefficients
+func Hermite(xValues v.Vector, functionValues v.Vector, dfunctionValues v.Vector) (m.Matrix, error) {
+	size := xValues.Len()
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	if err := validateValues("dfunctionValues", dfunctionValues, size); err != nil {
+		return nil, err
+	}
+
+	valueDoubleSet := make([]gcv.Value, 2*size)
+	tableValues := m.NewMatrix(2*size, 2*size)
+
+	for i := 0; i < size; i++ {
+		valueDoubleSet[2*i] = xValues.Get(i)
+		valueDoubleSet[2*i+1] = xValues.Get(i)
+
+		tableValues.Set(2*i, 0, functionValues.Get(i))
+		tableValues.Set(2*i+1, 0, functionValues.Get(i))
+		tableValues.Set(2*i+1, 1, dfunctionValues.Get(i))
+
+		if i > 0 {
+			difference := gcvops.Sub(tableValues.Get(2*i, 0), tableValues.Get(2*i-1, 0))
+			tableValues.Set(2*i, 1, gcvops.Div(difference, gcvops.Sub(valueDoubleSet[2*i], valueDoubleSet[2*i-1])))
+		}
+	}
+
+	for i := 2; i < 2*size; i++ {
+		for j := 2; j <= i; j++ {
+			difference := gcvops.Sub(tableValues.Get(i, j-1), tableValues.Get(i-1, j-1))
+			tableValues.Set(i, j, gcvops.Div(difference, gcvops.Sub(valueDoubleSet[i], valueDoubleSet[i-j])))
+		}
+	}
+
+	return tableValues, nil
+}
+
+// NaturalCubicSpline is used for finding the coefficients solution set of the natural cubic spline,
+// the rows of the returned matrix are the a, b, c and d coefficients of each piece
+func NaturalCubicSpline(xValues v.Vector, functionValues v.Vector) (m.Matrix, error) {
+	size := xValues.Len()
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	stepLengthSet, alpha := cubicSplineSystem(xValues, functionValues)
+
+	solvingSetA := make([]gcv.Value, size)
+	solvingSetB := make([]gcv.Value, size-1)
+	solvingSetC := make([]gcv.Value, size)
+
+	solvingSetA[0] = gcv.One()
+	solvingSetB[0] = gcv.Zero()
+	solvingSetC[0] = gcv.Zero()
+
+	for i := 1; i < size-1; i++ {
+		solvingSetA[i] = gcvops.Sub(gcvops.Mult(gcv.MakeValue(2.0), gcvops.Sub(xValues.Get(i+1), xValues.Get(i-1))), gcvops.Mult(stepLengthSet[i-1], solvingSetB[i-1]))
+		solvingSetB[i] = gcvops.Div(stepLengthSet[i], solvingSetA[i])
+		solvingSetC[i] = gcvops.Div(gcvops.Sub(alpha[i], gcvops.Mult(stepLengthSet[i-1], solvingSetC[i-1])), solvingSetA[i])
+	}
+
+	solvingSetA[size-1] = gcv.One()
+	solvingSetC[size-1] = gcv.Zero()
+
+	return cubicSplineSolution(functionValues, stepLengthSet, solvingSetB, solvingSetC), nil
+}
+
+// ClampedCubicSpline is for finding the coefficients solution set of the clamped cubic spline,
+// the rows of the returned matrix are the a, b, c and d coefficients of each piece
+func ClampedCubicSpline(xValues v.Vector, functionValues v.Vector, df0 gcv.Value, dfN gcv.Value) (m.Matrix, error) {
+	size := xValues.Len()
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	if cmplx.IsNaN(df0.Complex()) {
+		return nil, &ArgumentError{Name: "df0", Value: df0, Reason: "must not be NaN"}
+	}
+
+	if cmplx.IsNaN(dfN.Complex()) {
+		return nil, &ArgumentError{Name: "dfN", Value: dfN, Reason: "must not be NaN"}
+	}
+
+	three := gcv.MakeValue(3.0)
+	stepLengthSet, alpha := cubicSplineSystem(xValues, functionValues)
+
+	alpha[0] = gcvops.Sub(gcvops.Div(gcvops.Mult(three, gcvops.Sub(functionValues.Get(1), functionValues.Get(0))), stepLengthSet[0]), gcvops.Mult(three, df0))
+	alpha[size-1] = gcvops.Sub(gcvops.Mult(three, dfN), gcvops.Div(gcvops.Mult(three, gcvops.Sub(functionValues.Get(size-1), functionValues.Get(size-2))), stepLengthSet[size-2]))
+
+	solvingSetA := make([]gcv.Value, size)
+	solvingSetB := make([]gcv.Value, size-1)
+	solvingSetC := make([]gcv.Value, size)
+
+	solvingSetA[0] = gcvops.Mult(gcv.MakeValue(2.0), stepLengthSet[0])
+	solvingSetB[0] = gcv.MakeValue(0.5)
+	solvingSetC[0] = gcvops.Div(alpha[0], solvingSetA[0])
+
+	for i := 1; i < size-1; i++ {
+		solvingSetA[i] = gcvops.Sub(gcvops.Mult(gcv.MakeValue(2.0), gcvops.Sub(xValues.Get(i+1), xValues.Get(i-1))), gcvops.Mult(stepLengthSet[i-1], solvingSetB[i-1]))
+		solvingSetB[i] = gcvops.Div(stepLengthSet[i], solvingSetA[i])
+		solvingSetC[i] = gcvops.Div(gcvops.Sub(alpha[i], gcvops.Mult(stepLengthSet[i-1], solvingSetC[i-1])), solvingSetA[i])
+	}
+
+	solvingSetA[size-1] = gcvops.Mult(stepLengthSet[size-2], gcvops.Sub(gcv.MakeValue(2.0), solvingSetB[size-2]))
+	solvingSetC[size-1] = gcvops.Div(gcvops.Sub(alpha[size-1], gcvops.Mult(stepLengthSet[size-2], solvingSetC[size-2])), solvingSetA[size-1])
+
+	return cubicSplineSolution(functionValues, stepLengthSet, solvingSetB, solvingSetC), nil
+}
+
+// cubicSplineSystem returns the step lengths and the interior right hand sides shared by the cubic splines
+func cubicSplineSystem(xValues v.Vector, functionValues v.Vector) (stepLengthSet []gcv.Value, alpha []gcv.Value) {
+	size := xValues.Len()
+	three := gcv.MakeValue(3.0)
+
+	stepLengthSet = make([]gcv.Value, size-1)
+
+	for i := 0; i < size-1; i++ {
+		stepLengthSet[i] = gcvops.Sub(xValues.Get(i+1), xValues.Get(i))
+	}
+
+	alpha = make([]gcv.Value, size)
+	alpha[0] = gcv.Zero()
+	alpha[size-1] = gcv.Zero()
+
+	for i := 1; i < size-1; i++ {
+		forward := gcvops.Div(gcvops.Mult(three, gcvops.Sub(functionValues.Get(i+1), functionValues.Get(i))), stepLengthSet[i])
+		backward := gcvops.Div(gcvops.Mult(three, gcvops.Sub(functionValues.Get(i), functionValues.Get(i-1))), stepLengthSet[i-1])
+		alpha[i] = gcvops.Sub(forward, backward)
+	}
+
+	return stepLengthSet, alpha
+}
+
+// cubicSplineSolution back substitutes the tridiagonal system of a cubic spline
+// and returns the a, b, c and d coefficients as the rows of a matrix
+func cubicSplineSolution(functionValues v.Vector, stepLengthSet []gcv.Value, solvingSetB []gcv.Value, solvingSetC []gcv.Value) m.Matrix {
+	pieces := len(stepLengthSet)
+	two := gcv.MakeValue(2.0)
+	three := gcv.MakeValue(3.0)
+
+	solutionSetC := make([]gcv.Value, pieces+1)
+	solutionSetC[pieces] = solvingSetC[pieces]
+
+	solutionTable := m.NewMatrix(4, pieces)
+
+	for i := pieces - 1; i >= 0; i-- {
+		solutionSetC[i] = gcvops.Sub(solvingSetC[i], gcvops.Mult(solvingSetB[i], solutionSetC[i+1]))
+
+		slope := gcvops.Div(gcvops.Sub(functionValues.Get(i+1), functionValues.Get(i)), stepLengthSet[i])
+		correction := gcvops.Div(gcvops.Mult(stepLengthSet[i], gcvops.Add(solutionSetC[i+1], gcvops.Mult(two, solutionSetC[i]))), three)
+
+		solutionTable.Set(0, i, functionValues.Get(i))
+		solutionTable.Set(1, i, gcvops.Sub(slope, correction))
+		solutionTable.Set(2, i, solutionSetC[i])
+		solutionTable.Set(3, i, gcvops.Div(gcvops.Sub(solutionSetC[i+1], solutionSetC[i]), gcvops.Mult(three, stepLengthSet[i])))
+	}
+
+	return solutionTable
+}
@@ -0,0 +1,258 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// matrixApproxEqual compares a matrix against rows of expected values, entries missing from a row are expected to be 0
+func matrixApproxEqual(expected [][]complex128, received m.Matrix, TOL float64) bool {
+	rows, cols := received.Dim()
+	if rows != len(expected) {
+		return false
+	}
+
+	for i := 0; i < rows; i++ {
+		if len(expected[i]) > cols {
+			return false
+		}
+		for j := 0; j < cols; j++ {
+			var value complex128
+			if j < len(expected[i]) {
+				value = expected[i][j]
+			}
+			if cmplx.Abs(received.Get(i, j).Complex()-value) > TOL*math.Max(1, cmplx.Abs(value)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func TestNewtonDividedDifference(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 1, 1.3, 1.6, 1.9, 2.2)
+	functionValues := v.MakeVector(v.RowSpace, 0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039)
+	testTableA, errA := NewtonDividedDifference(xValues, functionValues)
+
+	solutionTable := [][]complex128{{0.9153827}, {0.4873198, -1.426876333333333}, {0.8960778, 1.3625266666666664, 4.649004999999998}, {0.2769871, -2.063635666666668, -5.710270555555559, -11.51030617283951}, {0.7866039, 1.6987226666666655, 6.2705972222222215, 13.312075308641978, 20.68531790123457}}
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual(solutionTable, testTableA, 1e-12) {
+		t.Errorf("Expected %v, received %+v", solutionTable, testTableA)
+	}
+
+	// i x^2 through 0, 1 and 2 has coefficients 0, i and i
+	testTableB, errB := NewtonDividedDifference(v.MakeVector(v.RowSpace, 0, 1, 2), v.MakeVector(v.RowSpace, 0, 1i, 4i))
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if !matrixApproxEqual([][]complex128{{0}, {1i, 1i}, {4i, 3i, 1i}}, testTableB, 1e-12) {
+		t.Errorf("Expected complex divided differences, received %+v", testTableB)
+	}
+
+	_, errC := NewtonDividedDifference(v.MakeVector(v.RowSpace, 1, 1.3), functionValues)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := NewtonDividedDifference(v.MakeVector(v.RowSpace), v.MakeVector(v.RowSpace))
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+
+	// a repeated node would divide by zero
+	_, errE := NewtonDividedDifference(v.MakeVector(v.RowSpace, 1, 1.3, 1), v.MakeVector(v.RowSpace, 0.9153827, 0.4873198, 0.8960778))
+
+	var nodeErr *NodeError
+	if !errors.As(errE, &nodeErr) || nodeErr.Index != 2 || !errors.Is(errE, ErrDuplicateNodes) {
+		t.Errorf("Expected NodeError for xValues[2], received %v", errE)
+	}
+}
+
+func TestSortNodes(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 2, 0, 3, 1)
+	functionValues := v.MakeVector(v.RowSpace, 4, 0, 9, 1i)
+
+	_, errA := NaturalCubicSpline(xValues, functionValues)
+
+	if !errors.Is(errA, ErrUnsortedNodes) {
+		t.Errorf("Expected %v, received %v", ErrUnsortedNodes, errA)
+	}
+
+	if err := SortNodes(xValues, functionValues); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	for i, expected := range [][2]complex128{{0, 0}, {1, 1i}, {2, 4}, {3, 9}} {
+		if xValues.Get(i).Complex() != expected[0] || functionValues.Get(i).Complex() != expected[1] {
+			t.Errorf("Expected %v at %d, received %v and %v", expected, i, xValues.Get(i), functionValues.Get(i))
+		}
+	}
+
+	if _, err := NaturalCubicSpline(xValues, functionValues); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := SortNodes(xValues, v.MakeVector(v.RowSpace, 1, 2)); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+}
+
+func TestNevilleIterated(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 1, 1.3, 1.6, 1.9, 2.2)
+	functionValues := v.MakeVector(v.RowSpace, 0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039)
+	testTableA, errA := NevilleIterated(gcv.MakeValue(1.5), xValues, functionValues)
+
+	solutionTable := [][]complex128{{0.9153827}, {0.4873198, 0.2019445333333335}, {0.8960778, 0.7598251333333332, 0.6668450333333332}, {0.2769871, 1.102441366666667, 0.8740305444444444, 0.7819480950617284}, {0.7866039, -0.40250196666666593, 1.3532652555555562, 0.9805271469135803, 0.8646893666666667}}
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual(solutionTable, testTableA, 1e-12) {
+		t.Errorf("Expected %v, received %+v", solutionTable, testTableA)
+	}
+
+	// i x^2 is reproduced by three nodes, also at a complex point
+	testTableB, errB := NevilleIterated(gcv.MakeValue(1+1i), v.MakeVector(v.RowSpace, 0, 1, 2), v.MakeVector(v.RowSpace, 0, 1i, 4i))
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if value := testTableB.Get(2, 2).Complex(); cmplx.Abs(value-1i*(1+1i)*(1+1i)) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 1i*(1+1i)*(1+1i), value)
+	}
+
+	_, errC := NevilleIterated(gcv.MakeValue(1.5), v.MakeVector(v.RowSpace, 1, 1.3), functionValues)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestHermite(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 1.3, 1.6, 1.9)
+	functionValues := v.MakeVector(v.RowSpace, 0.4873198, 0.8960778, 0.2769871)
+	dfunctionValues := v.MakeVector(v.RowSpace, -0.0293884, 1.3455501, -0.741541)
+	testTableA, errA := Hermite(xValues, functionValues, dfunctionValues)
+
+	solutionSet := []complex128{0.4873198, -0.0293884, 4.639716888888888, -15.65435148148147, -5.318758641975368, 207.24067901234616}
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	rows, cols := testTableA.Dim()
+	if rows != 6 || cols != 6 {
+		t.Fatalf("Expected a 6x6 table, received %dx%d", rows, cols)
+	}
+
+	for i, coefficient := range solutionSet {
+		if value := testTableA.Get(i, i).Complex(); cmplx.Abs(value-coefficient) > 1e-9*math.Max(1, cmplx.Abs(coefficient)) {
+			t.Errorf("Expected coefficient %v, received %v", coefficient, value)
+		}
+	}
+
+	_, errB := Hermite(v.MakeVector(v.RowSpace, 1.3, 1.6), functionValues, dfunctionValues)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := Hermite(xValues, functionValues, v.MakeVector(v.RowSpace, -0.0293884))
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestNaturalCubicSpline(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 0, 1, 2, 3, 4)
+	functionValues := v.MakeVector(v.RowSpace, 1, math.Exp(1), math.Exp(2), math.Exp(3), math.Exp(4))
+	testTableA, errA := NaturalCubicSpline(xValues, functionValues)
+
+	solutionTable := [][]complex128{{1, 2.718281828459045, 7.38905609893065, 20.085536923187668}, {1.1111266016600037, 2.9325922820571275, 6.325672566903437, 23.86648273451499}, {0, 1.8214656803971239, 1.571614604449186, 15.969195563162367}, {0.6071552267990413, -0.08328369198264592, 4.7991936529043935, -5.323065187720789}}
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual(solutionTable, testTableA, 1e-12) {
+		t.Errorf("Expected %v, received %+v", solutionTable, testTableA)
+	}
+
+	// the spline is linear in the function values, so i times the data gives i times the coefficients
+	complexValues := v.MakeVector(v.RowSpace, 1i, complex(0, math.Exp(1)), complex(0, math.Exp(2)), complex(0, math.Exp(3)), complex(0, math.Exp(4)))
+	testTableB, errB := NaturalCubicSpline(xValues, complexValues)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	complexTable := make([][]complex128, len(solutionTable))
+	for i := range solutionTable {
+		complexTable[i] = make([]complex128, len(solutionTable[i]))
+		for j := range solutionTable[i] {
+			complexTable[i][j] = 1i * solutionTable[i][j]
+		}
+	}
+
+	if !matrixApproxEqual(complexTable, testTableB, 1e-12) {
+		t.Errorf("Expected %v, received %+v", complexTable, testTableB)
+	}
+
+	_, errC := NaturalCubicSpline(v.MakeVector(v.RowSpace, 0, 1, 2, 3), functionValues)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := NaturalCubicSpline(v.MakeVector(v.RowSpace, 0), v.MakeVector(v.RowSpace, 1))
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+}
+
+func TestClampedCubicSpline(t *testing.T) {
+	xValues := v.MakeVector(v.RowSpace, 0, 1, 2, 3, 4)
+	functionValues := v.MakeVector(v.RowSpace, 1, math.Exp(1), math.Exp(2), math.Exp(3), math.Exp(4))
+	testTableA, errA := ClampedCubicSpline(xValues, functionValues, gcv.MakeValue(1.0), gcv.MakeValue(math.Exp(4)))
+
+	solutionTable := [][]complex128{{1, 2.718281828459045, 7.38905609893065, 20.085536923187668}, {0.9999999999999999, 2.698742769368434, 7.372197219318214, 19.914233637544577}, {0.4561027160087011, 1.2426400533597335, 3.430814396590047, 9.111222021636316}, {0.26217911245034414, 0.7293914477434379, 1.8934692083487563, 5.487157450775675}}
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual(solutionTable, testTableA, 1e-12) {
+		t.Errorf("Expected %v, received %+v", solutionTable, testTableA)
+	}
+
+	_, errB := ClampedCubicSpline(v.MakeVector(v.RowSpace, 0, 1, 2, 3), functionValues, gcv.MakeValue(1.0), gcv.MakeValue(math.Exp(4)))
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := ClampedCubicSpline(v.MakeVector(v.RowSpace, 0), v.MakeVector(v.RowSpace, 1), gcv.Zero(), gcv.Zero())
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
@@ -0,0 +1,455 @@
+package methods
+
+import (
+	"math"
+	"math/cmplx"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// IterativeSolverResult holds the residual history and convergence diagnostics of an iterative linear solver
+type IterativeSolverResult struct {
+	// Solution is the approximated solution, only set when the method converged
+	Solution v.Vector
+	// Residuals holds |b - A x| for the initial guess and each iterate
+	Residuals []float64
+	// Iterations is the number of iterations performed
+	Iterations int
+}
+
+// ConvergenceFactor estimates the average factor by which each iteration reduced the residual, 0 if it can not be estimated
+func (r IterativeSolverResult) ConvergenceFactor() float64 {
+	size := len(r.Residuals)
+	if size < 2 || r.Residuals[0] == 0 || r.Residuals[size-1] == 0 {
+		return 0
+	}
+
+	return math.Pow(r.Residuals[size-1]/r.Residuals[0], 1/float64(size-1))
+}
+
+// addResidual records the residual of an iterate, returning ErrDivergence if it is not a finite number
+func (r *IterativeSolverResult) addResidual(residual float64) error {
+	r.Residuals = append(r.Residuals, residual)
+
+	if math.IsNaN(residual) || math.IsInf(residual, 0) {
+		return ErrDivergence
+	}
+
+	return nil
+}
+
+// iterationError wraps err with the method name and the number of iterations performed
+func (r IterativeSolverResult) iterationError(method string, err error) error {
+	return &IterationError{Method: method, Iterations: r.Iterations, Err: err}
+}
+
+// Preconditioner applies the inverse of a matrix M that approximates A to the residual r
+type Preconditioner func(r v.Vector) (v.Vector, error)
+
+// Jacobi is for solving the linear system A x = b by jacobi iteration from the initial guess x0, or zero if x0 is nil,
+// it converges for strictly diagonally dominant A and stops once |b - A x| <= TOL |b|
+// Algorithm from Numerical Analysis - By Burden and Faires
+func Jacobi(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := JacobiWithHistory(A, b, x0, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// JacobiWithHistory is Jacobi, also returning the residual history
+func JacobiWithHistory(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	var result IterativeSolverResult
+
+	if err := validateStationary(A, b, x0, TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	degree, _ := A.Dim()
+	x := initialGuess(x0, degree)
+	target := TOL * norm(b)
+
+	if err := result.addResidual(norm(residualVector(A, x, b))); err != nil {
+		return result, result.iterationError("Jacobi", err)
+	}
+
+	if result.Residuals[0] <= target {
+		result.Solution = x
+		return result, nil
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		next := v.NewVector(v.ColSpace, degree)
+		for row := 0; row < degree; row++ {
+			sum := b.Get(row)
+			for col := 0; col < degree; col++ {
+				if col != row {
+					sum = gcvops.Sub(sum, gcvops.Mult(A.Get(row, col), x.Get(col)))
+				}
+			}
+			next.Set(row, gcvops.Div(sum, A.Get(row, row)))
+		}
+		x = next
+		result.Iterations++
+
+		if err := result.addResidual(norm(residualVector(A, x, b))); err != nil {
+			return result, result.iterationError("Jacobi", err)
+		}
+
+		if result.Residuals[len(result.Residuals)-1] <= target {
+			result.Solution = x
+			return result, nil
+		}
+	}
+
+	return result, result.iterationError("Jacobi", ErrMaxIterations)
+}
+
+// GaussSeidel is for solving the linear system A x = b by gauss-seidel iteration from the initial guess x0, or zero if x0 is nil,
+// it converges for strictly diagonally dominant or hermitian positive-definite A and stops once |b - A x| <= TOL |b|
+// Algorithm from Numerical Analysis - By Burden and Faires
+func GaussSeidel(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := GaussSeidelWithHistory(A, b, x0, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// GaussSeidelWithHistory is GaussSeidel, also returning the residual history
+func GaussSeidelWithHistory(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	if err := validateStationary(A, b, x0, TOL, maxIteration); err != nil {
+		return IterativeSolverResult{}, err
+	}
+
+	return successiveOverRelaxation("GaussSeidel", A, b, x0, 1, TOL, maxIteration)
+}
+
+// SOR is for solving the linear system A x = b by successive over-relaxation with the relaxation factor omega from the
+// initial guess x0, or zero if x0 is nil, omega = 1 is gauss-seidel and the iteration stops once |b - A x| <= TOL |b|
+// Algorithm from Numerical Analysis - By Burden and Faires
+func SOR(A m.Matrix, b v.Vector, x0 v.Vector, omega float64, TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := SORWithHistory(A, b, x0, omega, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// SORWithHistory is SOR, also returning the residual history
+func SORWithHistory(A m.Matrix, b v.Vector, x0 v.Vector, omega float64, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	if err := validateStationary(A, b, x0, TOL, maxIteration); err != nil {
+		return IterativeSolverResult{}, err
+	}
+
+	if err := validateRelaxation(omega); err != nil {
+		return IterativeSolverResult{}, err
+	}
+
+	return successiveOverRelaxation("SOR", A, b, x0, omega, TOL, maxIteration)
+}
+
+// OptimalOmega estimates the relaxation factor 2 / (1 + sqrt(1 - rho^2)) that makes SOR converge fastest, where rho is
+// the spectral radius of the jacobi iteration matrix, the estimate is exact for consistently ordered matrices
+// such as the tridiagonal positive-definite ones, else error
+// Algorithm from Numerical Analysis - By Burden and Faires
+func OptimalOmega(A m.Matrix) (float64, error) {
+	if !A.IsSquare() {
+		return 0, ErrNotSquare
+	}
+
+	if err := validateDiagonal(A); err != nil {
+		return 0, err
+	}
+
+	// the jacobi iteration matrix is I - D^-1 A
+	degree, _ := A.Dim()
+	T := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		for j := 0; j < degree; j++ {
+			if i != j {
+				T.Set(i, j, gcvops.Div(gcvops.Sub(gcv.Zero(), A.Get(i, j)), A.Get(i, i)))
+			}
+		}
+	}
+
+	eigenvalues, _, err := Eigen(T)
+	if err != nil {
+		return 0, err
+	}
+
+	var rho float64
+	for i := 0; i < eigenvalues.Len(); i++ {
+		rho = math.Max(rho, cmplx.Abs(eigenvalues.Get(i).Complex()))
+	}
+
+	if rho >= 1 {
+		return 0, &ArgumentError{Name: "spectral radius of the jacobi iteration matrix", Value: rho, Reason: "must be less than 1"}
+	}
+
+	return 2 / (1 + math.Sqrt(1-rho*rho)), nil
+}
+
+// ConjugateGradient is for solving the linear system A x = b for hermitian positive-definite A by the conjugate gradient
+// method from the initial guess x0, or zero if x0 is nil, it stops once |b - A x| <= TOL |b| and in exact arithmetic
+// within n iterations
+// Algorithm from Numerical Analysis - By Burden and Faires
+func ConjugateGradient(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := ConjugateGradientWithHistory(A, b, x0, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// ConjugateGradientWithHistory is ConjugateGradient, also returning the residual history
+func ConjugateGradientWithHistory(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	unpreconditioned := func(r v.Vector) (v.Vector, error) { return r, nil }
+	return conjugateGradient("ConjugateGradient", A, b, x0, unpreconditioned, TOL, maxIteration)
+}
+
+// PreconditionedConjugateGradient is ConjugateGradient with the hermitian positive-definite preconditioner M,
+// which converges in fewer iterations when M is a cheap approximation of A
+// Algorithm from Numerical Analysis - By Burden and Faires
+func PreconditionedConjugateGradient(A m.Matrix, b v.Vector, x0 v.Vector, M Preconditioner, TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := PreconditionedConjugateGradientWithHistory(A, b, x0, M, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// PreconditionedConjugateGradientWithHistory is PreconditionedConjugateGradient, also returning the residual history
+func PreconditionedConjugateGradientWithHistory(A m.Matrix, b v.Vector, x0 v.Vector, M Preconditioner, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	return conjugateGradient("PreconditionedConjugateGradient", A, b, x0, M, TOL, maxIteration)
+}
+
+// JacobiPreconditioner returns the preconditioner that divides by the diagonal of A, else error
+func JacobiPreconditioner(A m.Matrix) (Preconditioner, error) {
+	if !A.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	if err := validateDiagonal(A); err != nil {
+		return nil, err
+	}
+
+	degree, _ := A.Dim()
+	diagonal := make([]gcv.Value, degree)
+	for i := range diagonal {
+		diagonal[i] = A.Get(i, i)
+	}
+
+	return func(r v.Vector) (v.Vector, error) {
+		if r.Len() != degree {
+			return nil, &DimensionError{Name: "r", Expected: degree, Received: r.Len()}
+		}
+
+		z := v.NewVector(v.ColSpace, degree)
+		for i, value := range diagonal {
+			z.Set(i, gcvops.Div(r.Get(i), value))
+		}
+		return z, nil
+	}, nil
+}
+
+// SSORPreconditioner returns the symmetric successive over-relaxation preconditioner
+// M = omega / (2 - omega) (D / omega + L) D^-1 (D / omega + U) of A, where D, L and U are the diagonal, strictly lower
+// and strictly upper parts of A, M is hermitian positive-definite when A is, else error
+func SSORPreconditioner(A m.Matrix, omega float64) (Preconditioner, error) {
+	if !A.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	if err := validateDiagonal(A); err != nil {
+		return nil, err
+	}
+
+	if err := validateRelaxation(omega); err != nil {
+		return nil, err
+	}
+
+	degree, _ := A.Dim()
+	lower := m.NewMatrix(degree, degree)
+	upper := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		scaled := gcvops.Div(A.Get(i, i), gcv.MakeValue(omega))
+		lower.Set(i, i, scaled)
+		upper.Set(i, i, scaled)
+		for j := 0; j < i; j++ {
+			lower.Set(i, j, A.Get(i, j))
+			upper.Set(j, i, A.Get(j, i))
+		}
+	}
+	factor := gcv.MakeValue((2 - omega) / omega)
+
+	return func(r v.Vector) (v.Vector, error) {
+		y, err := ForwardSubstitution(lower, r)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < degree; i++ {
+			y.Set(i, gcvops.Mult(factor, gcvops.Mult(A.Get(i, i), y.Get(i))))
+		}
+
+		return BackwardSubstitution(upper, y)
+	}, nil
+}
+
+// ILUPreconditioner returns the preconditioner that solves with the incomplete factorization L U of A found by ILU,
+// else error
+func ILUPreconditioner(A m.Matrix) (Preconditioner, error) {
+	L, U, err := ILU(A)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(r v.Vector) (v.Vector, error) {
+		y, err := ForwardSubstitution(L, r)
+		if err != nil {
+			return nil, err
+		}
+		return BackwardSubstitution(U, y)
+	}, nil
+}
+
+// successiveOverRelaxation runs SOR with the validated inputs, reporting errors under the given method name
+func successiveOverRelaxation(method string, A m.Matrix, b v.Vector, x0 v.Vector, omega float64, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	var result IterativeSolverResult
+
+	degree, _ := A.Dim()
+	x := initialGuess(x0, degree)
+	target := TOL * norm(b)
+	relaxation := gcv.MakeValue(omega)
+	complement := gcv.MakeValue(1 - omega)
+
+	if err := result.addResidual(norm(residualVector(A, x, b))); err != nil {
+		return result, result.iterationError(method, err)
+	}
+
+	if result.Residuals[0] <= target {
+		result.Solution = x
+		return result, nil
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		for row := 0; row < degree; row++ {
+			sum := b.Get(row)
+			for col := 0; col < degree; col++ {
+				if col != row {
+					sum = gcvops.Sub(sum, gcvops.Mult(A.Get(row, col), x.Get(col)))
+				}
+			}
+			update := gcvops.Div(sum, A.Get(row, row))
+			x.Set(row, gcvops.Add(gcvops.Mult(complement, x.Get(row)), gcvops.Mult(relaxation, update)))
+		}
+		result.Iterations++
+
+		if err := result.addResidual(norm(residualVector(A, x, b))); err != nil {
+			return result, result.iterationError(method, err)
+		}
+
+		if result.Residuals[len(result.Residuals)-1] <= target {
+			result.Solution = x
+			return result, nil
+		}
+	}
+
+	return result, result.iterationError(method, ErrMaxIterations)
+}
+
+// conjugateGradient runs the preconditioned conjugate gradient method, reporting errors under the given method name
+func conjugateGradient(method string, A m.Matrix, b v.Vector, x0 v.Vector, M Preconditioner, TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	var result IterativeSolverResult
+
+	if err := validateLinearSystem(A, b, x0); err != nil {
+		return result, err
+	}
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	if err := validateHermitian(A); err != nil {
+		return result, err
+	}
+
+	degree, _ := A.Dim()
+	x := initialGuess(x0, degree)
+	target := TOL * norm(b)
+
+	r := residualVector(A, x, b)
+	if err := result.addResidual(norm(r)); err != nil {
+		return result, result.iterationError(method, err)
+	}
+
+	if result.Residuals[0] <= target {
+		result.Solution = x
+		return result, nil
+	}
+
+	z, err := M(r)
+	if err != nil {
+		return result, err
+	}
+	p := z
+	rz := innerProduct(r, z)
+
+	for i := 0; i < maxIteration; i++ {
+		product := multiply(A, p)
+		curvature := innerProduct(p, product).Real()
+		if curvature <= 0 {
+			return result, result.iterationError(method, ErrNotPositiveDefinite)
+		}
+
+		alpha := gcvops.Div(rz, gcv.MakeValue(curvature))
+		x = addScaled(x, alpha, p)
+		r = addScaled(r, gcvops.Sub(gcv.Zero(), alpha), product)
+		result.Iterations++
+
+		if err := result.addResidual(norm(r)); err != nil {
+			return result, result.iterationError(method, err)
+		}
+
+		if result.Residuals[len(result.Residuals)-1] <= target {
+			result.Solution = x
+			return result, nil
+		}
+
+		if z, err = M(r); err != nil {
+			return result, err
+		}
+		next := innerProduct(r, z)
+		p = addScaled(z, gcvops.Div(next, rz), p)
+		rz = next
+	}
+
+	return result, result.iterationError(method, ErrMaxIterations)
+}
+
+// validateStationary checks the inputs of the jacobi, gauss-seidel and SOR iterations
+func validateStationary(A m.Matrix, b v.Vector, x0 v.Vector, TOL float64, maxIteration int) error {
+	if err := validateLinearSystem(A, b, x0); err != nil {
+		return err
+	}
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return err
+	}
+
+	return validateDiagonal(A)
+}
+
+// initialGuess returns a copy of x0 as a column vector, or the zero vector if x0 is nil
+func initialGuess(x0 v.Vector, degree int) v.Vector {
+	x := v.NewVector(v.ColSpace, degree)
+	if x0 != nil {
+		for i := 0; i < degree; i++ {
+			x.Set(i, x0.Get(i))
+		}
+	}
+	return x
+}
+
+// residualVector returns b - A x
+func residualVector(A m.Matrix, x v.Vector, b v.Vector) v.Vector {
+	return addScaled(b, gcv.MakeValue(-1), multiply(A, x))
+}
+
+// addScaled returns x + alpha y
+func addScaled(x v.Vector, alpha gcv.Value, y v.Vector) v.Vector {
+	sum := v.NewVector(v.ColSpace, x.Len())
+	for i := 0; i < x.Len(); i++ {
+		sum.Set(i, gcvops.Add(x.Get(i), gcvops.Mult(alpha, y.Get(i))))
+	}
+	return sum
+}
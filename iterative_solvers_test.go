@@ -0,0 +1,387 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// diagonallyDominant returns the strictly diagonally dominant system with the solution (1, 2, -1, 1)
+func diagonallyDominant() (m.Matrix, v.Vector) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 10, -1, 2, 0),
+		v.MakeVector(v.RowSpace, -1, 11, -1, 3),
+		v.MakeVector(v.RowSpace, 2, -1, 10, -1),
+		v.MakeVector(v.RowSpace, 0, 3, -1, 8)))
+	return A, v.MakeVector(v.RowSpace, 6, 25, -11, 15)
+}
+
+// tridiagonal returns the positive-definite system with the solution (3, 4, -5)
+func tridiagonal() (m.Matrix, v.Vector) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 3, 0),
+		v.MakeVector(v.RowSpace, 3, 4, -1),
+		v.MakeVector(v.RowSpace, 0, -1, 4)))
+	return A, v.MakeVector(v.RowSpace, 24, 30, -24)
+}
+
+func TestJacobi(t *testing.T) {
+	A, b := diagonallyDominant()
+
+	result, errA := JacobiWithHistory(A, b, nil, 1e-10, 100)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1, 2, -1, 1}, result.Solution, 1e-9) {
+		t.Errorf("Expected [1 2 -1 1], received %v", result.Solution)
+	}
+
+	if len(result.Residuals) != result.Iterations+1 || result.Residuals[len(result.Residuals)-1] > 1e-10*norm(b) {
+		t.Errorf("Expected a residual for the initial guess and each of %d iterations, received %v", result.Iterations, result.Residuals)
+	}
+
+	if factor := result.ConvergenceFactor(); !(factor > 0 && factor < 1) {
+		t.Errorf("Expected a convergence factor between 0 and 1, received %v", factor)
+	}
+
+	// an exact initial guess needs no iteration
+	if exact, err := JacobiWithHistory(A, b, v.MakeVector(v.RowSpace, 1, 2, -1, 1), 1e-10, 100); err != nil || exact.Iterations != 0 {
+		t.Errorf("Expected no iterations, received %v and %v", exact.Iterations, err)
+	}
+
+	if x, err := Jacobi(A, b, nil, 1e-10, 100); err != nil || !vectorApproxEqual([]complex128{1, 2, -1, 1}, x, 1e-9) {
+		t.Errorf("Expected [1 2 -1 1], received %v and %v", x, err)
+	}
+
+	_, errB := Jacobi(A, b, nil, 1e-10, 5)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	// the jacobi iteration matrix of [[1 2] [2 1]] has spectral radius 2
+	divergent := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 2, 1)))
+
+	_, errC := Jacobi(divergent, v.MakeVector(v.RowSpace, 3, 3), nil, 1e-10, 2000)
+
+	if !errors.Is(errC, ErrDivergence) {
+		t.Errorf("Expected %v, received %v", ErrDivergence, errC)
+	}
+
+	A.Set(2, 2, 0)
+	_, errD := Jacobi(A, b, nil, 1e-10, 100)
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+
+	_, errE := Jacobi(A, v.MakeVector(v.RowSpace, 6, 25, -11), nil, 1e-10, 100)
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+
+	_, errF := Jacobi(A, b, nil, 0, 100)
+
+	if !errors.Is(errF, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errF)
+	}
+}
+
+func TestGaussSeidel(t *testing.T) {
+	A, b := diagonallyDominant()
+
+	result, errA := GaussSeidelWithHistory(A, b, v.MakeVector(v.RowSpace, 0, 0, 0, 0), 1e-10, 100)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1, 2, -1, 1}, result.Solution, 1e-9) {
+		t.Errorf("Expected [1 2 -1 1], received %v", result.Solution)
+	}
+
+	// gauss-seidel uses each new component at once and needs fewer iterations than jacobi
+	jacobi, _ := JacobiWithHistory(A, b, nil, 1e-10, 100)
+	if result.Iterations >= jacobi.Iterations {
+		t.Errorf("Expected fewer than %d iterations, received %d", jacobi.Iterations, result.Iterations)
+	}
+
+	// a complex hermitian positive-definite system
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1-1i),
+		v.MakeVector(v.RowSpace, 1+1i, 3)))
+
+	if x, err := GaussSeidel(complexA, v.MakeVector(v.RowSpace, 5-1i, 4+1i), nil, 1e-12, 100); err != nil || !vectorApproxEqual([]complex128{1, 1}, x, 1e-11) {
+		t.Errorf("Expected [1 1], received %v and %v", x, err)
+	}
+
+	_, errB := GaussSeidel(m.NewMatrix(2, 3), v.MakeVector(v.RowSpace, 1, 2), nil, 1e-10, 100)
+
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
+	}
+}
+
+func TestSOR(t *testing.T) {
+	A, b := tridiagonal()
+
+	result, errA := SORWithHistory(A, b, v.MakeVector(v.RowSpace, 1, 1, 1), 1.25, 1e-10, 100)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{3, 4, -5}, result.Solution, 1e-9) {
+		t.Errorf("Expected [3 4 -5], received %v", result.Solution)
+	}
+
+	// over-relaxation needs fewer iterations than gauss-seidel
+	gaussSeidel, _ := GaussSeidelWithHistory(A, b, v.MakeVector(v.RowSpace, 1, 1, 1), 1e-10, 100)
+	if result.Iterations >= gaussSeidel.Iterations {
+		t.Errorf("Expected fewer than %d iterations, received %d", gaussSeidel.Iterations, result.Iterations)
+	}
+
+	if x, err := SOR(A, b, nil, 1, 1e-10, 100); err != nil || !vectorApproxEqual([]complex128{3, 4, -5}, x, 1e-9) {
+		t.Errorf("Expected [3 4 -5], received %v and %v", x, err)
+	}
+
+	_, errB := SOR(A, b, nil, 2, 1e-10, 100)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := SOR(A, b, nil, 1.25, 1e-10, 0)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestOptimalOmega(t *testing.T) {
+	A, b := tridiagonal()
+
+	// the spectral radius of the jacobi iteration matrix is sqrt(0.625)
+	omega, errA := OptimalOmega(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if expected := 2 / (1 + math.Sqrt(0.375)); math.Abs(omega-expected) > 1e-12 {
+		t.Errorf("Expected %v, received %v", expected, omega)
+	}
+
+	optimal, _ := SORWithHistory(A, b, nil, omega, 1e-10, 100)
+	for _, other := range []float64{0.8, 1, 1.6} {
+		if result, _ := SORWithHistory(A, b, nil, other, 1e-10, 100); result.Iterations < optimal.Iterations {
+			t.Errorf("Expected omega = %v to need at least %d iterations, received %d", other, optimal.Iterations, result.Iterations)
+		}
+	}
+
+	_, errB := OptimalOmega(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 2, 1))))
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := OptimalOmega(m.NewMatrix(2, 2))
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestConjugateGradient(t *testing.T) {
+	A, b := tridiagonal()
+
+	// in exact arithmetic the method terminates within n iterations
+	result, errA := ConjugateGradientWithHistory(A, b, nil, 1e-12, 10)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{3, 4, -5}, result.Solution, 1e-11) {
+		t.Errorf("Expected [3 4 -5], received %v", result.Solution)
+	}
+
+	if result.Iterations > 4 {
+		t.Errorf("Expected at most 4 iterations, received %d", result.Iterations)
+	}
+
+	// the second difference matrix of order 50
+	degree := 50
+	difference := m.NewMatrix(degree, degree)
+	ones := v.NewVector(v.ColSpace, degree)
+	for i := 0; i < degree; i++ {
+		difference.Set(i, i, 2)
+		ones.Set(i, 1)
+		if i > 0 {
+			difference.Set(i, i-1, -1)
+			difference.Set(i-1, i, -1)
+		}
+	}
+
+	x, errB := ConjugateGradient(difference, multiply(difference, ones), nil, 1e-12, 100)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	expected := make([]complex128, degree)
+	for i := range expected {
+		expected[i] = 1
+	}
+	if !vectorApproxEqual(expected, x, 1e-9) {
+		t.Errorf("Expected ones, received %v", x)
+	}
+
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1-1i),
+		v.MakeVector(v.RowSpace, 1+1i, 3)))
+
+	if x, err := ConjugateGradient(complexA, v.MakeVector(v.RowSpace, 5-1i, 4+1i), nil, 1e-12, 10); err != nil || !vectorApproxEqual([]complex128{1, 1}, x, 1e-11) {
+		t.Errorf("Expected [1 1], received %v and %v", x, err)
+	}
+
+	indefinite := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 0),
+		v.MakeVector(v.RowSpace, 0, -1)))
+
+	_, errC := ConjugateGradient(indefinite, v.MakeVector(v.RowSpace, 0, 1), nil, 1e-12, 10)
+
+	if !errors.Is(errC, ErrNotPositiveDefinite) {
+		t.Errorf("Expected %v, received %v", ErrNotPositiveDefinite, errC)
+	}
+
+	_, errD := ConjugateGradient(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1),
+		v.MakeVector(v.RowSpace, 2, 3))), v.MakeVector(v.RowSpace, 1, 1), nil, 1e-12, 10)
+
+	if !errors.Is(errD, ErrNotHermitian) {
+		t.Errorf("Expected %v, received %v", ErrNotHermitian, errD)
+	}
+
+	_, errE := ConjugateGradient(difference, ones, nil, 1e-12, 3)
+
+	if !errors.Is(errE, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errE)
+	}
+}
+
+func TestPreconditionedConjugateGradient(t *testing.T) {
+	// a badly scaled positive-definite matrix, D T D for the second difference matrix T and D = diag(1, 10, ..., 10^5)
+	degree := 6
+	A := m.NewMatrix(degree, degree)
+	solution := v.NewVector(v.ColSpace, degree)
+	for i := 0; i < degree; i++ {
+		A.Set(i, i, 2*math.Pow(100, float64(i)))
+		solution.Set(i, float64(i+1))
+		if i > 0 {
+			A.Set(i, i-1, -math.Pow(10, float64(2*i-1)))
+			A.Set(i-1, i, -math.Pow(10, float64(2*i-1)))
+		}
+	}
+	b := multiply(A, solution)
+
+	jacobi, errA := JacobiPreconditioner(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	preconditioned, errB := PreconditionedConjugateGradientWithHistory(A, b, nil, jacobi, 1e-12, 100)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if !vectorApproxEqual([]complex128{1, 2, 3, 4, 5, 6}, preconditioned.Solution, 1e-8) {
+		t.Errorf("Expected [1 2 3 4 5 6], received %v", preconditioned.Solution)
+	}
+
+	// scaling by the diagonal undoes the bad scaling
+	plain, _ := ConjugateGradientWithHistory(A, b, nil, 1e-12, 100)
+	if preconditioned.Iterations >= plain.Iterations {
+		t.Errorf("Expected fewer than %d iterations, received %d", plain.Iterations, preconditioned.Iterations)
+	}
+
+	ssor, errC := SSORPreconditioner(A, 1.2)
+
+	if errC != nil {
+		t.Errorf("Unexpected error: %v", errC)
+	}
+
+	if x, err := PreconditionedConjugateGradient(A, b, nil, ssor, 1e-12, 100); err != nil || !vectorApproxEqual([]complex128{1, 2, 3, 4, 5, 6}, x, 1e-8) {
+		t.Errorf("Expected [1 2 3 4 5 6], received %v and %v", x, err)
+	}
+
+	// with the complete factorization as preconditioner a single iteration suffices
+	L, _ := Cholesky(A)
+	exact := func(r v.Vector) (v.Vector, error) {
+		y, err := ForwardSubstitution(L, r)
+		if err != nil {
+			return nil, err
+		}
+		return BackwardSubstitution(conjugateTranspose(L), y)
+	}
+
+	if result, err := PreconditionedConjugateGradientWithHistory(A, b, nil, exact, 1e-10, 100); err != nil || result.Iterations != 1 {
+		t.Errorf("Expected 1 iteration, received %v and %v", result.Iterations, err)
+	}
+
+	_, errD := JacobiPreconditioner(m.NewMatrix(2, 2))
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+
+	_, errE := SSORPreconditioner(A, 0)
+
+	if !errors.Is(errE, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errE)
+	}
+
+	_, errF := jacobi(v.MakeVector(v.RowSpace, 1, 2))
+
+	if !errors.Is(errF, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errF)
+	}
+}
+
+func TestILUPreconditioner(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 0),
+		v.MakeVector(v.RowSpace, -2, 4, -1),
+		v.MakeVector(v.RowSpace, 0, -2, 4)))
+
+	M, errA := ILUPreconditioner(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	// the factorization of a tridiagonal matrix is complete, so M inverts A
+	if z, err := M(v.MakeVector(v.RowSpace, 3, 1, 2)); err != nil || !vectorApproxEqual([]complex128{1, 1, 1}, z, 1e-12) {
+		t.Errorf("Expected [1 1 1], received %v and %v", z, err)
+	}
+
+	_, errB := ILUPreconditioner(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 0, 1),
+		v.MakeVector(v.RowSpace, 1, 0))))
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+}
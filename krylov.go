@@ -0,0 +1,342 @@
+package methods
+
+import (
+	"math/cmplx"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// LinearOperator returns the product A x of a linear operator A with x, so that a matrix need not be stored explicitly
+type LinearOperator func(x v.Vector) (v.Vector, error)
+
+// MatrixOperator returns the LinearOperator of the explicit matrix A
+func MatrixOperator(A m.Matrix) LinearOperator {
+	return func(x v.Vector) (v.Vector, error) {
+		rows, cols := A.Dim()
+		if x.Len() != cols {
+			return nil, &DimensionError{Name: "x", Expected: cols, Received: x.Len()}
+		}
+
+		product := v.NewVector(v.ColSpace, rows)
+		for i := 0; i < rows; i++ {
+			sum := gcv.Zero()
+			for j := 0; j < cols; j++ {
+				sum = gcvops.Add(sum, gcvops.Mult(A.Get(i, j), x.Get(j)))
+			}
+			product.Set(i, sum)
+		}
+		return product, nil
+	}
+}
+
+// GMRES is for solving the linear system A x = b by the generalized minimal residual method restarted every restart
+// iterations from the initial guess x0, or zero if x0 is nil, it solves left^-1 A right^-1 y = left^-1 b with x = right^-1 y,
+// where a nil preconditioner is the identity, and stops once |left^-1 (b - A x)| <= TOL |left^-1 b|
+// Algorithm from Iterative Methods for Sparse Linear Systems - By Saad
+func GMRES(A LinearOperator, b v.Vector, x0 v.Vector, restart int, left Preconditioner, right Preconditioner,
+	TOL float64, maxIteration int) (v.Vector, error) {
+	result, err := GMRESWithHistory(A, b, x0, restart, left, right, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// GMRESWithHistory is GMRES, also returning the history of the preconditioned residual, which GMRES minimizes
+func GMRESWithHistory(A LinearOperator, b v.Vector, x0 v.Vector, restart int, left Preconditioner, right Preconditioner,
+	TOL float64, maxIteration int) (IterativeSolverResult, error) {
+	var result IterativeSolverResult
+
+	if err := validateKrylov(b, x0, TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	if restart <= 0 {
+		return result, &ArgumentError{Name: "restart", Value: restart, Reason: "must be positive"}
+	}
+
+	operator := preconditionedOperator(A, left, right)
+	x := initialGuess(x0, b.Len())
+
+	target, err := preconditionedNorm(b, left)
+	if err != nil {
+		return result, err
+	}
+	target *= TOL
+
+	r, err := preconditionedResidual(A, left, x, b)
+	if err != nil {
+		return result, err
+	}
+
+	beta := norm(r)
+	if err := result.addResidual(beta); err != nil {
+		return result, result.iterationError("GMRES", err)
+	}
+
+	for beta > target {
+		// basis holds the orthonormal basis of the krylov space and hessenberg its projection of the operator
+		basis := []v.Vector{addScaled(v.NewVector(v.ColSpace, b.Len()), gcv.MakeValue(1/beta), r)}
+		hessenberg := make([][]complex128, restart+1)
+		for i := range hessenberg {
+			hessenberg[i] = make([]complex128, restart)
+		}
+		cosines := make([]complex128, restart)
+		sines := make([]complex128, restart)
+		rhs := make([]complex128, restart+1)
+		rhs[0] = complex(beta, 0)
+
+		size := 0
+		for size < restart && result.Iterations < maxIteration {
+			w, err := operator(basis[size])
+			if err != nil {
+				return result, err
+			}
+
+			for i := 0; i <= size; i++ {
+				projection := innerProduct(basis[i], w)
+				hessenberg[i][size] = projection.Complex()
+				w = addScaled(w, gcvops.Sub(gcv.Zero(), projection), basis[i])
+			}
+			length := norm(w)
+			hessenberg[size+1][size] = complex(length, 0)
+
+			// the earlier rotations and a new one reduce the hessenberg column to triangular form
+			for i := 0; i < size; i++ {
+				top, bottom := hessenberg[i][size], hessenberg[i+1][size]
+				hessenberg[i][size] = cosines[i]*top + sines[i]*bottom
+				hessenberg[i+1][size] = -cmplx.Conj(sines[i])*top + cosines[i]*bottom
+			}
+			cosines[size], sines[size] = givensCoefficients(hessenberg[size][size], hessenberg[size+1][size])
+			hessenberg[size][size] = cosines[size]*hessenberg[size][size] + sines[size]*hessenberg[size+1][size]
+			hessenberg[size+1][size] = 0
+			rhs[size+1] = -cmplx.Conj(sines[size]) * rhs[size]
+			rhs[size] = cosines[size] * rhs[size]
+
+			size++
+			result.Iterations++
+			if err := result.addResidual(cmplx.Abs(rhs[size])); err != nil {
+				return result, result.iterationError("GMRES", err)
+			}
+
+			if length == 0 || cmplx.Abs(rhs[size]) <= target {
+				break
+			}
+			basis = append(basis, addScaled(v.NewVector(v.ColSpace, b.Len()), gcv.MakeValue(1/length), w))
+		}
+
+		// the least squares solution of the triangular system gives the correction in the krylov space
+		y := make([]complex128, size)
+		for i := size - 1; i >= 0; i-- {
+			sum := rhs[i]
+			for j := i + 1; j < size; j++ {
+				sum -= hessenberg[i][j] * y[j]
+			}
+			if hessenberg[i][i] == 0 {
+				return result, result.iterationError("GMRES", ErrDivisionByZero)
+			}
+			y[i] = sum / hessenberg[i][i]
+		}
+
+		// the coefficients of a real system have zero imaginary parts and are kept real values
+		realCoefficients := isReal(y)
+		correction := v.NewVector(v.ColSpace, b.Len())
+		for i, coefficient := range y {
+			correction = addScaled(correction, gcv.MakeValue(complexEntry(coefficient, realCoefficients)), basis[i])
+		}
+		if correction, err = applyPreconditioner(right, correction); err != nil {
+			return result, err
+		}
+		x = addScaled(x, gcv.One(), correction)
+
+		if r, err = preconditionedResidual(A, left, x, b); err != nil {
+			return result, err
+		}
+		beta = norm(r)
+
+		if beta > target && result.Iterations >= maxIteration {
+			return result, result.iterationError("GMRES", ErrMaxIterations)
+		}
+	}
+
+	result.Solution = x
+	return result, nil
+}
+
+// BiCGSTAB is for solving the linear system A x = b by the biconjugate gradient stabilized method from the initial guess x0,
+// or zero if x0 is nil, it solves left^-1 A right^-1 y = left^-1 b with x = right^-1 y, where a nil preconditioner is the
+// identity, and stops once |left^-1 (b - A x)| <= TOL |left^-1 b|
+// Algorithm from Iterative Methods for Sparse Linear Systems - By Saad
+func BiCGSTAB(A LinearOperator, b v.Vector, x0 v.Vector, left Preconditioner, right Preconditioner, TOL float64,
+	maxIteration int) (v.Vector, error) {
+	result, err := BiCGSTABWithHistory(A, b, x0, left, right, TOL, maxIteration)
+	return result.Solution, err
+}
+
+// BiCGSTABWithHistory is BiCGSTAB, also returning the history of the preconditioned residual
+func BiCGSTABWithHistory(A LinearOperator, b v.Vector, x0 v.Vector, left Preconditioner, right Preconditioner, TOL float64,
+	maxIteration int) (IterativeSolverResult, error) {
+	var result IterativeSolverResult
+
+	if err := validateKrylov(b, x0, TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	operator := preconditionedOperator(A, left, right)
+	x := initialGuess(x0, b.Len())
+
+	target, err := preconditionedNorm(b, left)
+	if err != nil {
+		return result, err
+	}
+	target *= TOL
+
+	r, err := preconditionedResidual(A, left, x, b)
+	if err != nil {
+		return result, err
+	}
+
+	if err := result.addResidual(norm(r)); err != nil {
+		return result, result.iterationError("BiCGSTAB", err)
+	}
+
+	// y is the solution of the preconditioned system for the correction to x
+	y := v.NewVector(v.ColSpace, b.Len())
+	shadow := r
+	p := r
+	rho := innerProduct(shadow, r)
+
+	converged := norm(r) <= target
+	for !converged && result.Iterations < maxIteration {
+		if rho.Complex() == 0 {
+			return result, result.iterationError("BiCGSTAB", ErrDivisionByZero)
+		}
+
+		direction, err := operator(p)
+		if err != nil {
+			return result, err
+		}
+
+		denominator := innerProduct(shadow, direction)
+		if denominator.Complex() == 0 {
+			return result, result.iterationError("BiCGSTAB", ErrDivisionByZero)
+		}
+		alpha := gcvops.Div(rho, denominator)
+
+		s := addScaled(r, gcvops.Sub(gcv.Zero(), alpha), direction)
+		y = addScaled(y, alpha, p)
+		result.Iterations++
+
+		if norm(s) <= target {
+			if err := result.addResidual(norm(s)); err != nil {
+				return result, result.iterationError("BiCGSTAB", err)
+			}
+			converged = true
+			break
+		}
+
+		t, err := operator(s)
+		if err != nil {
+			return result, err
+		}
+
+		squaredNorm := innerProduct(t, t)
+		if squaredNorm.Complex() == 0 {
+			return result, result.iterationError("BiCGSTAB", ErrDivisionByZero)
+		}
+		omega := gcvops.Div(innerProduct(t, s), squaredNorm)
+
+		y = addScaled(y, omega, s)
+		r = addScaled(s, gcvops.Sub(gcv.Zero(), omega), t)
+
+		if err := result.addResidual(norm(r)); err != nil {
+			return result, result.iterationError("BiCGSTAB", err)
+		}
+		if converged = norm(r) <= target; converged {
+			break
+		}
+
+		if omega.Complex() == 0 {
+			return result, result.iterationError("BiCGSTAB", ErrDivisionByZero)
+		}
+
+		next := innerProduct(shadow, r)
+		beta := gcvops.Mult(gcvops.Div(next, rho), gcvops.Div(alpha, omega))
+		p = addScaled(r, beta, addScaled(p, gcvops.Sub(gcv.Zero(), omega), direction))
+		rho = next
+	}
+
+	correction, err := applyPreconditioner(right, y)
+	if err != nil {
+		return result, err
+	}
+	x = addScaled(x, gcv.One(), correction)
+
+	if !converged {
+		return result, result.iterationError("BiCGSTAB", ErrMaxIterations)
+	}
+
+	result.Solution = x
+	return result, nil
+}
+
+// validateKrylov checks the inputs of the krylov methods, the operator is checked as it is applied
+func validateKrylov(b v.Vector, x0 v.Vector, TOL float64, maxIteration int) error {
+	if x0 != nil && x0.Len() != b.Len() {
+		return &DimensionError{Name: "x0", Expected: b.Len(), Received: x0.Len()}
+	}
+
+	return validateRootFinding(TOL, maxIteration)
+}
+
+// applyPreconditioner applies M to r, a nil M is the identity
+func applyPreconditioner(M Preconditioner, r v.Vector) (v.Vector, error) {
+	if M == nil {
+		return r, nil
+	}
+	return M(r)
+}
+
+// preconditionedOperator returns the operator left^-1 A right^-1
+func preconditionedOperator(A LinearOperator, left Preconditioner, right Preconditioner) LinearOperator {
+	return func(x v.Vector) (v.Vector, error) {
+		z, err := applyPreconditioner(right, x)
+		if err != nil {
+			return nil, err
+		}
+
+		product, err := A(z)
+		if err != nil {
+			return nil, err
+		}
+
+		if product.Len() != x.Len() {
+			return nil, &DimensionError{Name: "A x", Expected: x.Len(), Received: product.Len()}
+		}
+
+		return applyPreconditioner(left, product)
+	}
+}
+
+// preconditionedResidual returns left^-1 (b - A x)
+func preconditionedResidual(A LinearOperator, left Preconditioner, x v.Vector, b v.Vector) (v.Vector, error) {
+	product, err := A(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Len() != b.Len() {
+		return nil, &DimensionError{Name: "A x", Expected: b.Len(), Received: product.Len()}
+	}
+
+	return applyPreconditioner(left, addScaled(b, gcv.MakeValue(-1), product))
+}
+
+// preconditionedNorm returns |left^-1 b|
+func preconditionedNorm(b v.Vector, left Preconditioner) (float64, error) {
+	z, err := applyPreconditioner(left, b)
+	if err != nil {
+		return 0, err
+	}
+	return norm(z), nil
+}
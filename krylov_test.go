@@ -0,0 +1,199 @@
+package methods
+
+import (
+	"errors"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// onesSystem returns the right hand side A (1, ..., 1) and the expected solution
+func onesSystem(A m.Matrix) (v.Vector, []complex128) {
+	degree, _ := A.Dim()
+	ones := v.NewVector(v.ColSpace, degree)
+	expected := make([]complex128, degree)
+	for i := range expected {
+		ones.Set(i, 1)
+		expected[i] = 1
+	}
+	return multiply(A, ones), expected
+}
+
+func TestMatrixOperator(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2, 3),
+		v.MakeVector(v.RowSpace, 4, 5, 6)))
+
+	if product, err := MatrixOperator(A)(v.MakeVector(v.RowSpace, 1, 0, -1)); err != nil || !vectorApproxEqual([]complex128{-2, -2}, product, 0) {
+		t.Errorf("Expected [-2 -2], received %v and %v", product, err)
+	}
+
+	_, err := MatrixOperator(A)(v.MakeVector(v.RowSpace, 1, 0))
+
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+}
+
+func TestGMRES(t *testing.T) {
+	A := convectionDiffusion(5, 0.4)
+	b, expected := onesSystem(A)
+
+	// without restarts GMRES terminates within n iterations
+	full, errA := GMRESWithHistory(MatrixOperator(A), b, nil, 25, nil, nil, 1e-12, 100)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual(expected, full.Solution, 1e-10) {
+		t.Errorf("Expected ones, received %v", full.Solution)
+	}
+
+	if len(full.Residuals) != full.Iterations+1 {
+		t.Errorf("Expected a residual for the initial guess and each of %d iterations, received %v", full.Iterations, full.Residuals)
+	}
+
+	// the residual of GMRES never increases
+	for i := 1; i < len(full.Residuals); i++ {
+		if full.Residuals[i] > full.Residuals[i-1]*(1+1e-12) {
+			t.Errorf("Expected non-increasing residuals, received %v", full.Residuals)
+		}
+	}
+
+	restarted, errB := GMRESWithHistory(MatrixOperator(A), b, nil, 5, nil, nil, 1e-12, 500)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if !vectorApproxEqual(expected, restarted.Solution, 1e-10) {
+		t.Errorf("Expected ones, received %v", restarted.Solution)
+	}
+
+	if restarted.Iterations < full.Iterations {
+		t.Errorf("Expected restarting to need at least %d iterations, received %d", full.Iterations, restarted.Iterations)
+	}
+
+	// an incomplete factorization preconditioner on either side cuts the iterations
+	M, _ := ILUPreconditioner(A)
+	for name, sides := range map[string][2]Preconditioner{"left": {M, nil}, "right": {nil, M}} {
+		preconditioned, err := GMRESWithHistory(MatrixOperator(A), b, nil, 25, sides[0], sides[1], 1e-12, 100)
+
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", name, err)
+		}
+
+		if !vectorApproxEqual(expected, preconditioned.Solution, 1e-10) {
+			t.Errorf("%v: expected ones, received %v", name, preconditioned.Solution)
+		}
+
+		if preconditioned.Iterations >= full.Iterations {
+			t.Errorf("%v: expected fewer than %d iterations, received %d", name, full.Iterations, preconditioned.Iterations)
+		}
+	}
+
+	// the operator of a complex circulant shift need not be stored
+	shift := func(x v.Vector) (v.Vector, error) {
+		y := v.NewVector(v.ColSpace, x.Len())
+		for i := 0; i < x.Len(); i++ {
+			y.Set(i, 2*x.Get(i).Complex()+1i*x.Get((i+1)%x.Len()).Complex())
+		}
+		return y, nil
+	}
+
+	if x, err := GMRES(shift, v.MakeVector(v.RowSpace, 2+1i, 2+1i, 2+1i, 2+1i), v.MakeVector(v.RowSpace, 0, 0, 0, 0), 4, nil, nil, 1e-12, 20); err != nil || !vectorApproxEqual([]complex128{1, 1, 1, 1}, x, 1e-10) {
+		t.Errorf("Expected [1 1 1 1], received %v and %v", x, err)
+	}
+
+	_, errC := GMRES(MatrixOperator(A), b, nil, 2, nil, nil, 1e-12, 6)
+
+	if !errors.Is(errC, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errC)
+	}
+
+	_, errD := GMRES(MatrixOperator(A), b, nil, 0, nil, nil, 1e-12, 100)
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+
+	_, errE := GMRES(MatrixOperator(A), v.MakeVector(v.RowSpace, 1, 2), nil, 5, nil, nil, 1e-12, 100)
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+
+	_, errF := GMRES(MatrixOperator(A), b, v.MakeVector(v.RowSpace, 1, 2), 5, nil, nil, 1e-12, 100)
+
+	if !errors.Is(errF, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errF)
+	}
+}
+
+func TestBiCGSTAB(t *testing.T) {
+	A := convectionDiffusion(5, 0.4)
+	b, expected := onesSystem(A)
+
+	plain, errA := BiCGSTABWithHistory(MatrixOperator(A), b, nil, nil, nil, 1e-12, 100)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual(expected, plain.Solution, 1e-10) {
+		t.Errorf("Expected ones, received %v", plain.Solution)
+	}
+
+	if len(plain.Residuals) != plain.Iterations+1 {
+		t.Errorf("Expected a residual for the initial guess and each of %d iterations, received %v", plain.Iterations, plain.Residuals)
+	}
+
+	M, _ := ILUPreconditioner(A)
+	for name, sides := range map[string][2]Preconditioner{"left": {M, nil}, "right": {nil, M}} {
+		preconditioned, err := BiCGSTABWithHistory(MatrixOperator(A), b, nil, sides[0], sides[1], 1e-12, 100)
+
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", name, err)
+		}
+
+		if !vectorApproxEqual(expected, preconditioned.Solution, 1e-10) {
+			t.Errorf("%v: expected ones, received %v", name, preconditioned.Solution)
+		}
+
+		if preconditioned.Iterations >= plain.Iterations {
+			t.Errorf("%v: expected fewer than %d iterations, received %d", name, plain.Iterations, preconditioned.Iterations)
+		}
+	}
+
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 3, 1i, 0),
+		v.MakeVector(v.RowSpace, -1, 4, 1-1i),
+		v.MakeVector(v.RowSpace, 0, 2i, 5)))
+
+	if x, err := BiCGSTAB(MatrixOperator(complexA), v.MakeVector(v.RowSpace, 3+1i, 4-1i, 5+2i), nil, nil, nil, 1e-12, 50); err != nil || !vectorApproxEqual([]complex128{1, 1, 1}, x, 1e-10) {
+		t.Errorf("Expected [1 1 1], received %v and %v", x, err)
+	}
+
+	// an exact initial guess needs no iteration
+	ones := v.NewVector(v.ColSpace, 25)
+	for i := 0; i < 25; i++ {
+		ones.Set(i, 1)
+	}
+	if exact, err := BiCGSTABWithHistory(MatrixOperator(A), b, ones, nil, nil, 1e-12, 100); err != nil || exact.Iterations != 0 {
+		t.Errorf("Expected no iterations, received %v and %v", exact.Iterations, err)
+	}
+
+	_, errB := BiCGSTAB(MatrixOperator(A), b, nil, nil, nil, 1e-12, 2)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	_, errC := BiCGSTAB(MatrixOperator(A), b, nil, nil, nil, -1, 100)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
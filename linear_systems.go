@@ -0,0 +1,183 @@
+package methods
+
+import (
+	"fmt"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// ForwardSubstitution solves L y = b for the lower triangular matrix L, the entries above the diagonal are not read
+func ForwardSubstitution(L m.Matrix, b v.Vector) (v.Vector, error) {
+	if !L.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	degree, _ := L.Dim()
+	if b.Len() != degree {
+		return nil, &DimensionError{Name: "b", Expected: degree, Received: b.Len()}
+	}
+
+	y := v.NewVector(v.ColSpace, degree)
+	for i := 0; i < degree; i++ {
+		if L.Get(i, i).Complex() == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+
+		sum := gcv.Zero()
+		for j := 0; j < i; j++ {
+			sum = gcvops.Add(sum, gcvops.Mult(L.Get(i, j), y.Get(j)))
+		}
+		y.Set(i, gcvops.Div(gcvops.Sub(b.Get(i), sum), L.Get(i, i)))
+	}
+
+	return y, nil
+}
+
+// BackwardSubstitution solves U x = y for the upper triangular matrix U, the entries below the diagonal are not read
+func BackwardSubstitution(U m.Matrix, y v.Vector) (v.Vector, error) {
+	if !U.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	degree, _ := U.Dim()
+	if y.Len() != degree {
+		return nil, &DimensionError{Name: "y", Expected: degree, Received: y.Len()}
+	}
+
+	x := v.NewVector(v.ColSpace, degree)
+	for i := degree - 1; i >= 0; i-- {
+		if U.Get(i, i).Complex() == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+
+		sum := gcv.Zero()
+		for j := i + 1; j < degree; j++ {
+			sum = gcvops.Add(sum, gcvops.Mult(U.Get(i, j), x.Get(j)))
+		}
+		x.Set(i, gcvops.Div(gcvops.Sub(y.Get(i), sum), U.Get(i, i)))
+	}
+
+	return x, nil
+}
+
+// SolveLU solves A x = b given the factorization P A = L U returned by LU, so that a factorization
+// can be reused for any number of right hand sides
+func SolveLU(L, U, P m.Matrix, b v.Vector) (v.Vector, error) {
+	if !P.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	degree, _ := P.Dim()
+	if b.Len() != degree {
+		return nil, &DimensionError{Name: "b", Expected: degree, Received: b.Len()}
+	}
+
+	y, err := ForwardSubstitution(L, multiply(P, b))
+	if err != nil {
+		return nil, err
+	}
+
+	return BackwardSubstitution(U, y)
+}
+
+// Solve solves the linear system A x = b by LU factorization with partial pivoting and forward and backward substitution,
+// a singular A returns a SingularError
+func Solve(A m.Matrix, b v.Vector) (v.Vector, error) {
+	return SolvePivot(A, b, PartialPivoting)
+}
+
+// SolvePivot solves the linear system A x = b by LU factorization with the given pivoting
+func SolvePivot(A m.Matrix, b v.Vector, pivoting Pivoting) (v.Vector, error) {
+	L, U, P, Q, err := LUPivot(A, pivoting)
+	if err != nil {
+		return nil, err
+	}
+
+	z, err := SolveLU(L, U, P, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiply(Q, z), nil
+}
+
+// SolveMany solves A x = b for each of the right hand sides in B, A is factorized once with partial pivoting
+func SolveMany(A m.Matrix, B v.Vectors) (v.Vectors, error) {
+	L, U, P, _, err := LUPivot(A, PartialPivoting)
+	if err != nil {
+		return nil, err
+	}
+
+	degree, _ := A.Dim()
+	solutions := v.MakeVectors(v.ColSpace)
+	for k := 0; k < B.Len(); k++ {
+		if B.Get(k).Len() != degree {
+			return nil, &DimensionError{Name: fmt.Sprintf("B[%d]", k), Expected: degree, Received: B.Get(k).Len()}
+		}
+
+		x, err := SolveLU(L, U, P, B.Get(k))
+		if err != nil {
+			return nil, err
+		}
+		solutions.Append(x)
+	}
+
+	return solutions, nil
+}
+
+// SolveLeastSquares returns the x that minimizes the euclidean norm of A x - b for the m x n matrix A by householder QR
+// with column pivoting, if A is rank deficient the basic solution that is zero in the n - rank trailing pivoted columns is returned
+func SolveLeastSquares(A m.Matrix, b v.Vector) (v.Vector, error) {
+	rows, cols := A.Dim()
+	if b.Len() != rows {
+		return nil, &DimensionError{Name: "b", Expected: rows, Received: b.Len()}
+	}
+
+	Q, R, P, rank, err := QRPivot(A, Householder, EconomyQR)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the leading rank rows of Q* b and columns of R take part
+	leading := m.NewMatrix(rank, rank)
+	projection := v.NewVector(v.ColSpace, rank)
+	for i := 0; i < rank; i++ {
+		for j := i; j < rank; j++ {
+			leading.Set(i, j, R.Get(i, j))
+		}
+		sum := gcv.Zero()
+		for k := 0; k < rows; k++ {
+			sum = gcvops.Add(sum, gcvops.Mult(conjugate(Q.Get(k, i)), b.Get(k)))
+		}
+		projection.Set(i, sum)
+	}
+
+	z, err := BackwardSubstitution(leading, projection)
+	if err != nil {
+		return nil, err
+	}
+
+	padded := v.NewVector(v.ColSpace, cols)
+	for i := 0; i < rank; i++ {
+		padded.Set(i, z.Get(i))
+	}
+
+	return multiply(P, padded), nil
+}
+
+// multiply returns the product of the square matrix A and the vector x
+func multiply(A m.Matrix, x v.Vector) v.Vector {
+	degree, _ := A.Dim()
+	product := v.NewVector(v.ColSpace, degree)
+	for i := 0; i < degree; i++ {
+		sum := gcv.Zero()
+		for j := 0; j < degree; j++ {
+			sum = gcvops.Add(sum, gcvops.Mult(A.Get(i, j), x.Get(j)))
+		}
+		product.Set(i, sum)
+	}
+	return product
+}
@@ -0,0 +1,217 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// vectorApproxEqual compares a vector against the expected values
+func vectorApproxEqual(expected []complex128, received v.Vector, TOL float64) bool {
+	if received.Len() != len(expected) {
+		return false
+	}
+
+	for i, value := range expected {
+		if cmplx.Abs(received.Get(i).Complex()-value) > TOL*math.Max(1, cmplx.Abs(value)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestForwardSubstitution(t *testing.T) {
+	// the upper entries are not read
+	L := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 2, 9, 9),
+		v.MakeVector(v.RowSpace, 1, 1, 9),
+		v.MakeVector(v.RowSpace, -1, 2, 4)))
+
+	y, errA := ForwardSubstitution(L, v.MakeVector(v.RowSpace, 2, 3, 11))
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1, 2, 2}, y, 1e-12) {
+		t.Errorf("Expected [1 2 2], received %v", y)
+	}
+
+	_, errB := ForwardSubstitution(L, v.MakeVector(v.RowSpace, 2, 3))
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	L.Set(1, 1, 0)
+	_, errC := ForwardSubstitution(L, v.MakeVector(v.RowSpace, 2, 3, 11))
+
+	var singularErr *SingularError
+	if !errors.As(errC, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected singular pivot at 1, received %v", errC)
+	}
+}
+
+func TestBackwardSubstitution(t *testing.T) {
+	// the lower entries are not read
+	U := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 2, 1, -1),
+		v.MakeVector(v.RowSpace, 9, 1i, 2),
+		v.MakeVector(v.RowSpace, 9, 9, 4)))
+
+	x, errA := BackwardSubstitution(U, v.MakeVector(v.RowSpace, 1, 4+2i, 8))
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{0.5, 2, 2}, x, 1e-12) {
+		t.Errorf("Expected [0.5 2 2], received %v", x)
+	}
+
+	_, errB := BackwardSubstitution(m.NewMatrix(2, 3), v.MakeVector(v.RowSpace, 1, 2))
+
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
+	}
+
+	U.Set(2, 2, 0)
+	_, errC := BackwardSubstitution(U, v.MakeVector(v.RowSpace, 1, 4+2i, 8))
+
+	var singularErr *SingularError
+	if !errors.As(errC, &singularErr) || singularErr.Pivot != 2 {
+		t.Errorf("Expected singular pivot at 2, received %v", errC)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// the leading zero needs a row interchange
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 0, 0, -1, 1),
+		v.MakeVector(v.RowSpace, 1, 1, -1, 2),
+		v.MakeVector(v.RowSpace, -1, -1, 2, 0),
+		v.MakeVector(v.RowSpace, 1, 2, 0, 2)))
+
+	x, errA := Solve(A, v.MakeVector(v.RowSpace, -1, 0, 4, 1))
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1, -1, 2, 1}, x, 1e-12) {
+		t.Errorf("Expected [1 -1 2 1], received %v", x)
+	}
+
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1i, 1),
+		v.MakeVector(v.RowSpace, 1, 2)))
+
+	if x, err := Solve(complexA, v.MakeVector(v.RowSpace, 1+1i, 3)); err != nil || !vectorApproxEqual([]complex128{1, 1}, x, 1e-12) {
+		t.Errorf("Expected [1 1], received %v and %v", x, err)
+	}
+
+	// the last pivot of a rank deficient matrix vanishes
+	singularA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 2, 4)))
+
+	_, errB := Solve(singularA, v.MakeVector(v.RowSpace, 1, 2))
+
+	var singularErr *SingularError
+	if !errors.As(errB, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected singular pivot at 1, received %v", errB)
+	}
+
+	_, errC := Solve(A, v.MakeVector(v.RowSpace, -1, 0, 4))
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := Solve(m.NewMatrix(2, 3), v.MakeVector(v.RowSpace, 1, 2))
+
+	if !errors.Is(errD, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errD)
+	}
+}
+
+func TestSolveMany(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 4.25, 2.75),
+		v.MakeVector(v.RowSpace, 1, 2.75, 3.5)))
+
+	B := v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 5, 15.75, 17),
+		v.MakeVector(v.RowSpace, 4, -1, 1))
+
+	solutions, errA := SolveMany(A, B)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if solutions.Len() != 2 || !vectorApproxEqual([]complex128{1, 2, 3}, solutions.Get(0), 1e-12) || !vectorApproxEqual([]complex128{1, 0, 0}, solutions.Get(1), 1e-12) {
+		t.Errorf("Expected [1 2 3] and [1 0 0], received %v", solutions)
+	}
+
+	B.Append(v.MakeVector(v.RowSpace, 1, 2))
+	_, errB := SolveMany(A, B)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestSolveLeastSquares(t *testing.T) {
+	// the line through (0, 1), (1, 3), (2, 4) and (3, 4) of least squares is 1.5 + x
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 0),
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 1, 3)))
+
+	x, errA := SolveLeastSquares(A, v.MakeVector(v.RowSpace, 1, 3, 4, 4))
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !vectorApproxEqual([]complex128{1.5, 1}, x, 1e-12) {
+		t.Errorf("Expected [1.5 1], received %v", x)
+	}
+
+	// a consistent square system is solved exactly
+	square := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1i, 1),
+		v.MakeVector(v.RowSpace, 1, 2)))
+
+	if x, err := SolveLeastSquares(square, v.MakeVector(v.RowSpace, 1+1i, 3)); err != nil || !vectorApproxEqual([]complex128{1, 1}, x, 1e-12) {
+		t.Errorf("Expected [1 1], received %v and %v", x, err)
+	}
+
+	// with equal columns the basic solution puts everything on the first
+	deficient := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 1, 1)))
+
+	if x, err := SolveLeastSquares(deficient, v.MakeVector(v.RowSpace, 1, 2, 3)); err != nil || !vectorApproxEqual([]complex128{2, 0}, x, 1e-12) {
+		t.Errorf("Expected [2 0], received %v and %v", x, err)
+	}
+
+	if x, err := SolveLeastSquares(m.NewMatrix(2, 2), v.MakeVector(v.RowSpace, 1, 2)); err != nil || !vectorApproxEqual([]complex128{0, 0}, x, 0) {
+		t.Errorf("Expected [0 0], received %v and %v", x, err)
+	}
+
+	_, errB := SolveLeastSquares(A, v.MakeVector(v.RowSpace, 1, 3, 4))
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
@@ -1,18 +1,19 @@
 package methods
 
 import (
-	"errors"
+	"math"
+	"math/cmplx"
 
 	m "github.com/NumberXNumbers/types/gc/matrices"
-	v "github.com/NumberXNumbers/types/gc/vectors"
 	gcv "github.com/NumberXNumbers/types/gc/values"
 	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
+	v "github.com/NumberXNumbers/types/gc/vectors"
 )
 
 // LU will return an L U decomposition of matrix A as well as any permutation matrix P, else error
 func LU(A m.Matrix) (L, U, P m.Matrix, err error) {
 	if !A.IsSquare() {
-		return nil, nil, nil, errors.New("Matrix is not square")
+		return nil, nil, nil, ErrNotSquare
 	}
 	degree, _ := A.Dim()
 	matrixCopy := A.Copy()
@@ -44,7 +45,7 @@ func LU(A m.Matrix) (L, U, P m.Matrix, err error) {
 				}
 				count++
 				if count == degree {
-					return nil, nil, nil, errors.New("Unable to factorize matrix")
+					return nil, nil, nil, &SingularError{Pivot: i}
 				}
 			}
 		}
@@ -66,46 +67,715 @@ func LU(A m.Matrix) (L, U, P m.Matrix, err error) {
 	return l, u, p, nil
 }
 
-// LDLt will return the L D mattrices of the L D Lt facorization of matrix A if it is
-// hermitian positive-definite else error
-// TODO: add in checks for positive-definite and hermitian forms
-func LDLt(A m.Matrix) (L, D  m.Matrix, err error) {
+// ILU will return the incomplete L U factorization of matrix A without fill-in, ILU(0), the elimination of LUPivot
+// without pivoting restricted to the nonzero pattern of A, so that L and U keep the pattern of the lower and upper parts
+// of A and L U agrees with A on it, else error
+// Algorithm from Iterative Methods for Sparse Linear Systems - By Saad
+func ILU(A m.Matrix) (L, U m.Matrix, err error) {
 	if !A.IsSquare() {
-		return nil, nil, errors.New("Matrix is not square")
+		return nil, nil, ErrNotSquare
 	}
 	degree, _ := A.Dim()
-	l := m.NewMatrix(degree, degree)
-	d := m.NewMatrix(degree, degree)
+	a := A.Copy()
+
+	pattern := func(i, j int) bool {
+		return A.Get(i, j).Complex() != 0
+	}
+
+	for k := 0; k < degree; k++ {
+		if a.Get(k, k).Complex() == 0 {
+			return nil, nil, &SingularError{Pivot: k}
+		}
+		eliminate(a, k, pattern)
+	}
+
+	L, U = splitLU(a)
+	return L, U, nil
+}
+
+// Pivoting selects how LUPivot chooses the pivot of each elimination step
+type Pivoting int
+
+const (
+	// PartialPivoting takes the entry of largest magnitude in the pivot column
+	PartialPivoting Pivoting = iota
+	// ScaledPartialPivoting takes the entry of the pivot column that is largest relative to the largest entry of its row
+	ScaledPartialPivoting
+	// RookPivoting alternates between the pivot column and row until it finds an entry that is largest in both
+	RookPivoting
+	// CompletePivoting takes the entry of largest magnitude in the remaining submatrix
+	CompletePivoting
+)
+
+// LUPivot will return the L U decomposition P A Q = L U of matrix A with the row permutation P and the column
+// permutation Q chosen by pivoting, Q is the identity unless rook or complete pivoting is used, else error
+// Algorithm from Numerical Analysis - By Burden and Faires
+func LUPivot(A m.Matrix, pivoting Pivoting) (L, U, P, Q m.Matrix, err error) {
+	if !A.IsSquare() {
+		return nil, nil, nil, nil, ErrNotSquare
+	}
+	degree, _ := A.Dim()
+	a := A.Copy()
+	rows := make([]int, degree)
+	cols := make([]int, degree)
+	scale := make([]float64, degree)
 
 	for i := 0; i < degree; i++ {
-		sumLi := gcv.Zero()
-		diff := gcv.Zero()
-		sumLj := gcv.Zero()
-		counter := 0
-		tempVector := v.NewVector(v.RowSpace, i+1)
+		rows[i], cols[i] = i, i
 		for j := 0; j < degree; j++ {
-			if j <= i - 1 {
-				tempValue := gcvops.Mult(l.Get(i, j), d.Get(j, j))
-				tempVector.Set(j, tempValue)
-				sumLi = gcvops.Add(sumLi, gcvops.Mult(l.Get(i, j), tempValue))
-			} else if j == i {
-				diff = gcvops.Sub(A.Get(i, i), sumLi)
-				if diff.Complex() == 0 {
-					return nil, nil, errors.New("Matrix can not be factorized")
+			if magnitude := cmplx.Abs(a.Get(i, j).Complex()); magnitude > scale[i] {
+				scale[i] = magnitude
+			}
+		}
+		if scale[i] == 0 {
+			return nil, nil, nil, nil, &SingularError{Pivot: i}
+		}
+	}
+
+	magnitude := func(i, j int) float64 {
+		return cmplx.Abs(a.Get(i, j).Complex())
+	}
+
+	for k := 0; k < degree; k++ {
+		pivotRow, pivotCol := k, k
+
+		switch pivoting {
+		case ScaledPartialPivoting:
+			for i := k + 1; i < degree; i++ {
+				if magnitude(i, k)/scale[i] > magnitude(pivotRow, k)/scale[pivotRow] {
+					pivotRow = i
+				}
+			}
+		case RookPivoting:
+			for {
+				for i := k; i < degree; i++ {
+					if magnitude(i, pivotCol) > magnitude(pivotRow, pivotCol) {
+						pivotRow = i
+					}
+				}
+				moved := false
+				for j := k; j < degree; j++ {
+					if magnitude(pivotRow, j) > magnitude(pivotRow, pivotCol) {
+						pivotCol, moved = j, true
+					}
+				}
+				if !moved {
+					break
+				}
+			}
+		case CompletePivoting:
+			for i := k; i < degree; i++ {
+				for j := k; j < degree; j++ {
+					if magnitude(i, j) > magnitude(pivotRow, pivotCol) {
+						pivotRow, pivotCol = i, j
+					}
 				}
-				d.Set(i, i, diff)
-				l.Set(i, i, 1)
-			} else {
-				if j > tempVector.Len() {
-					sumLi = gcv.Zero()
-				} else {
-					sumLj = gcvops.Add(sumLj, gcvops.Mult(l.Get(j, counter), tempVector.Get(counter)))
+			}
+		default:
+			for i := k + 1; i < degree; i++ {
+				if magnitude(i, k) > magnitude(pivotRow, k) {
+					pivotRow = i
 				}
-				div := gcvops.Div(gcvops.Sub(A.Get(j, i), sumLj), diff)
-				l.Set(j, i, div)
-				counter++
 			}
 		}
+
+		if a.Get(pivotRow, pivotCol).Complex() == 0 {
+			return nil, nil, nil, nil, &SingularError{Pivot: k}
+		}
+
+		if pivotRow != k {
+			a.Swap(k, pivotRow)
+			rows[k], rows[pivotRow] = rows[pivotRow], rows[k]
+			scale[k], scale[pivotRow] = scale[pivotRow], scale[k]
+		}
+
+		if pivotCol != k {
+			for i := 0; i < degree; i++ {
+				value := a.Get(i, k)
+				a.Set(i, k, a.Get(i, pivotCol))
+				a.Set(i, pivotCol, value)
+			}
+			cols[k], cols[pivotCol] = cols[pivotCol], cols[k]
+		}
+
+		eliminate(a, k, nil)
+	}
+
+	l, u := splitLU(a)
+	p := m.NewMatrix(degree, degree)
+	q := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		p.Set(i, rows[i], 1)
+		q.Set(cols[i], i, 1)
+	}
+
+	return l, u, p, q, nil
+}
+
+// eliminate subtracts multiples of row k of a from the rows below it and stores the multipliers below the diagonal,
+// only the entries for which keep is true are updated, a nil keep updates every entry
+func eliminate(a m.Matrix, k int, keep func(i, j int) bool) {
+	degree, _ := a.Dim()
+	for i := k + 1; i < degree; i++ {
+		if keep != nil && !keep(i, k) {
+			continue
+		}
+		multiplier := gcvops.Div(a.Get(i, k), a.Get(k, k))
+		a.Set(i, k, multiplier)
+		for j := k + 1; j < degree; j++ {
+			if keep == nil || keep(i, j) {
+				a.Set(i, j, gcvops.Sub(a.Get(i, j), gcvops.Mult(multiplier, a.Get(k, j))))
+			}
+		}
+	}
+}
+
+// splitLU returns the unit lower triangular L of the multipliers below the diagonal of a and the upper triangular U
+// of the rest of a
+func splitLU(a m.Matrix) (L, U m.Matrix) {
+	degree, _ := a.Dim()
+	l := m.NewMatrix(degree, degree)
+	u := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		l.Set(i, i, 1)
+		for j := 0; j < i; j++ {
+			l.Set(i, j, a.Get(i, j))
+		}
+		for j := i; j < degree; j++ {
+			u.Set(i, j, a.Get(i, j))
+		}
+	}
+	return l, u
+}
+
+// LDLt will return the L D matrices of the L D L* factorization of matrix A, where L* is the conjugate transpose of L,
+// if it is hermitian positive-definite else error
+// Algorithm from Numerical Analysis - By Burden and Faires
+func LDLt(A m.Matrix) (L, D m.Matrix, err error) {
+	if err := validateHermitian(A); err != nil {
+		return nil, nil, err
+	}
+	degree, _ := A.Dim()
+	l := m.NewMatrix(degree, degree)
+	d := m.NewMatrix(degree, degree)
+
+	for i := 0; i < degree; i++ {
+		// products holds the entries of row i of L D
+		products := make([]gcv.Value, i)
+		diff := A.Get(i, i)
+		for j := 0; j < i; j++ {
+			products[j] = gcvops.Mult(l.Get(i, j), d.Get(j, j))
+			diff = gcvops.Sub(diff, gcvops.Mult(products[j], conjugate(l.Get(i, j))))
+		}
+
+		if err := validateDefinitePivot(diff, i); err != nil {
+			return nil, nil, err
+		}
+		// the pivots of a hermitian matrix are real, only rounding leaves an imaginary part
+		diff = gcv.MakeValue(diff.Real())
+		d.Set(i, i, diff)
+		l.Set(i, i, 1)
+
+		for j := i + 1; j < degree; j++ {
+			sum := gcv.Zero()
+			for k := 0; k < i; k++ {
+				sum = gcvops.Add(sum, gcvops.Mult(l.Get(j, k), conjugate(products[k])))
+			}
+			l.Set(j, i, gcvops.Div(gcvops.Sub(A.Get(j, i), sum), diff))
+		}
 	}
 	return l, d, nil
 }
+
+// Cholesky will return the lower triangular matrix L of the L L* factorization of matrix A, where L* is the conjugate
+// transpose of L, if it is hermitian positive-definite else error
+// Algorithm from Numerical Analysis - By Burden and Faires
+func Cholesky(A m.Matrix) (L m.Matrix, err error) {
+	if err := validateHermitian(A); err != nil {
+		return nil, err
+	}
+	degree, _ := A.Dim()
+	l := m.NewMatrix(degree, degree)
+
+	for i := 0; i < degree; i++ {
+		diff := A.Get(i, i)
+		for k := 0; k < i; k++ {
+			diff = gcvops.Sub(diff, gcvops.Mult(l.Get(i, k), conjugate(l.Get(i, k))))
+		}
+
+		if err := validateDefinitePivot(diff, i); err != nil {
+			return nil, err
+		}
+		l.Set(i, i, math.Sqrt(diff.Real()))
+
+		for j := i + 1; j < degree; j++ {
+			sum := gcv.Zero()
+			for k := 0; k < i; k++ {
+				sum = gcvops.Add(sum, gcvops.Mult(l.Get(j, k), conjugate(l.Get(i, k))))
+			}
+			l.Set(j, i, gcvops.Div(gcvops.Sub(A.Get(j, i), sum), l.Get(i, i)))
+		}
+	}
+	return l, nil
+}
+
+// QRMethod selects how QR orthogonalizes the columns of a matrix
+type QRMethod int
+
+const (
+	// Householder reduces the columns with reflections, it is the most stable of the methods
+	Householder QRMethod = iota
+	// Givens reduces the columns with plane rotations, one subdiagonal entry at a time
+	Givens
+	// ModifiedGramSchmidt orthogonalizes each column against the previous ones
+	ModifiedGramSchmidt
+)
+
+// QRMode selects the shape of the factors returned by QR
+type QRMode int
+
+const (
+	// FullQR returns the unitary m x m matrix Q and the upper trapezoidal m x n matrix R
+	FullQR QRMode = iota
+	// EconomyQR returns the first k = min(m, n) columns of Q and the first k rows of R
+	EconomyQR
+)
+
+// QR will return the Q R decomposition of the m x n matrix A, where Q has orthonormal columns
+// and R is upper triangular, with the given method and mode, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func QR(A m.Matrix, method QRMethod, mode QRMode) (Q, R m.Matrix, err error) {
+	Q, R, _, _, err = qrFactorization(A, method, mode, false)
+	return Q, R, err
+}
+
+// QRPivot will return the Q R decomposition A P = Q R of the m x n matrix A with the column permutation P
+// that moves the column of largest remaining norm forward at each step, so that the diagonal of R does not increase
+// in magnitude, and the numerical rank, the number of diagonal entries of R larger than max(m, n) times the machine
+// epsilon times the first, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func QRPivot(A m.Matrix, method QRMethod, mode QRMode) (Q, R, P m.Matrix, rank int, err error) {
+	return qrFactorization(A, method, mode, true)
+}
+
+// conjugate returns the complex conjugate of x, real values are returned unchanged
+func conjugate(x gcv.Value) gcv.Value {
+	if imag(x.Complex()) == 0 {
+		return x
+	}
+	return gcv.MakeValue(cmplx.Conj(x.Complex()))
+}
+
+// machineEpsilon is the distance from 1 to the next larger float64
+const machineEpsilon = 0x1p-52
+
+// qrFactorization computes the factors of QR and QRPivot on complex entries, the columns are pivoted if pivot is set
+func qrFactorization(A m.Matrix, method QRMethod, mode QRMode, pivot bool) (Q, R, P m.Matrix, rank int, err error) {
+	if method < Householder || method > ModifiedGramSchmidt {
+		return nil, nil, nil, 0, &ArgumentError{Name: "method", Value: method, Reason: "must be Householder, Givens or ModifiedGramSchmidt"}
+	}
+
+	if mode != FullQR && mode != EconomyQR {
+		return nil, nil, nil, 0, &ArgumentError{Name: "mode", Value: mode, Reason: "must be FullQR or EconomyQR"}
+	}
+
+	rows, cols := A.Dim()
+	if rows == 0 || cols == 0 {
+		return nil, nil, nil, 0, &DimensionError{Name: "A", Expected: 1, Received: 0}
+	}
+	size := rows
+	if cols < size {
+		size = cols
+	}
+
+	a := toComplex(A)
+
+	permutation := make([]int, cols)
+	for j := range permutation {
+		permutation[j] = j
+	}
+
+	// swapColumns moves the column of largest norm below row k into column k
+	swapColumns := func(k int) {
+		largest, best := -1.0, k
+		for j := k; j < cols; j++ {
+			if norm := columnNorm(a, j, k, rows); norm > largest {
+				largest, best = norm, j
+			}
+		}
+		for i := range a {
+			a[i][k], a[i][best] = a[i][best], a[i][k]
+		}
+		permutation[k], permutation[best] = permutation[best], permutation[k]
+	}
+
+	var q [][]complex128
+	switch method {
+	case Givens:
+		q = identity(rows)
+		for k := 0; k < size; k++ {
+			if pivot {
+				swapColumns(k)
+			}
+			for i := rows - 1; i > k; i-- {
+				givensRotation(a, q, k, i)
+			}
+		}
+	case ModifiedGramSchmidt:
+		q = gramSchmidt(a, rows, cols, size, pivot, permutation)
+	default:
+		q = identity(rows)
+		for k := 0; k < size; k++ {
+			if pivot {
+				swapColumns(k)
+			}
+			householderReflection(a, q, k)
+		}
+	}
+
+	if method == ModifiedGramSchmidt && mode == FullQR {
+		for len(q[0]) < rows {
+			q = appendOrthonormal(q)
+		}
+	}
+
+	qCols, rRows := rows, rows
+	if mode == EconomyQR {
+		qCols, rRows = size, size
+	}
+
+	qEntries := make([][]complex128, rows)
+	for i := range qEntries {
+		qEntries[i] = q[i][:qCols]
+	}
+	Q = fromComplex(qEntries)
+
+	rEntries := make([][]complex128, rRows)
+	for i := range rEntries {
+		rEntries[i] = make([]complex128, cols)
+		if i < rows {
+			copy(rEntries[i][i:], a[i][i:])
+		}
+	}
+	R = fromComplex(rEntries)
+
+	P = m.NewMatrix(cols, cols)
+	for j := 0; j < cols; j++ {
+		P.Set(permutation[j], j, 1)
+	}
+
+	if size > 0 {
+		largest := rows
+		if cols > largest {
+			largest = cols
+		}
+		TOL := float64(largest) * machineEpsilon * cmplx.Abs(a[0][0])
+		for rank < size && cmplx.Abs(a[rank][rank]) > TOL {
+			rank++
+		}
+	}
+
+	return Q, R, P, rank, nil
+}
+
+// householderReflection applies the reflection that zeroes column k of a below the diagonal to a,
+// and accumulates it into the columns of q
+func householderReflection(a, q [][]complex128, k int) {
+	reflector, scale, value := householderReflector(a, k, k)
+	if reflector == nil {
+		return
+	}
+
+	reflectRows(a, reflector, scale, k, k)
+	reflectColumns(q, reflector, scale, k)
+
+	a[k][k] = value
+	for i := k + 1; i < len(a); i++ {
+		a[i][k] = 0
+	}
+}
+
+// householderReflector returns the vector v and the scale 2 / (v* v) of the reflection I - scale v v* that maps
+// rows start to the last of column col of a onto a multiple of the first standard basis vector, and the entry it leaves
+// in row start, v is nil if those rows are already zero below row start
+func householderReflector(a [][]complex128, start, col int) (reflector []complex128, scale complex128, value complex128) {
+	rows := len(a)
+	if rows-start < 2 || columnNorm(a, col, start+1, rows) == 0 {
+		return nil, 0, a[start][col]
+	}
+	alpha := columnNorm(a, col, start, rows)
+
+	// the sign of the first entry is matched to avoid cancellation
+	phase := complex(1, 0)
+	if a[start][col] != 0 {
+		phase = a[start][col] / complex(cmplx.Abs(a[start][col]), 0)
+	}
+
+	reflector = columnSlice(a, col, start, rows)
+	reflector[0] += phase * complex(alpha, 0)
+
+	var squaredNorm float64
+	for _, entry := range reflector {
+		squaredNorm += real(entry)*real(entry) + imag(entry)*imag(entry)
+	}
+
+	return reflector, complex(2/squaredNorm, 0), -phase * complex(alpha, 0)
+}
+
+// reflectRows multiplies rows start to start + len(reflector) - 1 of a from the left by the reflection,
+// only the columns from col on are updated
+func reflectRows(a [][]complex128, reflector []complex128, scale complex128, start, col int) {
+	for j := col; j < len(a[start]); j++ {
+		var sum complex128
+		for i, entry := range reflector {
+			sum += cmplx.Conj(entry) * a[start+i][j]
+		}
+		for i, entry := range reflector {
+			a[start+i][j] -= scale * sum * entry
+		}
+	}
+}
+
+// reflectColumns multiplies columns start to start + len(reflector) - 1 of a from the right by the reflection
+func reflectColumns(a [][]complex128, reflector []complex128, scale complex128, start int) {
+	for i := range a {
+		var sum complex128
+		for l, entry := range reflector {
+			sum += a[i][start+l] * entry
+		}
+		for l, entry := range reflector {
+			a[i][start+l] -= scale * sum * cmplx.Conj(entry)
+		}
+	}
+}
+
+// givensRotation applies the rotation of rows k and i that zeroes the entry of a in row i and column k to a,
+// and accumulates its conjugate transpose into the columns of q
+func givensRotation(a, q [][]complex128, k, i int) {
+	if a[i][k] == 0 {
+		return
+	}
+
+	c, s := givensCoefficients(a[k][k], a[i][k])
+	rotateRows(a, c, s, k, i, k)
+	a[i][k] = 0
+	rotateColumns(q, c, s, k, i, 0, len(q))
+}
+
+// givensCoefficients returns the real c and complex s of the rotation [c s; -conj(s) c] that maps (x, y) onto (r, 0)
+func givensCoefficients(x, y complex128) (c complex128, s complex128) {
+	if y == 0 {
+		return 1, 0
+	}
+	if x == 0 {
+		return 0, 1
+	}
+
+	radius := math.Hypot(cmplx.Abs(x), cmplx.Abs(y))
+	c = complex(cmplx.Abs(x)/radius, 0)
+	s = x / complex(cmplx.Abs(x), 0) * cmplx.Conj(y) / complex(radius, 0)
+	return c, s
+}
+
+// rotateRows multiplies rows k and i of a from the left by the rotation, only the columns from col on are updated
+func rotateRows(a [][]complex128, c, s complex128, k, i, col int) {
+	for j := col; j < len(a[k]); j++ {
+		a[k][j], a[i][j] = c*a[k][j]+s*a[i][j], -cmplx.Conj(s)*a[k][j]+c*a[i][j]
+	}
+}
+
+// rotateColumns multiplies columns k and i of rows start to end - 1 of a from the right by the conjugate transpose
+// of the rotation
+func rotateColumns(a [][]complex128, c, s complex128, k, i, start, end int) {
+	for row := start; row < end; row++ {
+		a[row][k], a[row][i] = a[row][k]*c+a[row][i]*cmplx.Conj(s), -a[row][k]*s+a[row][i]*c
+	}
+}
+
+// gramSchmidt orthogonalizes the columns of a in place, leaving R in the first size rows of a, and returns
+// the size orthonormal columns of Q, a column that is dependent to working precision gets a zero diagonal in R
+// and a column of Q orthogonal to the previous ones
+func gramSchmidt(a [][]complex128, rows, cols, size int, pivot bool, permutation []int) [][]complex128 {
+	q := make([][]complex128, rows)
+	for i := range q {
+		q[i] = make([]complex128, 0, rows)
+	}
+
+	var largest float64
+	for j := 0; j < cols; j++ {
+		if norm := columnNorm(a, j, 0, rows); norm > largest {
+			largest = norm
+		}
+	}
+	TOL := float64(rows) * machineEpsilon * largest
+
+	// residual holds the columns of a less their projections onto the columns of q found so far
+	residual := make([][]complex128, cols)
+	for j := range residual {
+		residual[j] = columnSlice(a, j, 0, rows)
+	}
+
+	for k := 0; k < size; k++ {
+		if pivot {
+			best := k
+			for j := k + 1; j < cols; j++ {
+				if vectorNorm(residual[j]) > vectorNorm(residual[best]) {
+					best = j
+				}
+			}
+			residual[k], residual[best] = residual[best], residual[k]
+			for i := 0; i < k; i++ {
+				a[i][k], a[i][best] = a[i][best], a[i][k]
+			}
+			permutation[k], permutation[best] = permutation[best], permutation[k]
+		}
+
+		norm := vectorNorm(residual[k])
+		if norm > TOL {
+			for i := range q {
+				q[i] = append(q[i], residual[k][i]/complex(norm, 0))
+			}
+		} else {
+			norm = 0
+			q = appendOrthonormal(q)
+		}
+		a[k][k] = complex(norm, 0)
+
+		for j := k + 1; j < cols; j++ {
+			var projection complex128
+			for i := range q {
+				projection += cmplx.Conj(q[i][k]) * residual[j][i]
+			}
+			for i := range q {
+				residual[j][i] -= projection * q[i][k]
+			}
+			a[k][j] = projection
+		}
+	}
+
+	return q
+}
+
+// appendOrthonormal appends to q the unit vector orthogonal to its columns nearest to a standard basis vector
+func appendOrthonormal(q [][]complex128) [][]complex128 {
+	rows, count := len(q), len(q[0])
+	var best []complex128
+	bestNorm := -1.0
+	for l := 0; l < rows; l++ {
+		candidate := make([]complex128, rows)
+		candidate[l] = 1
+		// orthogonalizing twice keeps the candidate orthogonal to working precision
+		for pass := 0; pass < 2; pass++ {
+			for j := 0; j < count; j++ {
+				var projection complex128
+				for i := range q {
+					projection += cmplx.Conj(q[i][j]) * candidate[i]
+				}
+				for i := range q {
+					candidate[i] -= projection * q[i][j]
+				}
+			}
+		}
+		if norm := vectorNorm(candidate); norm > bestNorm {
+			best, bestNorm = candidate, norm
+		}
+	}
+
+	for i := range q {
+		q[i] = append(q[i], best[i]/complex(bestNorm, 0))
+	}
+	return q
+}
+
+// toComplex returns a copy of the entries of A as complex128, the orthogonal factorizations compute on these entries
+func toComplex(A m.Matrix) [][]complex128 {
+	rows, cols := A.Dim()
+	entries := make([][]complex128, rows)
+	for i := range entries {
+		entries[i] = make([]complex128, cols)
+		for j := range entries[i] {
+			entries[i][j] = A.Get(i, j).Complex()
+		}
+	}
+	return entries
+}
+
+// fromComplex returns the matrix of the given entries, the entries are set as real values if every imaginary part is zero
+func fromComplex(entries [][]complex128) m.Matrix {
+	cols := 0
+	if len(entries) > 0 {
+		cols = len(entries[0])
+	}
+	realEntries := true
+	for i := range entries {
+		realEntries = realEntries && isReal(entries[i])
+	}
+	A := m.NewMatrix(len(entries), cols)
+	for i := range entries {
+		for j, entry := range entries[i] {
+			A.Set(i, j, complexEntry(entry, realEntries))
+		}
+	}
+	return A
+}
+
+// fromComplexVector returns the column vector of the given entries, set as real values if every imaginary part is zero
+func fromComplexVector(entries []complex128) v.Vector {
+	realEntries := isReal(entries)
+	x := v.NewVector(v.ColSpace, len(entries))
+	for i, entry := range entries {
+		x.Set(i, complexEntry(entry, realEntries))
+	}
+	return x
+}
+
+// isReal reports whether every imaginary part of the entries is zero
+func isReal(entries []complex128) bool {
+	for _, entry := range entries {
+		if imag(entry) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// complexEntry returns the real part of entry if realEntries is set, else entry
+func complexEntry(entry complex128, realEntries bool) interface{} {
+	if realEntries {
+		return real(entry)
+	}
+	return entry
+}
+
+// identity returns the entries of the identity matrix of the given degree
+func identity(degree int) [][]complex128 {
+	entries := make([][]complex128, degree)
+	for i := range entries {
+		entries[i] = make([]complex128, degree)
+		entries[i][i] = 1
+	}
+	return entries
+}
+
+// columnSlice returns a copy of rows start to end - 1 of column j of a
+func columnSlice(a [][]complex128, j, start, end int) []complex128 {
+	column := make([]complex128, end-start)
+	for i := range column {
+		column[i] = a[start+i][j]
+	}
+	return column
+}
+
+// columnNorm returns the euclidean norm of rows start to end - 1 of column j of a
+func columnNorm(a [][]complex128, j, start, end int) float64 {
+	return vectorNorm(columnSlice(a, j, start, end))
+}
+
+// vectorNorm returns the euclidean norm of x, scaled to avoid overflow
+func vectorNorm(x []complex128) float64 {
+	var norm float64
+	for _, value := range x {
+		norm = math.Hypot(norm, cmplx.Abs(value))
+	}
+	return norm
+}
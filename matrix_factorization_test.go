@@ -1,9 +1,14 @@
 package methods
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
 	"reflect"
 	"testing"
 
+	f64 "github.com/NumberXNumbers/methods/native/f64"
 	m "github.com/NumberXNumbers/types/gc/matrices"
 	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
 	v "github.com/NumberXNumbers/types/gc/vectors"
@@ -33,8 +38,8 @@ func TestLU(t *testing.T) {
 	testMatrixB := m.MakeMatrixAlt(testVectorsB)
 
 	_, _, _, errB := LU(testMatrixB)
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
 	}
 
 	testVectorCa := v.MakeVector(v.RowSpace, 0, 0, -1)
@@ -44,8 +49,9 @@ func TestLU(t *testing.T) {
 	testMatrixC := m.MakeMatrixAlt(testVectorsC)
 
 	_, _, _, errC := LU(testMatrixC)
-	if errC == nil {
-		t.Error("Expected Error")
+	var singularErr *SingularError
+	if !errors.As(errC, &singularErr) || singularErr.Pivot != 0 {
+		t.Errorf("Expected singular pivot at 0, received %v", errC)
 	}
 }
 
@@ -70,8 +76,8 @@ func TestLDLtrans(t *testing.T) {
 	testMatrixB := m.MakeMatrixAlt(testVectorsB)
 
 	_, _, errB := LDLt(testMatrixB)
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
 	}
 
 	testVectorCa := v.MakeVector(v.RowSpace, 0, -1, 0)
@@ -81,7 +87,654 @@ func TestLDLtrans(t *testing.T) {
 	testMatrixC := m.MakeMatrixAlt(testVectorsC)
 
 	_, _, errC := LDLt(testMatrixC)
-	if errC == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errC, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errC)
+	}
+}
+
+// residualNorm returns the largest magnitude of an entry of A x - b
+func residualNorm(A m.Matrix, x v.Vector, b v.Vector) float64 {
+	rows, cols := A.Dim()
+	var norm float64
+	for i := 0; i < rows; i++ {
+		var sum complex128
+		for j := 0; j < cols; j++ {
+			sum += A.Get(i, j).Complex() * x.Get(j).Complex()
+		}
+		norm = math.Max(norm, cmplx.Abs(sum-b.Get(i).Complex()))
+	}
+	return norm
+}
+
+// wilkinsonMatrix returns the matrix with ones on the diagonal and in the last column and minus ones below the diagonal,
+// elimination with partial pivoting doubles its last column at every step
+func wilkinsonMatrix(degree int) m.Matrix {
+	A := m.NewMatrix(degree, degree)
+	for i := 0; i < degree; i++ {
+		for j := 0; j < i; j++ {
+			A.Set(i, j, -1)
+		}
+		A.Set(i, i, 1)
+		A.Set(i, degree-1, 1)
+	}
+	return A
+}
+
+func TestLUPivot(t *testing.T) {
+	testMatrixA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 0, 0, -1, 1),
+		v.MakeVector(v.RowSpace, 1, 1, -1, 2),
+		v.MakeVector(v.RowSpace, -1, -1, 2, 0),
+		v.MakeVector(v.RowSpace, 1, 2, 0, 2)))
+
+	expected := [][]complex128{{0, 0, -1, 1}, {1, 1, -1, 2}, {-1, -1, 2, 0}, {1, 2, 0, 2}}
+
+	for _, pivoting := range []Pivoting{PartialPivoting, ScaledPartialPivoting, RookPivoting, CompletePivoting} {
+		L, U, P, Q, err := LUPivot(testMatrixA, pivoting)
+
+		if err != nil {
+			t.Errorf("Unexpected error for pivoting %v: %v", pivoting, err)
+			continue
+		}
+
+		// P A Q = L U so A = P^T L U Q^T
+		A := mops.MustMultSimple(mops.MustMultSimple(m.MakeTransMatrix(P), mops.MustMultSimple(L, U)), m.MakeTransMatrix(Q))
+		if !matrixApproxEqual(expected, A, 1e-12) {
+			t.Errorf("Expected %v for pivoting %v, received %+v", expected, pivoting, A)
+		}
+
+		for i := 0; i < 4; i++ {
+			for j := 0; j < i; j++ {
+				if cmplx.Abs(L.Get(i, j).Complex()) > 1 {
+					t.Errorf("Expected multipliers of at most 1 for pivoting %v, received %v", pivoting, L.Get(i, j))
+				}
+			}
+		}
+	}
+
+	_, _, _, _, errB := LUPivot(m.NewMatrix(2, 3), PartialPivoting)
+
+	if !errors.Is(errB, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errB)
+	}
+
+	// the last pivot of a rank deficient matrix vanishes
+	singular := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2, 4),
+		v.MakeVector(v.RowSpace, 2, 4, 8),
+		v.MakeVector(v.RowSpace, 1, 1, 1)))
+
+	for _, pivoting := range []Pivoting{PartialPivoting, ScaledPartialPivoting, RookPivoting, CompletePivoting} {
+		_, _, _, _, err := LUPivot(singular, pivoting)
+
+		var singularErr *SingularError
+		if !errors.As(err, &singularErr) || singularErr.Pivot != 2 {
+			t.Errorf("Expected singular pivot at 2 for pivoting %v, received %v", pivoting, err)
+		}
+	}
+}
+
+func TestLUPivotTinyPivot(t *testing.T) {
+	// LU only interchanges rows for a zero pivot, so the pivot 1e-17 wipes out the second row
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1e-17, 1),
+		v.MakeVector(v.RowSpace, 1, 1)))
+	b := v.MakeVector(v.RowSpace, 1, 2)
+
+	L, U, P, _ := LU(A)
+	unpivoted, _ := SolveLU(L, U, P, b)
+
+	if residual := residualNorm(A, unpivoted, b); residual < 0.5 {
+		t.Errorf("Expected LU to lose the solution, received residual %v", residual)
+	}
+
+	for _, pivoting := range []Pivoting{PartialPivoting, ScaledPartialPivoting, RookPivoting, CompletePivoting} {
+		x, err := SolvePivot(A, b, pivoting)
+
+		if err != nil {
+			t.Errorf("Unexpected error for pivoting %v: %v", pivoting, err)
+		}
+
+		if residual := residualNorm(A, x, b); residual > 1e-15 {
+			t.Errorf("Expected a residual below 1e-15 for pivoting %v, received %v", pivoting, residual)
+		}
+	}
+}
+
+func TestLUPivotScaled(t *testing.T) {
+	// partial pivoting keeps the first row since 30 > 5.291, relative to its row 30 is tiny so scaled pivoting interchanges
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 30, 591400),
+		v.MakeVector(v.RowSpace, 5.291, -6.13)))
+
+	_, _, partialP, _, _ := LUPivot(A, PartialPivoting)
+	if partialP.Get(0, 0).Complex() != 1 {
+		t.Errorf("Expected partial pivoting to keep the rows, received %+v", partialP)
+	}
+
+	_, _, scaledP, _, _ := LUPivot(A, ScaledPartialPivoting)
+	if scaledP.Get(0, 1).Complex() != 1 {
+		t.Errorf("Expected scaled partial pivoting to interchange the rows, received %+v", scaledP)
+	}
+
+	x, err := SolvePivot(A, v.MakeVector(v.RowSpace, 591700, 46.78), ScaledPartialPivoting)
+	if err != nil || !vectorApproxEqual([]complex128{10, 1}, x, 1e-12) {
+		t.Errorf("Expected [10 1], received %v and %v", x, err)
+	}
+
+	_, _, _, _, errB := LUPivot(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 0, 0))), ScaledPartialPivoting)
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+}
+
+func TestLUPivotGrowth(t *testing.T) {
+	degree := 60
+	A := wilkinsonMatrix(degree)
+	solution := make([]complex128, degree)
+	b := v.NewVector(v.RowSpace, degree)
+	for i := 0; i < degree; i++ {
+		solution[i] = complex(math.Sin(float64(i+1)), 0)
+	}
+	for i := 0; i < degree; i++ {
+		var sum complex128
+		for j := 0; j < degree; j++ {
+			sum += A.Get(i, j).Complex() * solution[j]
+		}
+		b.Set(i, sum)
+	}
+
+	// partial pivoting grows the last column to 2^59 and loses every digit of the solution
+	partial, _ := SolvePivot(A, b, PartialPivoting)
+	if vectorApproxEqual(solution, partial, 1e-3) {
+		t.Errorf("Expected partial pivoting to lose accuracy, received %v", partial)
+	}
+
+	for _, pivoting := range []Pivoting{RookPivoting, CompletePivoting} {
+		x, err := SolvePivot(A, b, pivoting)
+
+		if err != nil || !vectorApproxEqual(solution, x, 1e-10) {
+			t.Errorf("Expected %v for pivoting %v, received %v and %v", solution, pivoting, x, err)
+		}
+	}
+}
+
+// conjugateTranspose returns the conjugate transpose of A
+func conjugateTranspose(A m.Matrix) m.Matrix {
+	rows, cols := A.Dim()
+	transpose := m.NewMatrix(cols, rows)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			transpose.Set(j, i, cmplx.Conj(A.Get(i, j).Complex()))
+		}
+	}
+	return transpose
+}
+
+func TestLDLtHermitian(t *testing.T) {
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1-1i, 2i),
+		v.MakeVector(v.RowSpace, 1+1i, 3, 1),
+		v.MakeVector(v.RowSpace, -2i, 1, 5)))
+
+	L, D, errA := LDLt(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	expected := [][]complex128{{4, 1 - 1i, 2i}, {1 + 1i, 3, 1}, {-2i, 1, 5}}
+	if product := mops.MustMultSimple(mops.MustMultSimple(L, D), conjugateTranspose(L)); !matrixApproxEqual(expected, product, 1e-12) {
+		t.Errorf("Expected %v, received %+v", expected, product)
+	}
+
+	for i := 0; i < 3; i++ {
+		if d := D.Get(i, i).Complex(); imag(d) != 0 || real(d) <= 0 {
+			t.Errorf("Expected a positive real diagonal, received %v", d)
+		}
+	}
+
+	_, _, errB := LDLt(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1+1i),
+		v.MakeVector(v.RowSpace, 1+1i, 3))))
+
+	var hermitianErr *HermitianError
+	if !errors.As(errB, &hermitianErr) || hermitianErr.Row != 0 || hermitianErr.Col != 1 {
+		t.Errorf("Expected HermitianError at (0, 1), received %v", errB)
+	}
+
+	// symmetric but indefinite, the second pivot is 1 - 4 = -3
+	_, _, errC := LDLt(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 2, 1))))
+
+	var definiteErr *DefiniteError
+	if !errors.As(errC, &definiteErr) || definiteErr.Pivot != 1 || definiteErr.Value != -3 {
+		t.Errorf("Expected DefiniteError at pivot 1, received %v", errC)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	testMatrixA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 1),
+		v.MakeVector(v.RowSpace, -1, 4.25, 2.75),
+		v.MakeVector(v.RowSpace, 1, 2.75, 3.5)))
+
+	L, errA := Cholesky(testMatrixA)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual([][]complex128{{2}, {-0.5, 2}, {0.5, 1.5, 1}}, L, 1e-12) {
+		t.Errorf("Expected [[2 0 0] [-0.5 2 0] [0.5 1.5 1]], received %+v", L)
+	}
+
+	complexA := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1-1i),
+		v.MakeVector(v.RowSpace, 1+1i, 3)))
+
+	complexL, errB := Cholesky(complexA)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if !matrixApproxEqual([][]complex128{{2}, {0.5 + 0.5i, complex(math.Sqrt(2.5), 0)}}, complexL, 1e-12) {
+		t.Errorf("Expected [[2 0] [0.5+0.5i 1.5811]], received %+v", complexL)
+	}
+
+	if product := mops.MustMultSimple(complexL, conjugateTranspose(complexL)); !matrixApproxEqual([][]complex128{{4, 1 - 1i}, {1 + 1i, 3}}, product, 1e-12) {
+		t.Errorf("Expected [[4 1-1i] [1+1i 3]], received %+v", product)
+	}
+
+	_, errC := Cholesky(m.NewMatrix(2, 3))
+
+	if !errors.Is(errC, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errC)
+	}
+
+	// a real diagonal is required
+	_, errD := Cholesky(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, 1),
+		v.MakeVector(v.RowSpace, 1, 3+1i))))
+
+	if !errors.Is(errD, ErrNotHermitian) {
+		t.Errorf("Expected %v, received %v", ErrNotHermitian, errD)
+	}
+
+	_, errE := Cholesky(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, -1, 0),
+		v.MakeVector(v.RowSpace, 0, 1))))
+
+	if !errors.Is(errE, ErrNotPositiveDefinite) {
+		t.Errorf("Expected %v, received %v", ErrNotPositiveDefinite, errE)
+	}
+
+	_, errF := Cholesky(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 1),
+		v.MakeVector(v.RowSpace, 1, 1))))
+
+	var singularErr *SingularError
+	if !errors.As(errF, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected singular pivot at 1, received %v", errF)
+	}
+}
+
+// entries returns the entries of A
+func entries(A m.Matrix) [][]complex128 {
+	rows, cols := A.Dim()
+	values := make([][]complex128, rows)
+	for i := range values {
+		values[i] = make([]complex128, cols)
+		for j := range values[i] {
+			values[i][j] = A.Get(i, j).Complex()
+		}
+	}
+	return values
+}
+
+// checkQR checks that Q has orthonormal columns, that R is upper triangular and that Q R is the expected product
+func checkQR(t *testing.T, name string, expected [][]complex128, Q, R m.Matrix, qCols int) {
+	rows, cols := len(expected), len(expected[0])
+
+	if r, c := Q.Dim(); r != rows || c != qCols {
+		t.Errorf("%v: expected Q to be %d x %d, received %d x %d", name, rows, qCols, r, c)
+	}
+
+	if r, c := R.Dim(); r != qCols || c != cols {
+		t.Errorf("%v: expected R to be %d x %d, received %d x %d", name, qCols, cols, r, c)
+	}
+
+	identity := make([][]complex128, qCols)
+	for i := range identity {
+		identity[i] = make([]complex128, i+1)
+		identity[i][i] = 1
+	}
+	if product := mops.MustMultSimple(conjugateTranspose(Q), Q); !matrixApproxEqual(identity, product, 1e-12) {
+		t.Errorf("%v: expected orthonormal columns, received Q* Q = %v", name, entries(product))
+	}
+
+	for i := 0; i < qCols; i++ {
+		for j := 0; j < i && j < cols; j++ {
+			if R.Get(i, j).Complex() != 0 {
+				t.Errorf("%v: expected R to be upper triangular, received %v", name, entries(R))
+			}
+		}
+	}
+
+	if product := mops.MustMultSimple(Q, R); !matrixApproxEqual(expected, product, 1e-12) {
+		t.Errorf("%v: expected Q R = %v, received %v", name, expected, entries(product))
+	}
+}
+
+func TestQR(t *testing.T) {
+	tall := [][]complex128{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 2, 3}}
+	wide := [][]complex128{{1, 2, 3}, {4, 5, 6}}
+	complexEntries := [][]complex128{{1i, 1, 0}, {1, 2 - 1i, 3}, {0, 1i, 4}}
+	// the third column is the sum of the first two
+	deficient := [][]complex128{{1, 2, 3}, {4, 5, 9}, {7, 8, 15}, {1, 0, 1}}
+
+	methods := map[string]QRMethod{"householder": Householder, "givens": Givens, "gram-schmidt": ModifiedGramSchmidt}
+	for name, method := range methods {
+		for _, example := range [][][]complex128{tall, wide, complexEntries, deficient} {
+			A := m.NewMatrix(len(example), len(example[0]))
+			for i := range example {
+				for j := range example[i] {
+					A.Set(i, j, example[i][j])
+				}
+			}
+			rows, cols := A.Dim()
+			size := rows
+			if cols < size {
+				size = cols
+			}
+
+			Q, R, err := QR(A, method, FullQR)
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", name, err)
+			}
+			checkQR(t, name+" full", example, Q, R, rows)
+
+			Q, R, err = QR(A, method, EconomyQR)
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", name, err)
+			}
+			checkQR(t, name+" economy", example, Q, R, size)
+		}
+	}
+
+	// the diagonal of R is unique up to the phase of each column
+	_, R, _ := QR(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 12, -51, 4),
+		v.MakeVector(v.RowSpace, 6, 167, -68),
+		v.MakeVector(v.RowSpace, -4, 24, -41))), Givens, FullQR)
+
+	for i, expected := range []float64{14, 175, 35} {
+		if magnitude := cmplx.Abs(R.Get(i, i).Complex()); math.Abs(magnitude-expected) > 1e-12*expected {
+			t.Errorf("Expected |r_%d%d| = %v, received %v", i, i, expected, magnitude)
+		}
+	}
+
+	_, _, errA := QR(m.NewMatrix(2, 2), QRMethod(5), FullQR)
+
+	if !errors.Is(errA, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errA)
+	}
+
+	_, _, errB := QR(m.NewMatrix(2, 2), Householder, QRMode(-1))
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestQRPivot(t *testing.T) {
+	// the third column is the sum of the first two
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2, 3),
+		v.MakeVector(v.RowSpace, 4, 5, 9),
+		v.MakeVector(v.RowSpace, 7, 8, 15),
+		v.MakeVector(v.RowSpace, 1, 0, 1)))
+
+	for _, method := range []QRMethod{Householder, Givens, ModifiedGramSchmidt} {
+		Q, R, P, rank, err := QRPivot(A, method, EconomyQR)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if rank != 2 {
+			t.Errorf("Expected rank 2, received %v", rank)
+		}
+
+		checkQR(t, "pivoted", entries(mops.MustMultSimple(A, P)), Q, R, 3)
+
+		// the largest column comes first and the diagonal does not increase
+		if P.Get(2, 0).Complex() != 1 {
+			t.Errorf("Expected the third column to be pivoted first, received %v", entries(P))
+		}
+
+		for i := 1; i < 3; i++ {
+			if cmplx.Abs(R.Get(i, i).Complex()) > cmplx.Abs(R.Get(i-1, i-1).Complex())*(1+1e-12) {
+				t.Errorf("Expected a non-increasing diagonal, received %v", entries(R))
+			}
+		}
+	}
+
+	_, _, _, rank, _ := QRPivot(m.NewIdentityMatrix(3), Householder, FullQR)
+
+	if rank != 3 {
+		t.Errorf("Expected rank 3, received %v", rank)
+	}
+
+	_, _, _, rank, _ = QRPivot(m.NewMatrix(3, 2), ModifiedGramSchmidt, FullQR)
+
+	if rank != 0 {
+		t.Errorf("Expected rank 0, received %v", rank)
+	}
+}
+
+// convectionDiffusion returns the nonsymmetric five point discretization of the convection-diffusion equation
+// with convection c on a size x size grid
+func convectionDiffusion(size int, c float64) m.Matrix {
+	degree := size * size
+	A := m.NewMatrix(degree, degree)
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			k := i*size + j
+			A.Set(k, k, 4)
+			if j > 0 {
+				A.Set(k, k-1, -1-c)
+			}
+			if j < size-1 {
+				A.Set(k, k+1, -1+c)
+			}
+			if i > 0 {
+				A.Set(k, k-size, -1-c)
+			}
+			if i < size-1 {
+				A.Set(k, k+size, -1+c)
+			}
+		}
+	}
+	return A
+}
+
+func TestILU(t *testing.T) {
+	// without fill-in outside of a tridiagonal pattern the incomplete factorization is complete
+	A := m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 4, -1, 0),
+		v.MakeVector(v.RowSpace, -2, 4, -1),
+		v.MakeVector(v.RowSpace, 0, -2, 4)))
+
+	L, U, errA := ILU(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if product := mops.MustMultSimple(L, U); !matrixApproxEqual(entries(A), product, 1e-12) {
+		t.Errorf("Expected L U = %v, received %v", entries(A), entries(product))
+	}
+
+	// L U matches A on its pattern and L and U keep the pattern of A
+	grid := convectionDiffusion(4, 0.3)
+	gridL, gridU, errB := ILU(grid)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	product := mops.MustMultSimple(gridL, gridU)
+	fillIn := false
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			value := grid.Get(i, j).Complex()
+			if value != 0 && cmplx.Abs(product.Get(i, j).Complex()-value) > 1e-12 {
+				t.Errorf("Expected (L U)(%d, %d) = %v, received %v", i, j, value, product.Get(i, j))
+			}
+			if value == 0 && (gridL.Get(i, j).Complex() != 0 || gridU.Get(i, j).Complex() != 0) && i != j {
+				t.Errorf("Expected no fill-in at (%d, %d)", i, j)
+			}
+			if value == 0 && product.Get(i, j).Complex() != 0 {
+				fillIn = true
+			}
+		}
+	}
+
+	if !fillIn {
+		t.Errorf("Expected L U to differ from A off its pattern")
+	}
+
+	_, _, errC := ILU(m.MakeMatrixAlt(v.MakeVectors(v.RowSpace,
+		v.MakeVector(v.RowSpace, 1, 2),
+		v.MakeVector(v.RowSpace, 2, 4))))
+
+	var singularErr *SingularError
+	if !errors.As(errC, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected singular pivot at 1, received %v", errC)
+	}
+
+	_, _, errD := ILU(m.NewMatrix(2, 3))
+
+	if !errors.Is(errD, ErrNotSquare) {
+		t.Errorf("Expected %v, received %v", ErrNotSquare, errD)
+	}
+}
+
+// benchmarkMatrix returns a symmetric positive-definite matrix of the given degree as a gc matrix and as the dense
+// row-major slice the native factorizations work on
+func benchmarkMatrix(degree int) (m.Matrix, []float64) {
+	A := m.NewMatrix(degree, degree)
+	dense := make([]float64, degree*degree)
+	for i := 0; i < degree; i++ {
+		for j := 0; j < degree; j++ {
+			value := math.Cos(float64(i + j + i*j))
+			if i == j {
+				value += float64(degree)
+			}
+			A.Set(i, j, value)
+			dense[i*degree+j] = value
+		}
+	}
+	return A, dense
+}
+
+// the gc factorizations box every entry, the native f64 factorizations work on dense slices in blocks of 64 columns,
+// the largest degree spans several blocks so that the blocked trailing update is measured and is run natively only
+var benchmarkDegrees = []int{16, 64, 256}
+
+// maxGCBenchmarkDegree is the largest degree the gc factorizations are benchmarked at
+const maxGCBenchmarkDegree = 64
+
+func BenchmarkLU(b *testing.B) {
+	for _, degree := range benchmarkDegrees {
+		A, dense := benchmarkMatrix(degree)
+
+		if degree <= maxGCBenchmarkDegree {
+			b.Run(fmt.Sprintf("gc/%d", degree), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					LU(A)
+				}
+			})
+		}
+
+		b.Run(fmt.Sprintf("f64/%d", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f64.LU(dense, degree)
+			}
+		})
+	}
+}
+
+func BenchmarkLDLt(b *testing.B) {
+	for _, degree := range benchmarkDegrees {
+		A, dense := benchmarkMatrix(degree)
+
+		if degree <= maxGCBenchmarkDegree {
+			b.Run(fmt.Sprintf("gc/%d", degree), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					LDLt(A)
+				}
+			})
+		}
+
+		b.Run(fmt.Sprintf("f64/%d", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f64.LDLt(dense, degree)
+			}
+		})
+	}
+}
+
+func BenchmarkCholesky(b *testing.B) {
+	for _, degree := range benchmarkDegrees {
+		A, dense := benchmarkMatrix(degree)
+
+		if degree <= maxGCBenchmarkDegree {
+			b.Run(fmt.Sprintf("gc/%d", degree), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					Cholesky(A)
+				}
+			})
+		}
+
+		b.Run(fmt.Sprintf("f64/%d", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f64.Cholesky(dense, degree)
+			}
+		})
+	}
+}
+
+func BenchmarkSolveLU(b *testing.B) {
+	for _, degree := range benchmarkDegrees {
+		A, dense := benchmarkMatrix(degree)
+		denseL, denseU, denseP, _ := f64.LU(dense, degree)
+		rhs := v.NewVector(v.ColSpace, degree)
+		denseRHS := make([]float64, degree)
+		for i := 0; i < degree; i++ {
+			rhs.Set(i, 1)
+			denseRHS[i] = 1
+		}
+
+		if degree <= maxGCBenchmarkDegree {
+			L, U, P, _ := LU(A)
+			b.Run(fmt.Sprintf("gc/%d", degree), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					SolveLU(L, U, P, rhs)
+				}
+			})
+		}
+
+		b.Run(fmt.Sprintf("f64/%d", degree), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				f64.SolveLU(denseL, denseU, denseP, degree, denseRHS)
+			}
+		})
 	}
 }
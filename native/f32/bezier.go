@@ -0,0 +1,14 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// Bezier is a bezier curve of arbitrary degree and dimension on the parameter interval [0, 1],
+// a curve of degree n has n + 1 control points
+type Bezier = generic.Bezier[float32]
+
+// NewBezier builds the bezier curve with the given control points, which must share their dimension
+func NewBezier(controlPoints [][]float32) (*Bezier, error) {
+	return generic.NewBezier(controlPoints)
+}
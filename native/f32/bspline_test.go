@@ -0,0 +1,73 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewBSpline(t *testing.T) {
+	knots, _ := OpenUniformKnots(4, 3, 0, 1)
+	bezier, errA := NewBSpline(3, knots, [][]float32{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if point := bezier.Eval(0.5); maxNormDiff([]float32{2, 1.5}, point) > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{2, 1.5}, point)
+	}
+
+	if derivative := bezier.Derivative(0, 1); maxNormDiff([]float32{3, 6}, derivative) > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{3, 6}, derivative)
+	}
+
+	if err := bezier.InsertKnot(0.5); err != nil {
+		t.Errorf("Error %v", err)
+	}
+
+	if point := bezier.Eval(0.25); maxNormDiff([]float32{0.90625, 1.125}, point) > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{0.90625, 1.125}, point)
+	}
+
+	_, errB := NewBSpline(3, knots[1:], [][]float32{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestFitBSpline(t *testing.T) {
+	parameters := make([]float32, 21)
+	points := make([][]float32, 21)
+	for i := range parameters {
+		parameters[i] = float32(i) / 20
+		points[i] = []float32{float32(math.Cos(float64(parameters[i]))), float32(math.Sin(float64(parameters[i])))}
+	}
+
+	spline, errA := FitBSpline(parameters, points, 8, 3)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if point := spline.Eval(0.37); maxNormDiff([]float32{float32(math.Cos(0.37)), float32(math.Sin(0.37))}, point) > 1e-4 {
+		t.Errorf("Expected %v, received %v", []float32{float32(math.Cos(0.37)), float32(math.Sin(0.37))}, point)
+	}
+}
+
+func TestNewNURBS(t *testing.T) {
+	circle, errA := NewNURBS(2, []float32{0, 0, 0, 1, 1, 1}, [][]float32{{1, 0}, {1, 1}, {0, 1}}, []float32{1, float32(math.Sqrt2 / 2), 1})
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if point := circle.Eval(0.5); maxNormDiff([]float32{float32(math.Sqrt2 / 2), float32(math.Sqrt2 / 2)}, point) > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{float32(math.Sqrt2 / 2), float32(math.Sqrt2 / 2)}, point)
+	}
+
+	if derivative := circle.Derivative(1, 1); maxNormDiff([]float32{-float32(math.Sqrt2), 0}, derivative) > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{-float32(math.Sqrt2), 0}, derivative)
+	}
+}
@@ -0,0 +1,36 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// ChebyshevSeries is a truncated chebyshev series sum c_k T_k(u) on [a, b], where u maps [a, b] onto [-1, 1]
+type ChebyshevSeries = generic.ChebyshevSeries[float32]
+
+// ChebyshevLobattoPoints returns the N chebyshev points of the second kind, the extrema of T_(N-1),
+// mapped to [a, b] in ascending order, a single point is the midpoint
+func ChebyshevLobattoPoints(N int, a float32, b float32) ([]float32, error) {
+	return generic.ChebyshevLobattoPoints(N, a, b)
+}
+
+// ChebyshevCoefficients returns the coefficients of the chebyshev series interpolating values
+// at the ascending chebyshev points of the second kind, computed with a type I discrete cosine transform
+func ChebyshevCoefficients(values []float32) ([]float32, error) {
+	return generic.ChebyshevCoefficients(values)
+}
+
+// NewChebyshevSeries builds the chebyshev series of f on [a, b] interpolating it at N chebyshev points of the second kind
+func NewChebyshevSeries(f func(float32) float32, N int, a float32, b float32) (*ChebyshevSeries, error) {
+	return generic.NewChebyshevSeries(f, N, a, b)
+}
+
+// NewAdaptiveChebyshevSeries builds the chebyshev series of f on [a, b], doubling the number of chebyshev points
+// from 17 until the coefficients have decayed to machine precision, and chops the negligible tail
+func NewAdaptiveChebyshevSeries(f func(float32) float32, a float32, b float32, maxPoints int) (*ChebyshevSeries, error) {
+	return generic.NewAdaptiveChebyshevSeries(f, a, b, maxPoints)
+}
+
+// NewChebyshevSeriesFromCoefficients builds the chebyshev series on [a, b] with the given coefficients
+func NewChebyshevSeriesFromCoefficients(coefficients []float32, a float32, b float32) (*ChebyshevSeries, error) {
+	return generic.NewChebyshevSeriesFromCoefficients(coefficients, a, b)
+}
@@ -0,0 +1,56 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewAdaptiveChebyshevSeries(t *testing.T) {
+	cosine := func(x float32) float32 { return float32(math.Cos(math.Pi * float64(x))) }
+	series, errA := NewAdaptiveChebyshevSeries(cosine, 0, 4, 1025)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := series.Eval(1.25); math.Abs(float64(value)-math.Cos(1.25*math.Pi)) > 10*1e-5 {
+		t.Errorf("Expected %v, received %v", math.Cos(1.25*math.Pi), value)
+	}
+
+	roots, errB := series.Roots()
+
+	if errB != nil {
+		t.Errorf("Unexpected error %v", errB)
+	}
+
+	expected := []float64{0.5, 1.5, 2.5, 3.5}
+	if len(roots) != len(expected) {
+		t.Fatalf("Expected %v, received %v", expected, roots)
+	}
+
+	for i := range expected {
+		if math.Abs(float64(roots[i])-expected[i]) > 100*1e-5 {
+			t.Errorf("Expected %v, received %v", expected, roots)
+		}
+	}
+
+	_, errC := NewAdaptiveChebyshevSeries(cosine, 4, 0, 1025)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestChebyshevCoefficients(t *testing.T) {
+	// T_2 = 2x^2 - 1 at the points -1, 0 and 1
+	coefficients, errA := ChebyshevCoefficients([]float32{1, -1, 1})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if len(coefficients) != 3 || math.Abs(float64(coefficients[0])) > 1e-5 || math.Abs(float64(coefficients[1])) > 1e-5 || math.Abs(float64(coefficients[2])-1) > 1e-5 {
+		t.Errorf("Expected [0 0 1], received %v", coefficients)
+	}
+}
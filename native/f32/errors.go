@@ -0,0 +1,70 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+var (
+	// ErrNotSquare is returned when a method requires a square matrix
+	ErrNotSquare = generic.ErrNotSquare
+	// ErrSingular is returned when a matrix can not be factorized because it is singular
+	ErrSingular = generic.ErrSingular
+	// ErrMaxIterations is returned when a method does not converge within the maximum number of iterations
+	ErrMaxIterations = generic.ErrMaxIterations
+	// ErrDimensionMismatch is returned when the lengths or dimensions of the inputs do not match
+	ErrDimensionMismatch = generic.ErrDimensionMismatch
+	// ErrStepSizeUnderflow is returned when an adaptive method needs a step smaller than the minimum step size
+	ErrStepSizeUnderflow = generic.ErrStepSizeUnderflow
+	// ErrDivisionByZero is returned when an iteration would divide by zero
+	ErrDivisionByZero = generic.ErrDivisionByZero
+	// ErrDivergence is returned when an iterate is no longer a finite number
+	ErrDivergence = generic.ErrDivergence
+	// ErrInvalidArgument is returned when an argument is outside of the domain a method accepts
+	ErrInvalidArgument = generic.ErrInvalidArgument
+	// ErrNotBracketed is returned when a bracketing method is given an interval without a sign change
+	ErrNotBracketed = generic.ErrNotBracketed
+	// ErrDuplicateNodes is returned when two interpolation nodes coincide
+	ErrDuplicateNodes = generic.ErrDuplicateNodes
+	// ErrUnsortedNodes is returned when a method requires increasing interpolation nodes and they are out of order
+	ErrUnsortedNodes = generic.ErrUnsortedNodes
+	// ErrNotSymmetric is returned when a method requires a matrix equal to its transpose
+	ErrNotSymmetric = generic.ErrNotSymmetric
+	// ErrNotPositiveDefinite is returned when a factorization of a positive-definite matrix meets a negative pivot
+	ErrNotPositiveDefinite = generic.ErrNotPositiveDefinite
+	// ErrOverlappingCurves is returned when two curves coincide along a piece of non-zero length
+	ErrOverlappingCurves = generic.ErrOverlappingCurves
+)
+
+// IterationError records which iterative method failed and after how many iterations
+type IterationError = generic.IterationError
+
+// DimensionError records which input has the wrong length, it unwraps to ErrDimensionMismatch
+type DimensionError = generic.DimensionError
+
+// SingularError records the pivot at which a factorization failed, it unwraps to ErrSingular
+type SingularError = generic.SingularError
+
+// SymmetricError records an entry that differs from its transposed entry, it unwraps to ErrNotSymmetric
+type SymmetricError = generic.SymmetricError
+
+// DefiniteError records the pivot at which a factorization of a positive-definite matrix found a negative value,
+// it unwraps to ErrNotPositiveDefinite
+type DefiniteError = generic.DefiniteError[float32]
+
+// OverlapError records the parameter ranges over which two curves coincide, it unwraps to ErrOverlappingCurves
+type OverlapError = generic.OverlapError[float32]
+
+// StepSizeError records where the step size of an adaptive method fell below the minimum step size,
+// it unwraps to ErrStepSizeUnderflow
+type StepSizeError = generic.StepSizeError[float32]
+
+// ArgumentError records which argument was rejected and why, it unwraps to ErrInvalidArgument
+type ArgumentError = generic.ArgumentError
+
+// BracketError records the function values at the ends of an interval that does not bracket a root,
+// it unwraps to ErrNotBracketed
+type BracketError = generic.BracketError[float32]
+
+// NodeError records an interpolation node that duplicates or is out of order with an earlier node,
+// it unwraps to ErrDuplicateNodes or ErrUnsortedNodes and matches ErrInvalidArgument
+type NodeError = generic.NodeError[float32]
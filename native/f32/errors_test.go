@@ -0,0 +1,160 @@
+package methods
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIterationError(t *testing.T) {
+	var err error = &IterationError{Method: "Newton1D", Iterations: 5, Err: ErrMaxIterations}
+
+	if !errors.Is(err, ErrMaxIterations) {
+		t.Errorf("Expected %v to wrap %v", err, ErrMaxIterations)
+	}
+
+	if errors.Is(err, ErrDivergence) {
+		t.Errorf("Expected %v not to wrap %v", err, ErrDivergence)
+	}
+
+	var iterationErr *IterationError
+	if !errors.As(err, &iterationErr) || iterationErr.Iterations != 5 || iterationErr.Method != "Newton1D" {
+		t.Errorf("Expected IterationError, received %v", err)
+	}
+
+	if err.Error() != "Newton1D: Maximum number of iterations exceeded after 5 iterations" {
+		t.Errorf("Unexpected error message %v", err.Error())
+	}
+}
+
+func TestDimensionError(t *testing.T) {
+	var err error = &DimensionError{Name: "functionValues", Expected: 4, Received: 5}
+
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v to wrap %v", err, ErrDimensionMismatch)
+	}
+
+	var dimensionErr *DimensionError
+	if !errors.As(err, &dimensionErr) || dimensionErr.Expected != 4 || dimensionErr.Received != 5 {
+		t.Errorf("Expected DimensionError, received %v", err)
+	}
+
+	if err.Error() != "Dimensions do not match: length of functionValues is 5, expected 4" {
+		t.Errorf("Unexpected error message %v", err.Error())
+	}
+}
+
+func TestSingularError(t *testing.T) {
+	var err error = &SingularError{Pivot: 2}
+
+	if !errors.Is(err, ErrSingular) {
+		t.Errorf("Expected %v to wrap %v", err, ErrSingular)
+	}
+
+	var singularErr *SingularError
+	if !errors.As(err, &singularErr) || singularErr.Pivot != 2 {
+		t.Errorf("Expected SingularError, received %v", err)
+	}
+}
+
+func TestSymmetricError(t *testing.T) {
+	var err error = &SymmetricError{Row: 0, Col: 2}
+
+	if !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("Expected %v to wrap %v", err, ErrNotSymmetric)
+	}
+
+	var symmetricErr *SymmetricError
+	if !errors.As(err, &symmetricErr) || symmetricErr.Col != 2 {
+		t.Errorf("Expected SymmetricError, received %v", err)
+	}
+}
+
+func TestDefiniteError(t *testing.T) {
+	var err error = &DefiniteError{Pivot: 1, Value: -2}
+
+	if !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("Expected %v to wrap %v", err, ErrNotPositiveDefinite)
+	}
+
+	var definiteErr *DefiniteError
+	if !errors.As(err, &definiteErr) || definiteErr.Value != -2 {
+		t.Errorf("Expected DefiniteError, received %v", err)
+	}
+}
+
+func TestOverlapError(t *testing.T) {
+	var err error = &OverlapError{First: [2]float32{0.5, 1}, Second: [2]float32{0, 0.5}}
+
+	if !errors.Is(err, ErrOverlappingCurves) {
+		t.Errorf("Expected %v to wrap %v", err, ErrOverlappingCurves)
+	}
+
+	var overlapErr *OverlapError
+	if !errors.As(err, &overlapErr) || overlapErr.First[0] != 0.5 {
+		t.Errorf("Expected OverlapError, received %v", err)
+	}
+}
+
+func TestStepSizeError(t *testing.T) {
+	var err error = &StepSizeError{Theta: 0.5, StepSize: 0.001, MinStep: 0.01}
+
+	if !errors.Is(err, ErrStepSizeUnderflow) {
+		t.Errorf("Expected %v to wrap %v", err, ErrStepSizeUnderflow)
+	}
+
+	var stepSizeErr *StepSizeError
+	if !errors.As(err, &stepSizeErr) || stepSizeErr.Theta != 0.5 {
+		t.Errorf("Expected StepSizeError, received %v", err)
+	}
+}
+
+func TestArgumentError(t *testing.T) {
+	var err error = &ArgumentError{Name: "N", Value: 0, Reason: "must be at least 1"}
+
+	if !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v to wrap %v", err, ErrInvalidArgument)
+	}
+
+	var argumentErr *ArgumentError
+	if !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError, received %v", err)
+	}
+
+	if err.Error() != "Invalid argument: N is 0, must be at least 1" {
+		t.Errorf("Unexpected error message %v", err.Error())
+	}
+}
+
+func TestBracketError(t *testing.T) {
+	var err error = &BracketError{A: 0, B: 2, FA: 1, FB: 3}
+
+	if !errors.Is(err, ErrNotBracketed) {
+		t.Errorf("Expected %v to wrap %v", err, ErrNotBracketed)
+	}
+
+	var bracketErr *BracketError
+	if !errors.As(err, &bracketErr) || bracketErr.FB != 3 {
+		t.Errorf("Expected BracketError, received %v", err)
+	}
+
+	if err.Error() != "Interval does not bracket a root: f(0) = 1 and f(2) = 3" {
+		t.Errorf("Unexpected error message %v", err.Error())
+	}
+}
+
+func TestNodeError(t *testing.T) {
+	var err error = &NodeError{Name: "xValues", Index: 2, Value: 1, Previous: 0, Err: ErrDuplicateNodes}
+
+	if !errors.Is(err, ErrDuplicateNodes) || !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v to wrap %v and %v", err, ErrDuplicateNodes, ErrInvalidArgument)
+	}
+
+	var nodeErr *NodeError
+	if !errors.As(err, &nodeErr) || nodeErr.Previous != 0 {
+		t.Errorf("Expected NodeError, received %v", err)
+	}
+
+	if err.Error() != "Nodes are not distinct: xValues[2] is 1, the same as xValues[0]" {
+		t.Errorf("Unexpected error message %v", err.Error())
+	}
+}
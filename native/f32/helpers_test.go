@@ -0,0 +1,14 @@
+package methods
+
+import "math"
+
+// maxNormDiff returns the infinity norm of x - y
+func maxNormDiff(x []float32, y []float32) float32 {
+	norm := float32(0)
+	for i := 0; i < len(x); i++ {
+		if diff := float32(math.Abs(float64(x[i] - y[i]))); diff > norm || math.IsNaN(float64(diff)) {
+			norm = diff
+		}
+	}
+	return norm
+}
@@ -0,0 +1,63 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// Interpolant is an interpolating function that can be evaluated, differentiated and integrated
+type Interpolant = generic.Interpolant[float32]
+
+// NewtonPolynomial is an interpolating polynomial in newton's divided-difference form,
+// coefficients[k] multiplies (x - nodes[0])...(x - nodes[k-1])
+type NewtonPolynomial = generic.NewtonPolynomial[float32]
+
+// PiecewisePolynomial is an interpolant made of polynomial pieces, piece i covers [breakpoints[i], breakpoints[i+1]]
+// and coefficients[i] holds its coefficients of ascending powers of x - breakpoints[i].
+// Outside of the breakpoints the first and last pieces are extended.
+type PiecewisePolynomial = generic.PiecewisePolynomial[float32]
+
+// NewNewtonInterpolant builds newton's divided-difference interpolating polynomial through the given points
+func NewNewtonInterpolant(xValues []float32, functionValues []float32) (*NewtonPolynomial, error) {
+	return generic.NewNewtonInterpolant(xValues, functionValues)
+}
+
+// NewHermiteInterpolant builds the hermite interpolating polynomial matching the function values and derivatives
+func NewHermiteInterpolant(xValues []float32, functionValues []float32, dfunctionValues []float32) (*NewtonPolynomial, error) {
+	return generic.NewHermiteInterpolant(xValues, functionValues, dfunctionValues)
+}
+
+// NewNaturalCubicSplineInterpolant builds the natural cubic spline through the given points
+func NewNaturalCubicSplineInterpolant(xValues []float32, functionValues []float32) (*PiecewisePolynomial, error) {
+	return generic.NewNaturalCubicSplineInterpolant(xValues, functionValues)
+}
+
+// NewClampedCubicSplineInterpolant builds the clamped cubic spline through the given points with end slopes df0 and dfN
+func NewClampedCubicSplineInterpolant(xValues []float32, functionValues []float32, df0 float32, dfN float32) (*PiecewisePolynomial, error) {
+	return generic.NewClampedCubicSplineInterpolant(xValues, functionValues, df0, dfN)
+}
+
+// NewNotAKnotCubicSplineInterpolant builds the not-a-knot cubic spline through the given points
+func NewNotAKnotCubicSplineInterpolant(xValues []float32, functionValues []float32) (*PiecewisePolynomial, error) {
+	return generic.NewNotAKnotCubicSplineInterpolant(xValues, functionValues)
+}
+
+// NewPeriodicCubicSplineInterpolant builds the periodic cubic spline through the given points
+func NewPeriodicCubicSplineInterpolant(xValues []float32, functionValues []float32) (*PiecewisePolynomial, error) {
+	return generic.NewPeriodicCubicSplineInterpolant(xValues, functionValues)
+}
+
+// NewMonotoneCubicSplineInterpolant builds the monotone piecewise cubic hermite interpolant through the given points
+func NewMonotoneCubicSplineInterpolant(xValues []float32, functionValues []float32) (*PiecewisePolynomial, error) {
+	return generic.NewMonotoneCubicSplineInterpolant(xValues, functionValues)
+}
+
+// NewAkimaSplineInterpolant builds the akima spline through the given points
+func NewAkimaSplineInterpolant(xValues []float32, functionValues []float32) (*PiecewisePolynomial, error) {
+	return generic.NewAkimaSplineInterpolant(xValues, functionValues)
+}
+
+// NewBezierCurveInterpolant builds the x and y components of the piecewise cubic bezier curve,
+// segment i is traced as the parameter runs over [i, i+1]
+func NewBezierCurveInterpolant(endpoints [][2]float32, leftGuidepoints [][2]float32, rightGuidepoints [][2]float32) (*PiecewisePolynomial, *PiecewisePolynomial, error) {
+	return generic.NewBezierCurveInterpolant(endpoints, leftGuidepoints, rightGuidepoints)
+}
@@ -0,0 +1,56 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewNewtonInterpolant(t *testing.T) {
+	// x^3 - 2x + 1 is reproduced exactly by four nodes
+	interpolant, errA := NewNewtonInterpolant([]float32{-1, 0, 1, 2}, []float32{2, 1, 0, 5})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(0.5); math.Abs(float64(value)-0.125) > 1e-5 {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 1); math.Abs(float64(derivative)+1.25) > 1e-5 {
+		t.Errorf("Expected %v, received %v", -1.25, derivative)
+	}
+
+	if integral := interpolant.Integral(0, 2); math.Abs(float64(integral)-2) > 1e-5 {
+		t.Errorf("Expected %v, received %v", 2, integral)
+	}
+
+	_, errB := NewNewtonInterpolant([]float32{1, 2}, []float32{1})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewClampedCubicSplineInterpolant(t *testing.T) {
+	// a clamped spline with the exact end slopes reproduces a cubic
+	var interpolant Interpolant
+	interpolant, errA := NewClampedCubicSplineInterpolant([]float32{0, 1, 2, 3}, []float32{0, 1, 8, 27}, 0, 27)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(1.5); math.Abs(float64(value)-3.375) > 10*1e-5 {
+		t.Errorf("Expected %v, received %v", 3.375, value)
+	}
+
+	if integral := interpolant.Integral(0, 3); math.Abs(float64(integral)-20.25) > 100*1e-5 {
+		t.Errorf("Expected %v, received %v", 20.25, integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != 0 || upper != 3 {
+		t.Errorf("Expected domain [0, 3], received [%v, %v]", lower, upper)
+	}
+}
@@ -0,0 +1,53 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// LU returns the L U factorization P A = L U of the n x n row-major matrix a with partial pivoting, where L is unit
+// lower triangular, U is upper triangular and row i of P A is row P[i] of a, a is not modified,
+// the elimination is blocked so that the trailing matrix is updated by blocks of rows kept in cache
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func LU(a []float32, n int) (L []float32, U []float32, P []int, err error) {
+	return generic.LU(a, n)
+}
+
+// LDLt returns the unit lower triangular L and the diagonal D of the L D L^T factorization of the n x n row-major
+// matrix a if it is symmetric positive-definite else error, a is not modified
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func LDLt(a []float32, n int) (L []float32, D []float32, err error) {
+	return generic.LDLt(a, n)
+}
+
+// Cholesky returns the lower triangular L of the L L^T factorization of the n x n row-major matrix a
+// if it is symmetric positive-definite else error, a is not modified
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func Cholesky(a []float32, n int) (L []float32, err error) {
+	return generic.Cholesky(a, n)
+}
+
+// ForwardSubstitution solves L y = b for the n x n row-major lower triangular matrix L, the entries above the diagonal are not read
+func ForwardSubstitution(L []float32, n int, b []float32) ([]float32, error) {
+	return generic.ForwardSubstitution(L, n, b)
+}
+
+// BackwardSubstitution solves U x = y for the n x n row-major upper triangular matrix U, the entries below the diagonal are not read
+func BackwardSubstitution(U []float32, n int, y []float32) ([]float32, error) {
+	return generic.BackwardSubstitution(U, n, y)
+}
+
+// SolveLU solves A x = b given the factorization P A = L U returned by LU, so that a factorization
+// can be reused for any number of right hand sides
+func SolveLU(L []float32, U []float32, P []int, n int, b []float32) ([]float32, error) {
+	return generic.SolveLU(L, U, P, n, b)
+}
+
+// SolveLDLt solves A x = b given the factorization A = L D L^T returned by LDLt
+func SolveLDLt(L []float32, D []float32, n int, b []float32) ([]float32, error) {
+	return generic.SolveLDLt(L, D, n, b)
+}
+
+// SolveCholesky solves A x = b given the factorization A = L L^T returned by Cholesky
+func SolveCholesky(L []float32, n int, b []float32) ([]float32, error) {
+	return generic.SolveCholesky(L, n, b)
+}
@@ -0,0 +1,97 @@
+package methods
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLU(t *testing.T) {
+	L, U, P, errA := LU([]float32{0, 2, 1, 1, 1, 1, 2, 1, 3}, 3)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if maxNormDiff([]float32{1, 0, 0, 0, 1, 0, 0.5, 0.25, 1}, L) > 1e-5 || maxNormDiff([]float32{2, 1, 3, 0, 2, 1, 0, 0, -0.75}, U) > 1e-5 {
+		t.Errorf("Expected L = [1 0 0 0 1 0 0.5 0.25 1] and U = [2 1 3 0 2 1 0 0 -0.75], received %v and %v", L, U)
+	}
+
+	if x, err := SolveLU(L, U, P, 3, []float32{7, 6, 13}); err != nil || maxNormDiff([]float32{1, 2, 3}, x) > 1e-5 {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	_, _, _, errB := LU([]float32{1, 2, 2, 4}, 2)
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+}
+
+func TestLDLt(t *testing.T) {
+	a := []float32{4, -1, 1, -1, 4.25, 2.75, 1, 2.75, 3.5}
+	L, D, errA := LDLt(a, 3)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if maxNormDiff([]float32{1, 0, 0, -0.25, 1, 0, 0.25, 0.75, 1}, L) > 1e-5 || maxNormDiff([]float32{4, 4, 1}, D) > 1e-5 {
+		t.Errorf("Expected L = [1 0 0 -0.25 1 0 0.25 0.75 1] and D = [4 4 1], received %v and %v", L, D)
+	}
+
+	if x, err := SolveLDLt(L, D, 3, []float32{5, 15.75, 17}); err != nil || maxNormDiff([]float32{1, 2, 3}, x) > 1e-5 {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	_, _, errB := LDLt([]float32{1, 2, 3, 1}, 2)
+
+	if !errors.Is(errB, ErrNotSymmetric) {
+		t.Errorf("Expected %v, received %v", ErrNotSymmetric, errB)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	L, errA := Cholesky([]float32{4, -1, 1, -1, 4.25, 2.75, 1, 2.75, 3.5}, 3)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if maxNormDiff([]float32{2, 0, 0, -0.5, 2, 0, 0.5, 1.5, 1}, L) > 1e-5 {
+		t.Errorf("Expected [2 0 0 -0.5 2 0 0.5 1.5 1], received %v", L)
+	}
+
+	if x, err := SolveCholesky(L, 3, []float32{5, 15.75, 17}); err != nil || maxNormDiff([]float32{1, 2, 3}, x) > 1e-5 {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	_, errB := Cholesky([]float32{1, 2, 2, 1}, 2)
+
+	if !errors.Is(errB, ErrNotPositiveDefinite) {
+		t.Errorf("Expected %v, received %v", ErrNotPositiveDefinite, errB)
+	}
+}
+
+func TestForwardSubstitution(t *testing.T) {
+	if y, err := ForwardSubstitution([]float32{2, 0, 0, 1, 1, 0, -1, 2, 4}, 3, []float32{2, 3, 13}); err != nil || maxNormDiff([]float32{1, 2, 2.5}, y) > 1e-5 {
+		t.Errorf("Expected [1 2 2.5], received %v and %v", y, err)
+	}
+
+	_, err := ForwardSubstitution([]float32{1, 0, 1, 1}, 2, []float32{1})
+
+	if !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+}
+
+func TestBackwardSubstitution(t *testing.T) {
+	if x, err := BackwardSubstitution([]float32{2, 1, -1, 0, 1, 2, 0, 0, 4}, 3, []float32{1, 8, 12}); err != nil || maxNormDiff([]float32{1, 2, 3}, x) > 1e-5 {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	_, err := BackwardSubstitution([]float32{0, 1, 0, 1}, 2, []float32{1, 1})
+
+	if !errors.Is(err, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, err)
+	}
+}
@@ -0,0 +1,77 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// RadialBasis selects the kernel of a radial basis function interpolant
+type RadialBasis = generic.RadialBasis
+
+const (
+	// GaussianBasis is the kernel exp(-(epsilon r)^2)
+	GaussianBasis = generic.GaussianBasis
+	// MultiquadricBasis is the kernel sqrt(1 + (epsilon r)^2)
+	MultiquadricBasis = generic.MultiquadricBasis
+	// ThinPlateBasis is the kernel r^2 log(r), it does not use the shape parameter
+	ThinPlateBasis = generic.ThinPlateBasis
+)
+
+// BilinearInterpolant interpolates values on a rectilinear grid with a bilinear polynomial in each cell
+type BilinearInterpolant = generic.BilinearInterpolant[float32]
+
+// BicubicInterpolant interpolates values on a rectilinear grid with a bicubic hermite patch in each cell,
+// the partial derivatives at the grid points are estimated with finite differences
+type BicubicInterpolant = generic.BicubicInterpolant[float32]
+
+// TensorSplineInterpolant is the tensor product of natural cubic splines on a rectilinear grid, a spline is fitted along y
+// for every x grid value and each evaluation fits a natural cubic spline along x through their values
+// Algorithm from Numerical Recipes - By Press, Teukolsky, Vetterling and Flannery
+type TensorSplineInterpolant = generic.TensorSplineInterpolant[float32]
+
+// MultilinearInterpolant interpolates values on a rectilinear grid of any dimension,
+// it is linear along each axis inside every grid cell
+type MultilinearInterpolant = generic.MultilinearInterpolant[float32]
+
+// RadialBasisInterpolant interpolates scattered data in any dimension with a sum of radial kernels centred on the data
+// points plus a linear polynomial, which makes the thin plate spline well posed and reproduces linear functions
+type RadialBasisInterpolant = generic.RadialBasisInterpolant[float32]
+
+// InverseDistanceInterpolant interpolates scattered data in any dimension by shepard's method,
+// a weighted mean of the data with weights 1 / d^power for a point at distance d
+type InverseDistanceInterpolant = generic.InverseDistanceInterpolant[float32]
+
+// NewBilinearInterpolant builds the bilinear interpolant of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing
+func NewBilinearInterpolant(xValues []float32, yValues []float32, functionValues [][]float32) (*BilinearInterpolant, error) {
+	return generic.NewBilinearInterpolant(xValues, yValues, functionValues)
+}
+
+// NewBicubicInterpolant builds the bicubic interpolant of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing, the interpolant reproduces quadratic functions when each axis has three or more points
+func NewBicubicInterpolant(xValues []float32, yValues []float32, functionValues [][]float32) (*BicubicInterpolant, error) {
+	return generic.NewBicubicInterpolant(xValues, yValues, functionValues)
+}
+
+// NewTensorSplineInterpolant builds the tensor product spline of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing
+func NewTensorSplineInterpolant(xValues []float32, yValues []float32, functionValues [][]float32) (*TensorSplineInterpolant, error) {
+	return generic.NewTensorSplineInterpolant(xValues, yValues, functionValues)
+}
+
+// NewMultilinearInterpolant builds the multilinear interpolant of values on the grid spanned by axes, the values are
+// ordered with the last axis varying fastest, so for two axes values[i*len(axes[1])+j] = f(axes[0][i], axes[1][j])
+func NewMultilinearInterpolant(axes [][]float32, values []float32) (*MultilinearInterpolant, error) {
+	return generic.NewMultilinearInterpolant(axes, values)
+}
+
+// NewRadialBasisInterpolant builds the radial basis function interpolant of values at points with the given kernel
+// and shape parameter epsilon, there must be more points than the dimension and they may not all lie on a hyperplane
+func NewRadialBasisInterpolant(points [][]float32, values []float32, basis RadialBasis, epsilon float32) (*RadialBasisInterpolant, error) {
+	return generic.NewRadialBasisInterpolant(points, values, basis, epsilon)
+}
+
+// NewInverseDistanceInterpolant builds the inverse distance weighted interpolant of values at points,
+// larger powers make the interpolant flatter around the data points
+func NewInverseDistanceInterpolant(points [][]float32, values []float32, power float32) (*InverseDistanceInterpolant, error) {
+	return generic.NewInverseDistanceInterpolant(points, values, power)
+}
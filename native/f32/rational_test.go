@@ -0,0 +1,77 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewFloaterHormannInterpolant(t *testing.T) {
+	xValues := []float32{-1, -0.5, 0, 0.5, 1, 1.5}
+	functionValues := make([]float32, len(xValues))
+	for i, x := range xValues {
+		functionValues[i] = x*x*x - 2*x
+	}
+
+	weights, errA := FloaterHormannWeights([]float32{0, 1, 2, 3, 4}, 1)
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if diff := maxNormDiff([]float32{-1, 2, -2, 2, -1}, weights); diff > 1e-5 {
+		t.Errorf("Expected %v, received %v", []float32{-1, 2, -2, 2, -1}, weights)
+	}
+
+	interpolant, errB := NewFloaterHormannInterpolant(xValues, functionValues, 3)
+
+	if errB != nil {
+		t.Fatalf("Error %v", errB)
+	}
+
+	if value := interpolant.Eval(0.3); math.Abs(float64(value)+0.573) > 1e-5 {
+		t.Errorf("Expected %v, received %v", -0.573, value)
+	}
+
+	if derivative := interpolant.Derivative(0.3, 1); math.Abs(float64(derivative)+1.73) > 1e-5 {
+		t.Errorf("Expected %v, received %v", -1.73, derivative)
+	}
+
+	if integral := interpolant.Integral(-1, 1.5); math.Abs(float64(integral)+0.234375) > 1e-5 {
+		t.Errorf("Expected %v, received %v", -0.234375, integral)
+	}
+
+	_, errC := NewFloaterHormannInterpolant(xValues, functionValues, 6)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestNewPadeApproximant(t *testing.T) {
+	numerator, denominator, errA := Pade([]float32{1, 1, 1.0 / 2, 1.0 / 6, 1.0 / 24}, 2, 2)
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if maxNormDiff([]float32{1, 0.5, 1.0 / 12}, numerator) > 1e-5 || maxNormDiff([]float32{1, -0.5, 1.0 / 12}, denominator) > 1e-5 {
+		t.Errorf("Expected [1 0.5 0.0833] and [1 -0.5 0.0833], received %v and %v", numerator, denominator)
+	}
+
+	approximant, errB := NewPadeApproximant([]float32{0, 1, -0.5}, 1, 1)
+
+	if errB != nil {
+		t.Fatalf("Error %v", errB)
+	}
+
+	if derivative := approximant.Derivative(1, 1); math.Abs(float64(derivative)-4.0/9) > 1e-5 {
+		t.Errorf("Expected %v, received %v", 4.0/9, derivative)
+	}
+
+	_, errC := NewPadeApproximant([]float32{1, 0, 0, 0}, 1, 2)
+
+	if !errors.Is(errC, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errC)
+	}
+}
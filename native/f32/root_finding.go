@@ -1,209 +1,97 @@
 package methods
 
 import (
-	"errors"
-	"math"
+	"github.com/NumberXNumbers/methods/native/generic"
 )
 
+// RootFindingResult holds the iteration history and convergence diagnostics of a 1D root finding method
+type RootFindingResult = generic.RootFindingResult[float32]
+
 // Bisection1D is for solving the 1D root finding bisection method
 func Bisection1D(intervalBegin float32, intervalEnd float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
-	fOfA := f(intervalBegin)
-	currentX := intervalBegin + (intervalEnd-intervalBegin)/float32(2)
-	fOfCurrentX := f(currentX)
-	root := float32(0)
-	solutionFound := false
-
-	for i := 0; i < maxIteration; i++ {
-		if fOfCurrentX == 0 || (intervalEnd-intervalBegin)/float32(2) < TOL {
-			root = currentX
-			solutionFound = true
-			break
-		}
-
-		if fOfA*fOfCurrentX > 0 {
-			intervalBegin = currentX
-			fOfA = fOfCurrentX
-		} else {
-			intervalEnd = currentX
-		}
-
-		currentX = intervalBegin + (intervalEnd-intervalBegin)/float32(2)
-		fOfCurrentX = f(currentX)
-	}
-
-	if solutionFound {
-		return root, nil
-	}
+	return generic.Bisection1D(intervalBegin, intervalEnd, TOL, maxIteration, f)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// Bisection1DWithHistory is Bisection1D, also returning the iteration history
+func Bisection1DWithHistory(intervalBegin float32, intervalEnd float32, TOL float32, maxIteration int, f func(x float32) float32) (RootFindingResult, error) {
+	return generic.Bisection1DWithHistory(intervalBegin, intervalEnd, TOL, maxIteration, f)
 }
 
 // FixedPointIteration1D is for solving the 1D root finding fixed point iteration method
 func FixedPointIteration1D(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
-	previousApprox := initialApprox
-	currentApprox := f(previousApprox)
-	root := float32(0)
-	solutionFound := false
-
-	for i := 0; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox = currentApprox
-		currentApprox = f(previousApprox)
-	}
-
-	if solutionFound {
-		return root, nil
-	}
+	return generic.FixedPointIteration1D(initialApprox, TOL, maxIteration, f)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// FixedPointIteration1DWithHistory is FixedPointIteration1D, also returning the iteration history
+func FixedPointIteration1DWithHistory(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32) (RootFindingResult, error) {
+	return generic.FixedPointIteration1DWithHistory(initialApprox, TOL, maxIteration, f)
 }
 
 // Newton1D is for solving the 1D  root finding newton's method
 func Newton1D(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32, df func(x float32) float32) (float32, error) {
-	previousApprox := initialApprox
-	currentApprox := previousApprox - f(previousApprox)/df(previousApprox)
-	root := float32(0)
-	solutionFound := false
-
-	for i := 0; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox = currentApprox
-		currentApprox = previousApprox - f(previousApprox)/df(previousApprox)
-	}
-
-	if solutionFound {
-		return root, nil
-	}
+	return generic.Newton1D(initialApprox, TOL, maxIteration, f, df)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// Newton1DWithHistory is Newton1D, also returning the iteration history
+func Newton1DWithHistory(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32, df func(x float32) float32) (RootFindingResult, error) {
+	return generic.Newton1DWithHistory(initialApprox, TOL, maxIteration, f, df)
 }
 
 // ModifiedNewton1D is a modification for solving the 1D  root finding newton's method
 func ModifiedNewton1D(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32,
 	df func(x float32) float32, ddf func(x float32) float32) (float32, error) {
-	previousApprox := initialApprox
-	currentApprox := previousApprox - f(previousApprox)*df(previousApprox)/(float32(math.Pow(float64(df(previousApprox)), 2))-f(previousApprox)*ddf(previousApprox))
-	root := float32(0)
-	solutionFound := false
-
-	for i := 0; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox = currentApprox
-		currentApprox = previousApprox - f(previousApprox)*df(previousApprox)/(float32(math.Pow(float64(df(previousApprox)), 2))-f(previousApprox)*ddf(previousApprox))
-	}
-
-	if solutionFound {
-		return root, nil
-	}
+	return generic.ModifiedNewton1D(initialApprox, TOL, maxIteration, f, df, ddf)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// ModifiedNewton1DWithHistory is ModifiedNewton1D, also returning the iteration history
+func ModifiedNewton1DWithHistory(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32,
+	df func(x float32) float32, ddf func(x float32) float32) (RootFindingResult, error) {
+	return generic.ModifiedNewton1DWithHistory(initialApprox, TOL, maxIteration, f, df, ddf)
 }
 
 // Secant1D is for solving the 1D root finding secant method
 func Secant1D(initialApprox1 float32, intitialApprox2 float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
-	previousApprox1 := initialApprox1
-	previousApprox2 := intitialApprox2
-	currentApprox := previousApprox2 - f(previousApprox2)*(previousApprox2-previousApprox1)/(f(previousApprox2)-f(previousApprox1))
-	root := float32(0)
-	solutionFound := false
-
-	for i := 1; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox2))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox1 = previousApprox2
-		previousApprox2 = currentApprox
-		currentApprox = previousApprox2 - f(previousApprox2)*(previousApprox2-previousApprox1)/(f(previousApprox2)-f(previousApprox1))
-	}
-
-	if solutionFound {
-		return root, nil
-	}
+	return generic.Secant1D(initialApprox1, intitialApprox2, TOL, maxIteration, f)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// Secant1DWithHistory is Secant1D, also returning the iteration history
+func Secant1DWithHistory(initialApprox1 float32, intitialApprox2 float32, TOL float32, maxIteration int, f func(x float32) float32) (RootFindingResult, error) {
+	return generic.Secant1DWithHistory(initialApprox1, intitialApprox2, TOL, maxIteration, f)
 }
 
 // FalsePosition1D is for solving the 1D root finding false position method
 func FalsePosition1D(initialApprox1 float32, initialApprox2 float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
-	previousApprox1 := initialApprox1
-	previousApprox2 := initialApprox2
-	fOfApprox1 := f(previousApprox1)
-	fOfApprox2 := f(previousApprox2)
-	currentApprox := previousApprox2 - fOfApprox2*(previousApprox2-previousApprox1)/(fOfApprox2-fOfApprox1)
-	fOfCurrentApprox := f(currentApprox)
-	root := float32(0)
-	solutionFound := false
-
-	for i := 1; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox2))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
+	return generic.FalsePosition1D(initialApprox1, initialApprox2, TOL, maxIteration, f)
+}
 
-		if fOfCurrentApprox*fOfApprox2 < 0 {
-			previousApprox1 = previousApprox2
-			fOfApprox1 = fOfApprox2
-		}
+// FalsePosition1DWithHistory is FalsePosition1D, also returning the iteration history
+func FalsePosition1DWithHistory(initialApprox1 float32, initialApprox2 float32, TOL float32, maxIteration int, f func(x float32) float32) (RootFindingResult, error) {
+	return generic.FalsePosition1DWithHistory(initialApprox1, initialApprox2, TOL, maxIteration, f)
+}
 
-		previousApprox2 = currentApprox
-		fOfApprox2 = fOfCurrentApprox
+// Steffensen1D is for solving the 1D root finding Steffensen's mehtod
+func Steffensen1D(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
+	return generic.Steffensen1D(initialApprox, TOL, maxIteration, f)
+}
 
-		currentApprox = previousApprox2 - fOfApprox2*(previousApprox2-previousApprox1)/(fOfApprox2-fOfApprox1)
-		fOfCurrentApprox = f(currentApprox)
-	}
+// Steffensen1DWithHistory is Steffensen1D, also returning the iteration history
+func Steffensen1DWithHistory(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32) (RootFindingResult, error) {
+	return generic.Steffensen1DWithHistory(initialApprox, TOL, maxIteration, f)
+}
 
-	if solutionFound {
-		return root, nil
-	}
+// FixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+func FixedPointIteration(initialApprox []float32, TOL float32, maxIteration int, f func(x []float32) []float32) ([]float32, error) {
+	return generic.FixedPointIteration(initialApprox, TOL, maxIteration, f)
+}
 
-	return root, errors.New("Unable to find root of given function")
+// SteffensenFixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+// accelerated by applying Aitken's delta-squared process to each component (Steffensen's method)
+func SteffensenFixedPointIteration(initialApprox []float32, TOL float32, maxIteration int, f func(x []float32) []float32) ([]float32, error) {
+	return generic.SteffensenFixedPointIteration(initialApprox, TOL, maxIteration, f)
 }
 
-// Steffensen1D is for solving the 1D root finding Steffensen's mehtod
-func Steffensen1D(initialApprox float32, TOL float32, maxIteration int, f func(x float32) float32) (float32, error) {
-	previousApprox1 := initialApprox
-	previousApprox2 := f(previousApprox1)
-	previousApprox3 := f(previousApprox2)
-	currentApprox := previousApprox1 - float32(math.Pow(float64(previousApprox2-previousApprox1), 2))/(previousApprox3-2*previousApprox2+previousApprox1)
-	root := float32(0)
-	solutionFound := false
-
-	for i := 0; i < maxIteration; i++ {
-		if float32(math.Abs(float64(currentApprox-previousApprox1))) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox1 = currentApprox
-		previousApprox2 = f(previousApprox1)
-		previousApprox3 = f(previousApprox2)
-
-		currentApprox = previousApprox1 - float32(math.Pow(float64(previousApprox2-previousApprox1), 2))/(previousApprox3-2*previousApprox2+previousApprox1)
-	}
-
-	if solutionFound {
-		return root, nil
-	}
-
-	return root, errors.New("Unable to find root of given function")
+// AndersonFixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+// with Anderson acceleration, mixing the last depth iterates. A depth of 0 is plain fixed point iteration.
+func AndersonFixedPointIteration(initialApprox []float32, depth int, TOL float32, maxIteration int, f func(x []float32) []float32) ([]float32, error) {
+	return generic.AndersonFixedPointIteration(initialApprox, depth, TOL, maxIteration, f)
 }
@@ -1,6 +1,7 @@
 package methods
 
 import (
+	"errors"
 	"math"
 	"testing"
 )
@@ -25,6 +26,31 @@ func TestBisection1D(t *testing.T) {
 	if errB == nil {
 		t.Error("Expected error")
 	}
+
+	_, errD := Bisection1D(1, 2, float32(math.Pow(10, -4)), 100, testFunction)
+
+	var bracketErr *BracketError
+	if !errors.As(errD, &bracketErr) || !errors.Is(errD, ErrNotBracketed) {
+		t.Errorf("Expected BracketError, received %v", errD)
+	}
+
+	_, errE := Bisection1D(2, 0, float32(math.Pow(10, -4)), 100, testFunction)
+
+	if !errors.Is(errE, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errE)
+	}
+
+	_, errF := Bisection1D(0, 2, 0, 100, testFunction)
+
+	if !errors.Is(errF, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errF)
+	}
+
+	_, errG := Bisection1D(0, 2, float32(math.Pow(10, -4)), 0, testFunction)
+
+	if !errors.Is(errG, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errG)
+	}
 }
 
 func TestFixedPointIteration1D(t *testing.T) {
@@ -170,3 +196,209 @@ func TestSteffensen1D(t *testing.T) {
 		t.Error("Expected error")
 	}
 }
+
+func TestFixedPointIteration(t *testing.T) {
+	testFunction := func(x []float32) []float32 {
+		return []float32{
+			float32(math.Cos(float64(x[1]*x[2])))/3.0 + 1.0/6.0,
+			float32(math.Sqrt(float64(x[0]*x[0]+float32(math.Sin(float64(x[2])))+1.06)))/9.0 - 0.1,
+			-float32(math.Exp(float64(-x[0]*x[1])))/20.0 - (10.0*math.Pi-3.0)/60.0,
+		}
+	}
+
+	solution := []float32{0.5, 0, -math.Pi / 6}
+
+	rootA, errA := FixedPointIteration([]float32{0.1, 0.1, -0.1}, float32(math.Pow(10, -6)), 100, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if diff := maxNormDiff(rootA, solution); diff >= float32(math.Pow(10, -5)) {
+		t.Errorf("Expected %v, received %v", solution, rootA)
+	}
+
+	_, errB := FixedPointIteration([]float32{0.1, 0.1, -0.1}, float32(math.Pow(10, -6)), 2, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	testFunctionBad := func(x []float32) []float32 {
+		return x[:1]
+	}
+
+	_, errC := FixedPointIteration([]float32{0.1, 0.1, -0.1}, float32(math.Pow(10, -6)), 100, testFunctionBad)
+
+	if errC == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestSteffensenFixedPointIteration(t *testing.T) {
+	testFunction := func(x []float32) []float32 {
+		return []float32{
+			float32(math.Sqrt(float64((5 - x[0] - float32(math.Pow(float64(x[0]), 3))) / 5.0))),
+			0.99*x[1] + 0.01,
+		}
+	}
+
+	solution := []float32{0.8434, 1}
+
+	rootA, errA := SteffensenFixedPointIteration([]float32{0.7, 0}, float32(math.Pow(10, -5)), 10, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if diff := maxNormDiff(rootA, solution); diff >= float32(math.Pow(10, -4)) {
+		t.Errorf("Expected %v, received %v", solution, rootA)
+	}
+
+	_, errB := FixedPointIteration([]float32{0.7, 0}, float32(math.Pow(10, -5)), 10, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestAndersonFixedPointIteration(t *testing.T) {
+	testFunction := func(x []float32) []float32 {
+		return []float32{
+			0.99*x[0] + 0.005*x[1] + 0.01,
+			0.002*x[0] + 0.98*x[1] - 0.02,
+			0.5 * float32(math.Cos(float64(x[2]))),
+		}
+	}
+
+	residual := func(x []float32) float32 {
+		return maxNormDiff(testFunction(x), x)
+	}
+
+	rootA, errA := AndersonFixedPointIteration([]float32{0, 0, 0}, 3, float32(math.Pow(10, -5)), 50, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if res := residual(rootA); res >= float32(math.Pow(10, -4)) {
+		t.Errorf("Expected residual below %v, received %v", math.Pow(10, -4), res)
+	}
+
+	_, errB := AndersonFixedPointIteration([]float32{0, 0, 0}, 0, float32(math.Pow(10, -5)), 50, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	_, errC := AndersonFixedPointIteration([]float32{0, 0, 0}, -1, float32(math.Pow(10, -5)), 50, testFunction)
+
+	var argumentErr *ArgumentError
+	if !errors.As(errC, &argumentErr) || argumentErr.Name != "depth" {
+		t.Errorf("Expected ArgumentError for depth, received %v", errC)
+	}
+}
+
+func TestNewton1DWithHistory(t *testing.T) {
+	testFunction := func(x float32) float32 {
+		return float32(math.Pow(float64(x), 3)) + 5*float32(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	testFunctionD := func(x float32) float32 {
+		return 3*float32(math.Pow(float64(x), 2)) + 10*x + 1
+	}
+
+	resultA, errA := Newton1DWithHistory(0.7, float32(math.Pow(10, -5)), 10, testFunction, testFunctionD)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if resultA.Root != resultA.Iterates[len(resultA.Iterates)-1] {
+		t.Errorf("Expected root %v to be the last iterate, received %v", resultA.Root, resultA.Iterates)
+	}
+
+	if len(resultA.Iterates) != resultA.Iterations+1 || len(resultA.Residuals) != len(resultA.Iterates) ||
+		len(resultA.StepSizes) != resultA.Iterations {
+		t.Errorf("Unexpected history lengths %+v", resultA)
+	}
+
+	if resultA.FunctionEvaluations != 2*resultA.Iterations+1 {
+		t.Errorf("Expected %v function evaluations, received %v", 2*resultA.Iterations+1, resultA.FunctionEvaluations)
+	}
+
+	_, errB := Newton1DWithHistory(0.7, float32(math.Pow(10, -5)), 2, testFunction, testFunctionD)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	var iterationErr *IterationError
+	if !errors.As(errB, &iterationErr) || iterationErr.Iterations != 2 || iterationErr.Method != "Newton1D" {
+		t.Errorf("Expected IterationError after 2 iterations, received %v", errB)
+	}
+
+	testFunctionDZero := func(x float32) float32 {
+		return 0
+	}
+
+	_, errC := Newton1DWithHistory(0.7, float32(math.Pow(10, -5)), 10, testFunction, testFunctionDZero)
+
+	if !errors.Is(errC, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errC)
+	}
+}
+
+func TestSecant1DWithHistory(t *testing.T) {
+	testFunction := func(x float32) float32 {
+		return float32(math.Pow(float64(x), 3)) + 5*float32(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	resultA, errA := Secant1DWithHistory(0.5, 1.5, float32(math.Pow(10, -5)), 20, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if resultA.FunctionEvaluations != resultA.Iterations+2 {
+		t.Errorf("Expected %v function evaluations, received %v", resultA.Iterations+2, resultA.FunctionEvaluations)
+	}
+
+	testFunctionFlat := func(x float32) float32 {
+		return 1
+	}
+
+	_, errB := Secant1DWithHistory(0.5, 1.5, float32(math.Pow(10, -5)), 20, testFunctionFlat)
+
+	if !errors.Is(errB, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errB)
+	}
+}
+
+func TestFixedPointIteration1DWithHistory(t *testing.T) {
+	testFunction := func(x float32) float32 {
+		return x * x
+	}
+
+	resultA, errA := FixedPointIteration1DWithHistory(10, float32(math.Pow(10, -4)), 100, testFunction)
+
+	if !errors.Is(errA, ErrDivergence) {
+		t.Errorf("Expected %v, received %v", ErrDivergence, errA)
+	}
+
+	if resultA.Iterations >= 100 {
+		t.Errorf("Expected divergence to be detected early, received %v iterations", resultA.Iterations)
+	}
+
+	resultB, errB := FixedPointIteration1DWithHistory(0.5, float32(math.Pow(10, -4)), 100, testFunction)
+
+	if errB != nil {
+		t.Errorf("Unexpected error, %v", errB)
+	}
+
+	for i := 1; i < len(resultB.Residuals); i++ {
+		if resultB.Residuals[i] > resultB.Residuals[i-1] {
+			t.Errorf("Expected decreasing residuals, received %v", resultB.Residuals)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// BarycentricPolynomial is an interpolating polynomial in barycentric form, each evaluation costs O(n)
+// once the weights are known and nodes can be added in O(n)
+type BarycentricPolynomial = generic.BarycentricPolynomial[float64]
+
+// LagrangeBasis returns the value of each lagrange basis polynomial of xValues at valueToApprox
+func LagrangeBasis(valueToApprox float64, xValues []float64) ([]float64, error) {
+	return generic.LagrangeBasis(valueToApprox, xValues)
+}
+
+// Lagrange returns the value at valueToApprox of the lagrange interpolating polynomial through the given points
+func Lagrange(valueToApprox float64, xValues []float64, functionValues []float64) (float64, error) {
+	return generic.Lagrange(valueToApprox, xValues, functionValues)
+}
+
+// BarycentricWeights returns the barycentric weights of xValues, every difference is divided by a quarter
+// of the width of the nodes so the weights stay representable, the common factor cancels in the barycentric formula
+func BarycentricWeights(xValues []float64) ([]float64, error) {
+	return generic.BarycentricWeights(xValues)
+}
+
+// ChebyshevNodes returns the N chebyshev points of the first kind mapped to [a, b] in ascending order,
+// interpolating at them avoids the runge phenomenon of equispaced nodes
+func ChebyshevNodes(N int, a float64, b float64) ([]float64, error) {
+	return generic.ChebyshevNodes(N, a, b)
+}
+
+// NewBarycentricInterpolant builds the barycentric interpolating polynomial through the given points
+func NewBarycentricInterpolant(xValues []float64, functionValues []float64) (*BarycentricPolynomial, error) {
+	return generic.NewBarycentricInterpolant(xValues, functionValues)
+}
+
+// NewChebyshevInterpolant builds the barycentric interpolating polynomial of f at the N chebyshev points of [a, b],
+// the weights are known in closed form
+func NewChebyshevInterpolant(f func(float64) float64, N int, a float64, b float64) (*BarycentricPolynomial, error) {
+	return generic.NewChebyshevInterpolant(f, N, a, b)
+}
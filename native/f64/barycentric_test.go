@@ -0,0 +1,69 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLagrange(t *testing.T) {
+	value, errA := Lagrange(1.5, []float64{1, 1.3, 1.6, 1.9, 2.2}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if math.Abs(float64(value)-0.8646893666666667) > 10*1e-12 {
+		t.Errorf("Expected %v, received %v", 0.8646893666666667, value)
+	}
+
+	_, errB := Lagrange(1.5, []float64{1, 1.3}, []float64{0.9153827})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewBarycentricInterpolant(t *testing.T) {
+	interpolant, errA := NewBarycentricInterpolant([]float64{-1, 0}, []float64{2, 1})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if err := interpolant.AddNode(1, 0); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := interpolant.AddNode(2, 5); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	// x^3 - 2x + 1 is reproduced exactly by four nodes
+	if value := interpolant.Eval(0.5); math.Abs(float64(value)-0.125) > 10*1e-12 {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if err := interpolant.AddNode(2, 5); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestNewChebyshevInterpolant(t *testing.T) {
+	runge := func(x float64) float64 { return 1 / (1 + 25*x*x) }
+
+	interpolant, errA := NewChebyshevInterpolant(runge, 41, -1, 1)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(0.975); math.Abs(float64(value-runge(0.975))) > 1e-2 {
+		t.Errorf("Expected %v, received %v", runge(0.975), value)
+	}
+
+	nodes, _ := ChebyshevNodes(41, -1, 1)
+	if received := interpolant.Nodes(); len(received) != len(nodes) || received[0] != nodes[0] {
+		t.Errorf("Expected the chebyshev nodes %v, received %v", nodes, received)
+	}
+}
@@ -0,0 +1,75 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewBezier(t *testing.T) {
+	curve, errA := NewBezier([][]float64{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if point := curve.Eval(0.5); maxNormDiff([]float64{2, 1.5}, point) > 1e-12 {
+		t.Errorf("Expected %v, received %v", []float64{2, 1.5}, point)
+	}
+
+	left, right := curve.Split(0.5)
+	if point := left.Eval(1); maxNormDiff([]float64{2, 1.5}, point) > 1e-12 {
+		t.Errorf("Expected %v, received %v", []float64{2, 1.5}, point)
+	}
+
+	if point := right.ElevateDegree().Eval(0.5); maxNormDiff(curve.Eval(0.75), point) > 1e-12 {
+		t.Errorf("Expected %v, received %v", curve.Eval(0.75), point)
+	}
+
+	lower, upper, errB := curve.BoundingBox()
+
+	if errB != nil {
+		t.Errorf("Error %v", errB)
+	}
+
+	if maxNormDiff([]float64{0, 0}, lower) > 1e-12 || maxNormDiff([]float64{4, 1.5}, upper) > 1e-12 {
+		t.Errorf("Expected [0 0] and [4 1.5], received %v and %v", lower, upper)
+	}
+
+	_, errC := NewBezier([][]float64{{0, 0}, {1}})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestBezierArcLength(t *testing.T) {
+	// a quarter circle approximated with the usual cubic has a length within 3e-4 of pi / 2
+	k := 4 * (math.Sqrt2 - 1) / 3
+	curve, _ := NewBezier([][]float64{{1, 0}, {1, k}, {k, 1}, {0, 1}})
+
+	length, err := curve.ArcLength(0, 1, 1e-12)
+
+	if err != nil {
+		t.Errorf("Error %v", err)
+	}
+
+	if math.Abs(length-math.Pi/2) > 3e-4 {
+		t.Errorf("Expected %v, received %v", math.Pi/2, length)
+	}
+}
+
+func TestBezierIntersect(t *testing.T) {
+	arch, _ := NewBezier([][]float64{{0, 0}, {0.5, 2}, {1, 0}})
+	line, _ := NewBezier([][]float64{{0, 0.75}, {1, 0.75}})
+
+	intersections, err := arch.Intersect(line, 1e-8)
+
+	if err != nil {
+		t.Errorf("Error %v", err)
+	}
+
+	if len(intersections) != 2 || maxNormDiff([]float64{0.25, 0.25}, intersections[0][:]) > 1e-12 || maxNormDiff([]float64{0.75, 0.75}, intersections[1][:]) > 1e-12 {
+		t.Errorf("Expected [[0.25 0.25] [0.75 0.75]], received %v", intersections)
+	}
+}
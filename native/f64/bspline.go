@@ -0,0 +1,56 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// BSpline is a b-spline curve of arbitrary degree and dimension, it is a polynomial of the given
+// degree between consecutive knots and each control point only influences degree + 1 knot spans
+type BSpline = generic.BSpline[float64]
+
+// NURBS is a non-uniform rational b-spline curve, a b-spline whose control points carry positive weights,
+// it can represent conic sections such as circles exactly
+type NURBS = generic.NURBS[float64]
+
+// UniformKnots returns the controlPoints + degree + 1 equally spaced knots from a to b,
+// the curve is only defined between the knots at index degree and controlPoints
+func UniformKnots(controlPoints int, degree int, a float64, b float64) ([]float64, error) {
+	return generic.UniformKnots(controlPoints, degree, a, b)
+}
+
+// OpenUniformKnots returns a knot vector on [a, b] whose end knots are repeated degree + 1 times and whose
+// interior knots are equally spaced, the curve then starts and ends at its first and last control points
+func OpenUniformKnots(controlPoints int, degree int, a float64, b float64) ([]float64, error) {
+	return generic.OpenUniformKnots(controlPoints, degree, a, b)
+}
+
+// ClampedKnots returns a clamped knot vector whose interior knots are placed by averaging the data parameters,
+// so that every knot span holds data and a least squares or interpolating fit is well posed
+// Algorithm from The NURBS Book - By Piegl and Tiller
+func ClampedKnots(parameters []float64, controlPoints int, degree int) ([]float64, error) {
+	return generic.ClampedKnots(parameters, controlPoints, degree)
+}
+
+// ChordLengthParameters returns parameters in [0, 1] for the points proportional to the
+// accumulated distance between consecutive points
+func ChordLengthParameters(points [][]float64) ([]float64, error) {
+	return generic.ChordLengthParameters(points)
+}
+
+// NewBSpline builds the b-spline curve with the given degree, knots and control points, there must be
+// len(controlPoints) + degree + 1 non-decreasing knots and no knot may be repeated more than degree + 1 times
+func NewBSpline(degree int, knots []float64, controlPoints [][]float64) (*BSpline, error) {
+	return generic.NewBSpline(degree, knots, controlPoints)
+}
+
+// FitBSpline returns the b-spline with the given number of control points that fits the points at the parameters
+// in the least squares sense, the knots are placed with ClampedKnots and the fit interpolates when there are as
+// many control points as points
+func FitBSpline(parameters []float64, points [][]float64, controlPoints int, degree int) (*BSpline, error) {
+	return generic.FitBSpline(parameters, points, controlPoints, degree)
+}
+
+// NewNURBS builds the rational b-spline curve with the given degree, knots, control points and weights
+func NewNURBS(degree int, knots []float64, controlPoints [][]float64, weights []float64) (*NURBS, error) {
+	return generic.NewNURBS(degree, knots, controlPoints, weights)
+}
@@ -0,0 +1,14 @@
+package methods
+
+import "math"
+
+// maxNormDiff returns the infinity norm of x - y
+func maxNormDiff(x []float64, y []float64) float64 {
+	norm := float64(0)
+	for i := 0; i < len(x); i++ {
+		if diff := math.Abs(x[i] - y[i]); diff > norm || math.IsNaN(diff) {
+			norm = diff
+		}
+	}
+	return norm
+}
@@ -1,6 +1,7 @@
 package methods
 
 import (
+	"errors"
 	"math"
 	"testing"
 )
@@ -13,7 +14,10 @@ func TestEuler1D(t *testing.T) {
 	b := 2.0
 	N := 10
 	initValue := 0.5
-	result := Euler1D(a, b, N, initValue, f)
+	result, err := Euler1D(a, b, N, initValue, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result - 4.865784) > 0.000001 {
 		t.Fail()
 	}
@@ -25,7 +29,10 @@ func TestTrapezoidRule(t *testing.T) {
 	}
 	a := 0.0
 	b := math.Pi / 4
-	result := TrapezoidRule(a, b, f)
+	result, err := TrapezoidRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result - 0.2776801) > 0.0000001 {
 		t.Fail()
 	}
@@ -37,7 +44,10 @@ func TestSimpsonRule(t *testing.T) {
 	}
 	a := 0.0
 	b := math.Pi / 4
-	result := SimpsonRule(a, b, f)
+	result, err := SimpsonRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result - 0.2929326) > 0.0000001 {
 		t.Fail()
 	}
@@ -49,7 +59,10 @@ func TestSimpson38Rule(t *testing.T) {
 	}
 	a := 0.0
 	b := math.Pi / 4
-	result := Simpson38Rule(a, b, f)
+	result, err := Simpson38Rule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result - 0.2929107) > 0.0000001 {
 		t.Fail()
 	}
@@ -61,7 +74,10 @@ func TestBooleRule(t *testing.T) {
 	}
 	a := 0.0
 	b := math.Pi / 4
-	result := BooleRule(a, b, f)
+	result, err := BooleRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if (result - 0.29289318) > 0.0000001 {
 		t.Fail()
 	}
@@ -75,21 +91,38 @@ func TestRungeKutta(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrixA := RungeKutta2(a, b, N, initialCondition, f)
+	solutionMatrixA, err := RungeKutta2(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := RungeKutta4(a, b, N, initialCondition, f)
+	solutionMatrixB, err := RungeKutta4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB[10][1]; math.Abs(result-5.3054720) > 1e-2 {
 		t.Fail()
 	}
 	TOL := 1e-5
 	maxStep := 0.25
 	minStep := 0.01
-	solutionMatrixC := RungeKuttaFehlbery(a, b, initialCondition, TOL, maxStep, minStep, f)
+	solutionMatrixC, err := RungeKuttaFehlbery(a, b, initialCondition, TOL, maxStep, minStep, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixC[9][1]; math.Abs(result-5.3054720) > 1e-4 {
 		t.Fail()
 	}
+	if last := solutionMatrixC[len(solutionMatrixC)-1][0]; last != b {
+		t.Errorf("Expected last mesh point %v, received %v", b, last)
+	}
+	_, errD := RungeKuttaFehlbery(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
 }
 
 func TestModifiedEuler(t *testing.T) {
@@ -100,7 +133,10 @@ func TestModifiedEuler(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrix := ModifiedEuler(a, b, N, initialCondition, f)
+	solutionMatrix, err := ModifiedEuler(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrix[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -114,7 +150,10 @@ func TestHeun(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrix := Heun(a, b, N, initialCondition, f)
+	solutionMatrix, err := Heun(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrix[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -132,21 +171,33 @@ func TestAdamsBashforth(t *testing.T) {
 	initialCondition3 := 1.2140877
 	initialCondition4 := 1.6489406
 	initialCondition5 := 2.1272295
-	solutionMatrixA := AdamsBashforth2(a, b, N, initialCondition1, initialCondition2, f)
+	solutionMatrixA, err := AdamsBashforth2(a, b, N, initialCondition1, initialCondition2, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := AdamsBashforth3(a, b, N, initialCondition1, initialCondition2, initialCondition3, f)
+	solutionMatrixB, err := AdamsBashforth3(a, b, N, initialCondition1, initialCondition2, initialCondition3, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixC := AdamsBashforth4(a, b, N, initialCondition1, initialCondition2, initialCondition3, initialCondition4, f)
+	solutionMatrixC, err := AdamsBashforth4(a, b, N, initialCondition1, initialCondition2, initialCondition3, initialCondition4, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 
 	if result := solutionMatrixC[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixD := AdamsBashforth5(a, b, N, initialCondition1,
+	solutionMatrixD, err := AdamsBashforth5(a, b, N, initialCondition1,
 		initialCondition2, initialCondition3, initialCondition4, initialCondition5, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixD[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -160,11 +211,17 @@ func TestAdamsBashforthMoulton(t *testing.T) {
 	b := 2.0
 	N := 10
 	initialCondition := 0.5
-	solutionMatrixA := AdamsBashforthMoulton3(a, b, N, initialCondition, f)
+	solutionMatrixA, err := AdamsBashforthMoulton3(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixA[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
-	solutionMatrixB := AdamsBashforthMoulton4(a, b, N, initialCondition, f)
+	solutionMatrixB, err := AdamsBashforthMoulton4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
 	if result := solutionMatrixB[10][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
@@ -178,4 +235,51 @@ func TestAdamsBashforthMoulton(t *testing.T) {
 	if result := solutionMatrixC[21][1]; math.Abs(result-5.3054720) > 1e-1 {
 		t.Fail()
 	}
+	_, errD := AdamsBashforthMoulton(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
+}
+
+func TestIntegrationValidation(t *testing.T) {
+	f := func(x, y float64) float64 {
+		return y - x*x + 1
+	}
+	g := func(x float64) float64 {
+		return math.Sin(x)
+	}
+
+	if _, err := Euler1D(0, 2, 0, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for N = 0, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := SimpsonRule(1, 1, g); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a = b, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := RungeKutta4(2, 0, 10, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a > b, received %v", ErrInvalidArgument, err)
+	}
+
+	var argumentErr *ArgumentError
+	if _, err := AdamsBashforth4(0, 2, 2, 0.5, 0.5, 0.5, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton4(0, 2, 2, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 0, 0.25, 0.01, f); !errors.As(err, &argumentErr) || argumentErr.Name != "TOL" {
+		t.Errorf("Expected ArgumentError for TOL, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 1e-5, 0.01, 0.25, f); !errors.As(err, &argumentErr) || argumentErr.Name != "maxStep" {
+		t.Errorf("Expected ArgumentError for maxStep, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton(0, 2, 0.5, 1e-5, 0.2, 0, f); !errors.As(err, &argumentErr) || argumentErr.Name != "minStep" {
+		t.Errorf("Expected ArgumentError for minStep, received %v", err)
+	}
 }
@@ -1,11 +1,38 @@
 package methods
 
 import (
+	"errors"
 	"math"
 	"reflect"
 	"testing"
 )
 
+func TestSortNodes(t *testing.T) {
+	xValues := []float64{2, 0, 3, 1}
+	functionValues := []float64{4, 0, 9, 1}
+
+	if err := SortNodes(xValues, functionValues); err != nil {
+		t.Errorf("Error %v", err)
+	}
+
+	if !reflect.DeepEqual([]float64{0, 1, 2, 3}, xValues) || !reflect.DeepEqual([]float64{0, 1, 4, 9}, functionValues) {
+		t.Errorf("Expected [0 1 2 3] and [0 1 4 9], received %v and %v", xValues, functionValues)
+	}
+
+	_, errA := NaturalCubicSpline([]float64{2, 0, 3, 1}, []float64{4, 0, 9, 1})
+
+	if !errors.Is(errA, ErrUnsortedNodes) {
+		t.Errorf("Expected %v, received %v", ErrUnsortedNodes, errA)
+	}
+
+	_, errB := NewtonDividedDifference([]float64{0, 1, 0}, []float64{0, 1, 0})
+
+	var nodeErr *NodeError
+	if !errors.As(errB, &nodeErr) || nodeErr.Index != 2 || !errors.Is(errB, ErrDuplicateNodes) {
+		t.Errorf("Expected NodeError for xValues[2], received %v", errB)
+	}
+}
+
 func TestNewtonDividedDifference(t *testing.T) {
 	testTableA, errA := NewtonDividedDifference([]float64{1, 1.3, 1.6, 1.9, 2.2}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
 
@@ -21,8 +48,8 @@ func TestNewtonDividedDifference(t *testing.T) {
 
 	_, errB := NewtonDividedDifference([]float64{1, 1.3, 1.6, 1.9}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -41,8 +68,8 @@ func TestNewtonForwardDividedDifference(t *testing.T) {
 
 	_, errB := NewtonForwardDividedDifference([]float64{1, 1.3, 1.6, 1.9}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -61,8 +88,8 @@ func TestNewtonBackwardsDividedDifference(t *testing.T) {
 
 	_, errB := NewtonBackwardsDividedDifference([]float64{1, 1.3, 1.6, 1.9}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -112,8 +139,32 @@ func TestNevilleIterated(t *testing.T) {
 
 	_, errB := NevilleIterated(1.5, []float64{1, 1.3, 1.6, 1.9}, []float64{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestBulirschStoerRational(t *testing.T) {
+	xValues := []float64{0, 0.25, 0.5, 0.75, 1}
+	functionValues := make([]float64, len(xValues))
+	for i, x := range xValues {
+		functionValues[i] = 1 / (x - 1.05)
+	}
+
+	testTableA, errA := BulirschStoerRational(0.9, xValues, functionValues)
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if value := testTableA[4][4]; math.Abs(value+1/0.15) > 1e-12 {
+		t.Errorf("Expected %v, received %v", -1/0.15, value)
+	}
+
+	_, errB := BulirschStoerRational(0.9, xValues[:4], functionValues)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -132,8 +183,8 @@ func TestHermite(t *testing.T) {
 
 	_, errB := Hermite([]float64{1.3, 1.6}, []float64{0.4873198, 0.8960778, 0.2769871}, []float64{-0.0293884, 1.3455501, -0.741541})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 
 	_, errC := Hermite([]float64{1.3, 1.6, 1.9}, []float64{0.4873198, 0.8960778, 0.2769871}, []float64{-0.0293884, 1.3455501})
@@ -158,8 +209,8 @@ func TestNaturalCubicSpline(t *testing.T) {
 
 	_, errB := NaturalCubicSpline([]float64{0, 1, 2, 3}, []float64{1, math.Exp(1), math.Exp(2), math.Exp(3), math.Exp(4)})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -178,8 +229,8 @@ func TestClampedCubicSpline(t *testing.T) {
 
 	_, errB := ClampedCubicSpline([]float64{0, 1, 2, 3}, []float64{1, math.Exp(1), math.Exp(2), math.Exp(3), math.Exp(4)}, 1, math.Exp(4))
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 }
 
@@ -198,8 +249,8 @@ func TestBezierCurve(t *testing.T) {
 
 	_, errB := BezierCurve([][2]float64{{0, 0}, {1, 0}}, [][2]float64{{2, 1}, {4, 1}}, [][2]float64{{0, 1}, {0, 4}})
 
-	if errB == nil {
-		t.Error("Expected Error")
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
 	}
 
 	_, errC := BezierCurve([][2]float64{{0, 0}, {1, 0}}, [][2]float64{{2, 1}}, [][2]float64{{0, 1}, {0, 4}})
@@ -208,3 +259,66 @@ func TestBezierCurve(t *testing.T) {
 		t.Error("Expected Error")
 	}
 }
+
+func TestNotAKnotCubicSpline(t *testing.T) {
+	// the not-a-knot spline reproduces x^3 - 2x + 1
+	testTableA, errA := NotAKnotCubicSpline([]float64{0, 1, 2.5, 3, 4}, []float64{1, 0, 11.625, 22, 57})
+
+	solutionSet := [][]float64{{1, 0, 11.625, 22}, {-2, 1, 16.75, 25}, {0, 3, 7.5, 9}, {1, 1, 1, 1}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	for i := range solutionSet {
+		if maxNormDiff(solutionSet[i], testTableA[i]) > 100*1e-12 {
+			t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+		}
+	}
+}
+
+func TestPeriodicCubicSpline(t *testing.T) {
+	_, errA := PeriodicCubicSpline([]float64{0, 0.25, 0.5, 0.75, 1}, []float64{0, 1, 0, -1, 0})
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	_, errB := PeriodicCubicSpline([]float64{0, 0.5, 1}, []float64{0, 1, 0.5})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestMonotoneCubicSpline(t *testing.T) {
+	testTableA, errA := MonotoneCubicSpline([]float64{0, 1, 2}, []float64{0, 1, 3})
+
+	solutionSet := [][]float64{{0, 1}, {0.5, 4.0 / 3}, {2.0 / 3, 5.0 / 6}, {-1.0 / 6, -1.0 / 6}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	for i := range solutionSet {
+		if maxNormDiff(solutionSet[i], testTableA[i]) > 10*1e-12 {
+			t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+		}
+	}
+}
+
+func TestAkimaSpline(t *testing.T) {
+	testTableA, errA := AkimaSpline([]float64{0, 1, 2, 3}, []float64{0, 1, 1, 0})
+
+	solutionSet := [][]float64{{0, 1, 1}, {1.5, 0.5, -0.5}, {-0.5, -0.5, -0.5}, {0, 0, 0}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	for i := range solutionSet {
+		if maxNormDiff(solutionSet[i], testTableA[i]) > 10*1e-12 {
+			t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+		}
+	}
+}
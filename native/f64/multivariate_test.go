@@ -0,0 +1,78 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewBicubicInterpolant(t *testing.T) {
+	xValues, yValues := []float64{0, 0.5, 1.5, 2}, []float64{-1, 0, 1}
+	functionValues := make([][]float64, len(xValues))
+	for i, x := range xValues {
+		functionValues[i] = make([]float64, len(yValues))
+		for j, y := range yValues {
+			functionValues[i][j] = x*x + 3*x*y - y*y
+		}
+	}
+
+	bicubic, errA := NewBicubicInterpolant(xValues, yValues, functionValues)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if value := bicubic.Eval(1.2, 0.4); math.Abs(value-(1.44+1.44-0.16)) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 1.44+1.44-0.16, value)
+	}
+
+	bilinear, _ := NewBilinearInterpolant(xValues, yValues, functionValues)
+
+	// bilinear interpolation is exact at the grid points only
+	if value := bilinear.Eval(1.5, 1); math.Abs(value-(2.25+4.5-1)) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 2.25+4.5-1, value)
+	}
+
+	_, errB := NewTensorSplineInterpolant(xValues, yValues[:2], functionValues)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewMultilinearInterpolant(t *testing.T) {
+	interpolant, err := NewMultilinearInterpolant([][]float64{{0, 1}, {0, 1}}, []float64{0, 1, 2, 3})
+
+	if err != nil {
+		t.Fatalf("Error %v", err)
+	}
+
+	if value := interpolant.Eval([]float64{0.5, 0.5}); math.Abs(value-1.5) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 1.5, value)
+	}
+}
+
+func TestNewRadialBasisInterpolant(t *testing.T) {
+	points := [][]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0.5, 0.5}}
+	values := []float64{0, 1, 1, 2, 1}
+
+	interpolant, errA := NewRadialBasisInterpolant(points, values, MultiquadricBasis, 2)
+
+	if errA != nil {
+		t.Fatalf("Error %v", errA)
+	}
+
+	if value := interpolant.Eval([]float64{0.25, 0.5}); math.Abs(value-0.75) > 1e-12 {
+		t.Errorf("Expected %v, received %v", 0.75, value)
+	}
+
+	shepard, errB := NewInverseDistanceInterpolant(points, values, 2)
+
+	if errB != nil {
+		t.Fatalf("Error %v", errB)
+	}
+
+	if value := shepard.Eval([]float64{1, 1}); value != 2 {
+		t.Errorf("Expected %v, received %v", 2, value)
+	}
+}
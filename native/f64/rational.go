@@ -0,0 +1,35 @@
+package methods
+
+import (
+	"github.com/NumberXNumbers/methods/native/generic"
+)
+
+// BarycentricRational is a rational interpolant in barycentric form, each evaluation costs O(n)
+type BarycentricRational = generic.BarycentricRational[float64]
+
+// RationalFunction is the quotient of two polynomials with coefficients of ascending powers
+type RationalFunction = generic.RationalFunction[float64]
+
+// FloaterHormannWeights returns the barycentric weights of the floater-hormann rational interpolant of blending degree d
+// through the ascending xValues, every difference is divided by a quarter of the width of the nodes as in BarycentricWeights
+func FloaterHormannWeights(xValues []float64, d int) ([]float64, error) {
+	return generic.FloaterHormannWeights(xValues, d)
+}
+
+// NewFloaterHormannInterpolant builds the floater-hormann rational interpolant of blending degree d through the given points,
+// it has no real poles and blends the polynomials through every d + 1 consecutive points, d = 0 gives berrut's interpolant
+// and d = n - 1 the interpolating polynomial
+func NewFloaterHormannInterpolant(xValues []float64, functionValues []float64, d int) (*BarycentricRational, error) {
+	return generic.NewFloaterHormannInterpolant(xValues, functionValues, d)
+}
+
+// Pade returns the numerator of degree L and the denominator of degree M, normalised so that its constant term is 1,
+// of the pade approximant matching the taylor coefficients of ascending powers up to L + M
+func Pade(coefficients []float64, L int, M int) ([]float64, []float64, error) {
+	return generic.Pade(coefficients, L, M)
+}
+
+// NewPadeApproximant builds the [L/M] pade approximant of the taylor coefficients of ascending powers
+func NewPadeApproximant(coefficients []float64, L int, M int) (*RationalFunction, error) {
+	return generic.NewPadeApproximant(coefficients, L, M)
+}
@@ -0,0 +1,330 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+)
+
+var _ Interpolant[float64] = (*BarycentricPolynomial[float64])(nil)
+
+// LagrangeBasis returns the value of each lagrange basis polynomial of xValues at valueToApprox
+func LagrangeBasis[T Float](valueToApprox T, xValues []T) ([]T, error) {
+	if err := validateNodes(xValues); err != nil {
+		return nil, err
+	}
+
+	size := len(xValues)
+	basis := make([]T, size)
+
+	for k := 0; k < size; k++ {
+		basis[k] = 1
+		for j := 0; j < size; j++ {
+			if j != k {
+				basis[k] *= (valueToApprox - xValues[j]) / (xValues[k] - xValues[j])
+			}
+		}
+	}
+
+	return basis, nil
+}
+
+// Lagrange returns the value at valueToApprox of the lagrange interpolating polynomial through the given points
+func Lagrange[T Float](valueToApprox T, xValues []T, functionValues []T) (T, error) {
+	if len(xValues) != len(functionValues) {
+		return 0, &DimensionError{Name: "functionValues", Expected: len(xValues), Received: len(functionValues)}
+	}
+
+	basis, err := LagrangeBasis(valueToApprox, xValues)
+	if err != nil {
+		return 0, err
+	}
+
+	var value T
+	for k := range basis {
+		value += functionValues[k] * basis[k]
+	}
+
+	return value, nil
+}
+
+// BarycentricWeights returns the barycentric weights of xValues, every difference is divided by a quarter
+// of the width of the nodes so the weights stay representable, the common factor cancels in the barycentric formula
+func BarycentricWeights[T Float](xValues []T) ([]T, error) {
+	if err := validateNodes(xValues); err != nil {
+		return nil, err
+	}
+
+	return barycentricWeights(xValues, nodeCapacity(xValues)), nil
+}
+
+// ChebyshevNodes returns the N chebyshev points of the first kind mapped to [a, b] in ascending order,
+// interpolating at them avoids the runge phenomenon of equispaced nodes
+func ChebyshevNodes[T Float](N int, a T, b T) ([]T, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]T, N)
+	center := (float64(a) + float64(b)) / 2
+	radius := (float64(b) - float64(a)) / 2
+
+	for j := 0; j < N; j++ {
+		nodes[j] = T(center - radius*math.Cos(float64(2*j+1)*math.Pi/float64(2*N)))
+	}
+
+	return nodes, nil
+}
+
+// BarycentricPolynomial is an interpolating polynomial in barycentric form, each evaluation costs O(n)
+// once the weights are known and nodes can be added in O(n)
+type BarycentricPolynomial[T Float] struct {
+	nodes    []T
+	values   []T
+	weights  []T
+	capacity T
+}
+
+// NewBarycentricInterpolant builds the barycentric interpolating polynomial through the given points
+func NewBarycentricInterpolant[T Float](xValues []T, functionValues []T) (*BarycentricPolynomial[T], error) {
+	if err := validateNodes(xValues); err != nil {
+		return nil, err
+	}
+
+	if len(xValues) != len(functionValues) {
+		return nil, &DimensionError{Name: "functionValues", Expected: len(xValues), Received: len(functionValues)}
+	}
+
+	capacity := nodeCapacity(xValues)
+
+	return &BarycentricPolynomial[T]{
+		nodes:    append([]T(nil), xValues...),
+		values:   append([]T(nil), functionValues...),
+		weights:  barycentricWeights(xValues, capacity),
+		capacity: capacity,
+	}, nil
+}
+
+// NewChebyshevInterpolant builds the barycentric interpolating polynomial of f at the N chebyshev points of [a, b],
+// the weights are known in closed form
+func NewChebyshevInterpolant[T Float](f func(T) T, N int, a T, b T) (*BarycentricPolynomial[T], error) {
+	nodes, err := ChebyshevNodes(N, a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, N)
+	weights := make([]T, N)
+
+	for j := 0; j < N; j++ {
+		values[j] = f(nodes[j])
+		// with differences divided by (b - a) / 4 the weights reduce to sin((2j + 1)pi / 2N) / N with alternating signs
+		weights[j] = T(math.Sin(float64(2*j+1)*math.Pi/float64(2*N)) / float64(N))
+		if (N-1-j)%2 == 1 {
+			weights[j] = -weights[j]
+		}
+	}
+
+	return &BarycentricPolynomial[T]{nodes: nodes, values: values, weights: weights, capacity: (b - a) / 4}, nil
+}
+
+// Nodes returns a copy of the interpolation nodes
+func (p *BarycentricPolynomial[T]) Nodes() []T {
+	return append([]T(nil), p.nodes...)
+}
+
+// Weights returns a copy of the barycentric weights
+func (p *BarycentricPolynomial[T]) Weights() []T {
+	return append([]T(nil), p.weights...)
+}
+
+// AddNode adds the point (x, functionValue) to the interpolant, updating the weights in O(n)
+func (p *BarycentricPolynomial[T]) AddNode(x T, functionValue T) error {
+	if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+		return &ArgumentError{Name: "x", Value: x, Reason: "must be finite"}
+	}
+
+	weight := T(1)
+	for j, node := range p.nodes {
+		if node == x {
+			return &ArgumentError{Name: "x", Value: x, Reason: fmt.Sprintf("duplicates node %d", j)}
+		}
+		p.weights[j] /= (node - x) / p.capacity
+		weight /= (x - node) / p.capacity
+	}
+
+	p.nodes = append(p.nodes, x)
+	p.values = append(p.values, functionValue)
+	p.weights = append(p.weights, weight)
+
+	return nil
+}
+
+// Eval returns the value of the polynomial at x using the second (true) barycentric form
+func (p *BarycentricPolynomial[T]) Eval(x T) T {
+	return barycentricEval(p.nodes, p.values, p.weights, x)
+}
+
+// EvalFirstForm returns the value of the polynomial at x using the first (modified lagrange) barycentric form
+func (p *BarycentricPolynomial[T]) EvalFirstForm(x T) T {
+	nodePolynomial := p.capacity
+	var sum T
+
+	for j, node := range p.nodes {
+		if x == node {
+			return p.values[j]
+		}
+		nodePolynomial *= (x - node) / p.capacity
+		sum += p.weights[j] * p.values[j] / (x - node)
+	}
+
+	return nodePolynomial * sum
+}
+
+// EvalMany returns the value of the polynomial at each of xs
+func (p *BarycentricPolynomial[T]) EvalMany(xs []T) []T {
+	return evalMany[T](p, xs)
+}
+
+// Derivative returns the derivative of the given order at x, the derivative values at the nodes
+// are found with the barycentric differentiation matrix and interpolated in turn
+func (p *BarycentricPolynomial[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	size := len(p.nodes)
+	if order >= size {
+		return 0
+	}
+
+	derivative := append([]T(nil), p.values...)
+	next := make([]T, size)
+	for k := 0; k < order; k++ {
+		for i := 0; i < size; i++ {
+			var sum T
+			for j := 0; j < size; j++ {
+				if j != i {
+					sum += p.weights[j] / p.weights[i] * (derivative[j] - derivative[i]) / (p.nodes[i] - p.nodes[j])
+				}
+			}
+			next[i] = sum
+		}
+		derivative, next = next, derivative
+	}
+
+	return barycentricEval(p.nodes, derivative, p.weights, x)
+}
+
+// Integral returns the integral of the polynomial from a to b using a gauss-legendre rule that is exact for its degree
+func (p *BarycentricPolynomial[T]) Integral(a T, b T) T {
+	points, weights := gaussLegendre(len(p.nodes)/2 + 1)
+	center := (float64(a) + float64(b)) / 2
+	radius := (float64(b) - float64(a)) / 2
+
+	var integral float64
+	for i := range points {
+		integral += weights[i] * float64(p.Eval(T(center+radius*points[i])))
+	}
+
+	return T(radius * integral)
+}
+
+// Domain returns the smallest and largest interpolation nodes
+func (p *BarycentricPolynomial[T]) Domain() (T, T) {
+	lower, upper := p.nodes[0], p.nodes[0]
+	for _, x := range p.nodes {
+		if x < lower {
+			lower = x
+		}
+		if x > upper {
+			upper = x
+		}
+	}
+	return lower, upper
+}
+
+// nodeCapacity returns a quarter of the width of the nodes, or 1 for a single node
+func nodeCapacity[T Float](xValues []T) T {
+	lower, upper := xValues[0], xValues[0]
+	for _, x := range xValues {
+		if x < lower {
+			lower = x
+		}
+		if x > upper {
+			upper = x
+		}
+	}
+
+	if upper == lower {
+		return 1
+	}
+
+	return (upper - lower) / 4
+}
+
+// barycentricWeights returns the weights of xValues with every difference divided by capacity
+func barycentricWeights[T Float](xValues []T, capacity T) []T {
+	weights := make([]T, len(xValues))
+
+	for j := range xValues {
+		weights[j] = 1
+		for k := range xValues {
+			if k != j {
+				weights[j] /= (xValues[j] - xValues[k]) / capacity
+			}
+		}
+	}
+
+	return weights
+}
+
+// barycentricEval evaluates the second barycentric form at x
+func barycentricEval[T Float](nodes []T, values []T, weights []T, x T) T {
+	var numerator, denominator T
+
+	for j, node := range nodes {
+		if x == node {
+			return values[j]
+		}
+		term := weights[j] / (x - node)
+		numerator += term * values[j]
+		denominator += term
+	}
+
+	return numerator / denominator
+}
+
+// gaussLegendre returns the points and weights of the n point gauss-legendre rule on [-1, 1],
+// the points are found by newton's method on the legendre polynomial
+func gaussLegendre(n int) ([]float64, []float64) {
+	points := make([]float64, n)
+	weights := make([]float64, n)
+
+	for i := 0; i < (n+1)/2; i++ {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var derivative float64
+
+		for iteration := 0; iteration < 100; iteration++ {
+			previous, current := 1.0, x
+			for k := 2; k <= n; k++ {
+				previous, current = current, (float64(2*k-1)*x*current-float64(k-1)*previous)/float64(k)
+			}
+			derivative = float64(n) * (x*current - previous) / (x*x - 1)
+			step := current / derivative
+			x -= step
+			if math.Abs(step) <= 1e-15 {
+				break
+			}
+		}
+
+		points[i], points[n-1-i] = -x, x
+		weights[i] = 2 / ((1 - x*x) * derivative * derivative)
+		weights[n-1-i] = weights[i]
+	}
+
+	return points, weights
+}
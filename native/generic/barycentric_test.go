@@ -0,0 +1,303 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLagrangeBasis(t *testing.T) {
+	t.Run("float32", testLagrangeBasis[float32])
+	t.Run("float64", testLagrangeBasis[float64])
+}
+
+func testLagrangeBasis[T Float](t *testing.T) {
+	basis, errA := LagrangeBasis[T](0.5, []T{0, 1, 2})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0.375, 0.75, -0.125}, basis) {
+		t.Errorf("Expected %v, received %v", []float64{0.375, 0.75, -0.125}, basis)
+	}
+
+	_, errB := LagrangeBasis[T](0.5, []T{0, 1, 1})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestLagrange(t *testing.T) {
+	t.Run("float32", testLagrange[float32])
+	t.Run("float64", testLagrange[float64])
+}
+
+func testLagrange[T Float](t *testing.T) {
+	// the same data as NevilleIterated approximated at 1.5, from Numerical Analysis - By Burden and Faires
+	value, errA := Lagrange[T](1.5, []T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqual(0.8646893666666667, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.8646893666666667, value)
+	}
+
+	_, errB := Lagrange[T](1.5, []T{1, 1.3}, []T{0.9153827})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestBarycentricWeights(t *testing.T) {
+	t.Run("float32", testBarycentricWeights[float32])
+	t.Run("float64", testBarycentricWeights[float64])
+}
+
+func testBarycentricWeights[T Float](t *testing.T) {
+	// the weights of 0, 1, 2 are 1/2, -1 and 1/2 times the common factor (1/2)^2
+	weights, errA := BarycentricWeights([]T{0, 1, 2})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0.125, -0.25, 0.125}, weights) {
+		t.Errorf("Expected %v, received %v", []float64{0.125, -0.25, 0.125}, weights)
+	}
+
+	_, errB := BarycentricWeights([]T{})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestChebyshevNodes(t *testing.T) {
+	t.Run("float32", testChebyshevNodes[float32])
+	t.Run("float64", testChebyshevNodes[float64])
+}
+
+func testChebyshevNodes[T Float](t *testing.T) {
+	nodes, errA := ChebyshevNodes[T](3, 0, 2)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	expected := []float64{1 - math.Sqrt(3)/2, 1, 1 + math.Sqrt(3)/2}
+	if !approxEqualSlice(expected, nodes) {
+		t.Errorf("Expected %v, received %v", expected, nodes)
+	}
+
+	_, errB := ChebyshevNodes[T](0, 0, 2)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := ChebyshevNodes[T](3, 2, 0)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestNewBarycentricInterpolant(t *testing.T) {
+	t.Run("float32", testNewBarycentricInterpolant[float32])
+	t.Run("float64", testNewBarycentricInterpolant[float64])
+}
+
+func testNewBarycentricInterpolant[T Float](t *testing.T) {
+	// x^3 - 2x + 1 is reproduced exactly by four nodes
+	interpolant, errA := NewBarycentricInterpolant([]T{-1, 0, 1, 2}, []T{2, 1, 0, 5})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if value := interpolant.EvalFirstForm(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if value := interpolant.Eval(1); value != 0 {
+		t.Errorf("Expected the node value 0, received %v", value)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 1); !approxEqual(-1.25, derivative, 10) {
+		t.Errorf("Expected %v, received %v", -1.25, derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 3); !approxEqual(6, derivative, 100) {
+		t.Errorf("Expected %v, received %v", 6, derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 4); derivative != 0 {
+		t.Errorf("Expected 0, received %v", derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, -1); !math.IsNaN(float64(derivative)) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	if integral := interpolant.Integral(2, -1); !approxEqual(-3.75, integral, 10) {
+		t.Errorf("Expected %v, received %v", -3.75, integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != -1 || upper != 2 {
+		t.Errorf("Expected domain [-1, 2], received [%v, %v]", lower, upper)
+	}
+
+	_, errB := NewBarycentricInterpolant([]T{0, 1}, []T{0})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NewBarycentricInterpolant([]T{0, 0}, []T{0, 1})
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestBarycentricPolynomialAddNode(t *testing.T) {
+	t.Run("float32", testBarycentricPolynomialAddNode[float32])
+	t.Run("float64", testBarycentricPolynomialAddNode[float64])
+}
+
+func testBarycentricPolynomialAddNode[T Float](t *testing.T) {
+	interpolant, _ := NewBarycentricInterpolant([]T{-1, 0}, []T{2, 1})
+
+	if err := interpolant.AddNode(1, 0); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := interpolant.AddNode(2, 5); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	// the added weights keep the capacity of the first two nodes, so they match the direct weights up to a common factor
+	direct, _ := NewBarycentricInterpolant([]T{-1, 0, 1, 2}, []T{2, 1, 0, 5})
+	directWeights := direct.Weights()
+	weights := interpolant.Weights()
+	ratio := float64(directWeights[0]) / float64(weights[0])
+	for i := range weights {
+		if !approxEqual(float64(directWeights[i]), T(ratio*float64(weights[i])), 10) {
+			t.Errorf("Expected weight %v, received %v", directWeights[i], T(ratio*float64(weights[i])))
+		}
+	}
+
+	if value := interpolant.Eval(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if value := interpolant.EvalFirstForm(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if err := interpolant.AddNode(0, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if nodes := interpolant.Nodes(); len(nodes) != 4 {
+		t.Errorf("Expected 4 nodes after a rejected node, received %v", nodes)
+	}
+}
+
+func TestNewChebyshevInterpolant(t *testing.T) {
+	t.Run("float32", testNewChebyshevInterpolant[float32])
+	t.Run("float64", testNewChebyshevInterpolant[float64])
+}
+
+func testNewChebyshevInterpolant[T Float](t *testing.T) {
+	runge := func(x T) T { return 1 / (1 + 25*x*x) }
+
+	interpolant, errA := NewChebyshevInterpolant(runge, 41, -1, 1)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	// the closed form weights match the computed ones up to the factor they share
+	computed, _ := BarycentricWeights(interpolant.Nodes())
+	weights := interpolant.Weights()
+	ratio := float64(computed[0]) / float64(weights[0])
+	for i := range weights {
+		if !approxEqual(float64(computed[i]), T(ratio*float64(weights[i])), 1000) {
+			t.Errorf("Expected weight %v, received %v", computed[i], T(ratio*float64(weights[i])))
+		}
+	}
+
+	// equispaced nodes diverge near the ends of the interval while the chebyshev nodes converge
+	equispacedNodes := make([]T, 41)
+	equispacedValues := make([]T, 41)
+	for i := range equispacedNodes {
+		equispacedNodes[i] = T(-1 + float64(i)/20)
+		equispacedValues[i] = runge(equispacedNodes[i])
+	}
+	equispaced, _ := NewBarycentricInterpolant(equispacedNodes, equispacedValues)
+
+	x := T(0.975)
+	if errorChebyshev := math.Abs(float64(interpolant.Eval(x) - runge(x))); errorChebyshev > 1e-2 {
+		t.Errorf("Expected a chebyshev error below 1e-2, received %v", errorChebyshev)
+	}
+
+	if errorEquispaced := math.Abs(float64(equispaced.Eval(x) - runge(x))); errorEquispaced < 1 {
+		t.Errorf("Expected an equispaced error above 1, received %v", errorEquispaced)
+	}
+
+	// the integral of the runge function over [-1, 1] is 2 arctan(5) / 5
+	if integral := interpolant.Integral(-1, 1); math.Abs(float64(integral)-2*math.Atan(5)/5) > 1e-2 {
+		t.Errorf("Expected %v, received %v", 2*math.Atan(5)/5, integral)
+	}
+
+	if err := interpolant.AddNode(1, runge(1)); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if value := interpolant.Eval(1); value != runge(1) {
+		t.Errorf("Expected %v, received %v", runge(1), value)
+	}
+
+	if value := interpolant.Eval(0.5); math.Abs(float64(value-runge(0.5))) > 1e-2 {
+		t.Errorf("Expected %v, received %v", runge(0.5), value)
+	}
+
+	_, errB := NewChebyshevInterpolant(runge, 0, -1, 1)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestGaussLegendre(t *testing.T) {
+	for n := 1; n <= 6; n++ {
+		points, weights := gaussLegendre(n)
+
+		// an n point rule integrates x^k exactly for k < 2n
+		for k := 0; k < 2*n; k++ {
+			var integral float64
+			for i := range points {
+				integral += weights[i] * math.Pow(points[i], float64(k))
+			}
+
+			expected := 0.0
+			if k%2 == 0 {
+				expected = 2 / float64(k+1)
+			}
+
+			if math.Abs(integral-expected) > 1e-13 {
+				t.Errorf("Expected %v for x^%d with %d points, received %v", expected, k, n, integral)
+			}
+		}
+	}
+}
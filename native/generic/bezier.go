@@ -0,0 +1,602 @@
+package generic
+
+import (
+	"math"
+	"sort"
+)
+
+// Bezier is a bezier curve of arbitrary degree and dimension on the parameter interval [0, 1],
+// a curve of degree n has n + 1 control points
+type Bezier[T Float] struct {
+	controlPoints [][]T
+}
+
+// NewBezier builds the bezier curve with the given control points, which must share their dimension
+func NewBezier[T Float](controlPoints [][]T) (*Bezier[T], error) {
+	if err := validatePoints("controlPoints", controlPoints); err != nil {
+		return nil, err
+	}
+
+	return &Bezier[T]{controlPoints: copyPoints(controlPoints)}, nil
+}
+
+// Degree returns the degree of the curve
+func (c *Bezier[T]) Degree() int {
+	return len(c.controlPoints) - 1
+}
+
+// Dimension returns the dimension of the points of the curve
+func (c *Bezier[T]) Dimension() int {
+	return len(c.controlPoints[0])
+}
+
+// ControlPoints returns a copy of the control points
+func (c *Bezier[T]) ControlPoints() [][]T {
+	return copyPoints(c.controlPoints)
+}
+
+// PowerCoefficients returns for each dimension the coefficients of the curve in the power basis,
+// starting with the constant term
+func (c *Bezier[T]) PowerCoefficients() [][]T {
+	degree := c.Degree()
+	coefficients := make([][]T, c.Dimension())
+
+	for d := range coefficients {
+		coefficients[d] = make([]T, degree+1)
+		outer := T(1)
+		for k := 0; k <= degree; k++ {
+			// a_k = C(n, k) sum (-1)^(k-i) C(k, i) P_i
+			var sum T
+			inner := T(1)
+			for i := 0; i <= k; i++ {
+				if (k-i)%2 == 0 {
+					sum += inner * c.controlPoints[i][d]
+				} else {
+					sum -= inner * c.controlPoints[i][d]
+				}
+				inner = inner * T(k-i) / T(i+1)
+			}
+			coefficients[d][k] = outer * sum
+			outer = outer * T(degree-k) / T(k+1)
+		}
+	}
+
+	return coefficients
+}
+
+// Eval returns the point of the curve at t using de casteljau's algorithm
+func (c *Bezier[T]) Eval(t T) []T {
+	points := copyPoints(c.controlPoints)
+
+	for r := len(points) - 1; r > 0; r-- {
+		for i := 0; i < r; i++ {
+			for d := range points[i] {
+				points[i][d] = (1-t)*points[i][d] + t*points[i+1][d]
+			}
+		}
+	}
+
+	return points[0]
+}
+
+// EvalMany returns the point of the curve at each of ts
+func (c *Bezier[T]) EvalMany(ts []T) [][]T {
+	points := make([][]T, len(ts))
+	for i, t := range ts {
+		points[i] = c.Eval(t)
+	}
+	return points
+}
+
+// Differentiate returns the hodograph of the curve, the bezier curve of one degree lower that is its derivative
+func (c *Bezier[T]) Differentiate() *Bezier[T] {
+	degree := c.Degree()
+
+	if degree == 0 {
+		return &Bezier[T]{controlPoints: [][]T{make([]T, c.Dimension())}}
+	}
+
+	controlPoints := make([][]T, degree)
+	for i := range controlPoints {
+		controlPoints[i] = make([]T, c.Dimension())
+		for d := range controlPoints[i] {
+			controlPoints[i][d] = T(degree) * (c.controlPoints[i+1][d] - c.controlPoints[i][d])
+		}
+	}
+
+	return &Bezier[T]{controlPoints: controlPoints}
+}
+
+// Derivative returns the derivative of the given order of the curve at t
+func (c *Bezier[T]) Derivative(t T, order int) []T {
+	if order < 0 {
+		nan := make([]T, c.Dimension())
+		for d := range nan {
+			nan[d] = T(math.NaN())
+		}
+		return nan
+	}
+
+	derivative := c
+	for k := 0; k < order && k <= c.Degree(); k++ {
+		derivative = derivative.Differentiate()
+	}
+
+	return derivative.Eval(t)
+}
+
+// Split returns the curves traced by the parameter on [0, t] and on [t, 1], each reparameterized to [0, 1],
+// their control points are the sides of the de casteljau triangle
+func (c *Bezier[T]) Split(t T) (*Bezier[T], *Bezier[T]) {
+	size := len(c.controlPoints)
+	points := copyPoints(c.controlPoints)
+	left := make([][]T, size)
+	right := make([][]T, size)
+
+	for r := 0; r < size; r++ {
+		left[r] = append([]T(nil), points[0]...)
+		right[size-1-r] = append([]T(nil), points[size-1-r]...)
+		for i := 0; i < size-1-r; i++ {
+			for d := range points[i] {
+				points[i][d] = (1-t)*points[i][d] + t*points[i+1][d]
+			}
+		}
+	}
+
+	return &Bezier[T]{controlPoints: left}, &Bezier[T]{controlPoints: right}
+}
+
+// ElevateDegree returns the same curve represented with one more control point
+func (c *Bezier[T]) ElevateDegree() *Bezier[T] {
+	degree := c.Degree()
+	controlPoints := make([][]T, degree+2)
+
+	controlPoints[0] = append([]T(nil), c.controlPoints[0]...)
+	controlPoints[degree+1] = append([]T(nil), c.controlPoints[degree]...)
+	for i := 1; i <= degree; i++ {
+		alpha := T(i) / T(degree+1)
+		controlPoints[i] = make([]T, c.Dimension())
+		for d := range controlPoints[i] {
+			controlPoints[i][d] = alpha*c.controlPoints[i-1][d] + (1-alpha)*c.controlPoints[i][d]
+		}
+	}
+
+	return &Bezier[T]{controlPoints: controlPoints}
+}
+
+// ArcLength returns the length of the curve between the parameters a and b, the speed is integrated
+// with an 8 point gauss-legendre rule that is bisected until the halves agree with the whole to within TOL
+func (c *Bezier[T]) ArcLength(a T, b T, TOL T) (T, error) {
+	if err := validateTolerance(TOL); err != nil {
+		return 0, err
+	}
+
+	if a == b {
+		return 0, nil
+	}
+
+	sign := 1.0
+	if a > b {
+		a, b = b, a
+		sign = -1
+	}
+
+	hodograph := c.Differentiate()
+	points, weights := gaussLegendre(8)
+	speed := func(lower float64, upper float64) float64 {
+		center := (lower + upper) / 2
+		radius := (upper - lower) / 2
+		var length float64
+		for i := range points {
+			velocity := hodograph.Eval(T(center + radius*points[i]))
+			var norm float64
+			for _, component := range velocity {
+				norm += float64(component) * float64(component)
+			}
+			length += weights[i] * math.Sqrt(norm)
+		}
+		return radius * length
+	}
+
+	// the rounding of the speed bounds the accuracy that can be asked of each piece
+	const maxDepth = 20
+	eps := 10 * float64(machineEpsilon[T]())
+	var length float64
+	var depthReached bool
+	var integrate func(lower float64, upper float64, whole float64, TOL float64, depth int)
+	integrate = func(lower float64, upper float64, whole float64, TOL float64, depth int) {
+		middle := (lower + upper) / 2
+		left, right := speed(lower, middle), speed(middle, upper)
+		if math.Abs(left+right-whole) <= math.Max(TOL, eps*math.Abs(left+right)) {
+			length += left + right
+			return
+		}
+		if depth == maxDepth {
+			depthReached = true
+			length += left + right
+			return
+		}
+		integrate(lower, middle, left, TOL/2, depth+1)
+		integrate(middle, upper, right, TOL/2, depth+1)
+	}
+
+	integrate(float64(a), float64(b), speed(float64(a), float64(b)), float64(TOL), 0)
+
+	if depthReached {
+		return T(sign * length), &IterationError{Method: "ArcLength", Iterations: maxDepth, Err: ErrMaxIterations}
+	}
+
+	return T(sign * length), nil
+}
+
+// BoundingBox returns the smallest and largest coordinates of the curve on [0, 1], the extremes of each coordinate
+// are at the ends or at the roots of the hodograph, which are found as chebyshev series roots
+func (c *Bezier[T]) BoundingBox() ([]T, []T, error) {
+	lower := append([]T(nil), c.controlPoints[0]...)
+	upper := append([]T(nil), c.controlPoints[0]...)
+	candidates := []T{1}
+
+	hodograph := c.Differentiate()
+	for d := 0; d < c.Dimension(); d++ {
+		component := func(t T) T { return hodograph.Eval(t)[d] }
+		series, err := NewChebyshevSeries(component, len(hodograph.controlPoints), 0, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		roots, err := series.Roots()
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = append(candidates, roots...)
+	}
+
+	for _, t := range candidates {
+		point := c.Eval(t)
+		for d, value := range point {
+			if value < lower[d] {
+				lower[d] = value
+			}
+			if value > upper[d] {
+				upper[d] = value
+			}
+		}
+	}
+
+	return lower, upper, nil
+}
+
+// Intersect returns the parameter pairs (s, t) at which the curve at s meets other at t, ordered by s,
+// pairs of pieces whose control point boxes overlap are bisected until both pieces are within TOL / 2 of their chords,
+// whose intersection gives the parameters that are then refined with gauss-newton iterations,
+// curves that stay within TOL of each other along a piece longer than TOL return an OverlapError with the parameter
+// ranges of the overlap, and a search through more than 65536 pairs of pieces returns an IterationError
+func (c *Bezier[T]) Intersect(other *Bezier[T], TOL T) ([][2]T, error) {
+	if err := validateTolerance(TOL); err != nil {
+		return nil, err
+	}
+
+	if other.Dimension() != c.Dimension() {
+		return nil, &DimensionError{Name: "other", Expected: c.Dimension(), Received: other.Dimension()}
+	}
+
+	const maxDepth = 40
+	const maxPairs = 1 << 16
+	var candidates [][2]T
+	var overlap *OverlapError[T]
+	pairs := 0
+
+	// extend widens the overlap to the piece of the first curve on [s0, s1] and of the second on [t0, t1]
+	extend := func(s0 T, s1 T, t0 T, t1 T) {
+		if overlap == nil {
+			overlap = &OverlapError[T]{First: [2]T{s0, s1}, Second: [2]T{t0, t1}}
+			return
+		}
+		overlap.First = [2]T{T(math.Min(float64(overlap.First[0]), float64(s0))), T(math.Max(float64(overlap.First[1]), float64(s1)))}
+		overlap.Second = [2]T{T(math.Min(float64(overlap.Second[0]), float64(t0))), T(math.Max(float64(overlap.Second[1]), float64(t1)))}
+	}
+
+	var search func(first *Bezier[T], second *Bezier[T], s0 T, s1 T, t0 T, t1 T, depth int)
+	search = func(first *Bezier[T], second *Bezier[T], s0 T, s1 T, t0 T, t1 T, depth int) {
+		if pairs++; pairs > maxPairs {
+			return
+		}
+
+		firstLower, firstUpper := first.hull()
+		secondLower, secondUpper := second.hull()
+		for d := range firstLower {
+			if firstUpper[d] < secondLower[d]-TOL || secondUpper[d] < firstLower[d]-TOL {
+				return
+			}
+		}
+
+		if first.flatness() <= TOL/2 && second.flatness() <= TOL/2 {
+			chords := intersectChords(first.controlPoints[0], first.controlPoints[len(first.controlPoints)-1], second.controlPoints[0],
+				second.controlPoints[len(second.controlPoints)-1], float64(TOL))
+			if chords.overlap {
+				extend(s0+T(chords.first[0])*(s1-s0), s0+T(chords.first[1])*(s1-s0),
+					t0+T(chords.second[0])*(t1-t0), t0+T(chords.second[1])*(t1-t0))
+			} else if chords.found {
+				candidates = append(candidates, [2]T{s0 + T(chords.u)*(s1-s0), t0 + T(chords.v)*(t1-t0)})
+			}
+			return
+		}
+
+		if depth == maxDepth || (boxDiagonal(firstLower, firstUpper) <= TOL && boxDiagonal(secondLower, secondUpper) <= TOL) {
+			candidates = append(candidates, [2]T{(s0 + s1) / 2, (t0 + t1) / 2})
+			return
+		}
+
+		sMiddle, tMiddle := (s0+s1)/2, (t0+t1)/2
+		firstLeft, firstRight := first.Split(0.5)
+		secondLeft, secondRight := second.Split(0.5)
+		search(firstLeft, secondLeft, s0, sMiddle, t0, tMiddle, depth+1)
+		search(firstLeft, secondRight, s0, sMiddle, tMiddle, t1, depth+1)
+		search(firstRight, secondLeft, sMiddle, s1, t0, tMiddle, depth+1)
+		search(firstRight, secondRight, sMiddle, s1, tMiddle, t1, depth+1)
+	}
+
+	search(c, other, 0, 1, 0, 1, 0)
+
+	if pairs > maxPairs {
+		return nil, &IterationError{Method: "Intersect", Iterations: maxPairs, Err: ErrMaxIterations}
+	}
+
+	if overlap != nil {
+		return nil, overlap
+	}
+
+	var intersections [][2]T
+	for _, candidate := range candidates {
+		refined := c.refineIntersection(other, candidate)
+
+		duplicate := false
+		for _, intersection := range intersections {
+			if boxDiagonal(c.Eval(intersection[0]), c.Eval(refined[0])) <= TOL {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			intersections = append(intersections, refined)
+		}
+	}
+
+	sort.Slice(intersections, func(i, j int) bool { return intersections[i][0] < intersections[j][0] })
+
+	return intersections, nil
+}
+
+// flatness returns the largest distance of a control point from the chord between the end points,
+// which bounds the distance of the curve from its chord
+func (c *Bezier[T]) flatness() T {
+	start, end := toFloat64(c.controlPoints[0]), toFloat64(c.controlPoints[len(c.controlPoints)-1])
+	var largest float64
+	for _, point := range c.controlPoints[1 : len(c.controlPoints)-1] {
+		largest = math.Max(largest, lineDistance(toFloat64(point), start, end))
+	}
+	return T(largest)
+}
+
+// chordIntersection is the intersection of two chords, the chord parameters (u, v) of a crossing if found
+// or the chord parameter ranges first and second over which collinear chords overlap
+type chordIntersection struct {
+	u       float64
+	v       float64
+	found   bool
+	overlap bool
+	first   [2]float64
+	second  [2]float64
+}
+
+// intersectChords intersects the chords p0 p1 and q0 q1 to within TOL, chords whose end points are all within TOL
+// of a common line overlap if they share a piece longer than TOL
+func intersectChords[T Float](p0 []T, p1 []T, q0 []T, q1 []T, TOL float64) chordIntersection {
+	start, end := toFloat64(p0), toFloat64(p1)
+	otherStart, otherEnd := toFloat64(q0), toFloat64(q1)
+	a, b, w := difference(end, start), difference(otherEnd, otherStart), difference(otherStart, start)
+	aa, bb, ab := dot(a, a), dot(b, b), dot(a, b)
+
+	// the point of the first chord at u and of the second at v, and whether they are within TOL
+	closeAt := func(u float64, v float64) chordIntersection {
+		u, v = math.Min(1, math.Max(0, u)), math.Min(1, math.Max(0, v))
+		gap := make([]float64, len(a))
+		for d := range gap {
+			gap[d] = start[d] + u*a[d] - otherStart[d] - v*b[d]
+		}
+		return chordIntersection{u: u, v: v, found: math.Sqrt(dot(gap, gap)) <= TOL}
+	}
+
+	switch {
+	case aa == 0 && bb == 0:
+		return closeAt(0, 0)
+	case aa == 0:
+		return closeAt(0, -dot(b, w)/bb)
+	case bb == 0:
+		return closeAt(dot(a, w)/aa, 0)
+	}
+
+	if lineDistance(otherStart, start, end) <= TOL && lineDistance(otherEnd, start, end) <= TOL {
+		// the projections of the second chord onto the first give the shared piece
+		projectionStart, projectionEnd := dot(a, w)/aa, dot(a, difference(otherEnd, start))/aa
+		lower := math.Max(0, math.Min(projectionStart, projectionEnd))
+		upper := math.Min(1, math.Max(projectionStart, projectionEnd))
+		length := math.Sqrt(aa)
+
+		if (upper-lower)*length > TOL {
+			// the parameters of the second chord at the ends of the shared piece
+			vLower, vUpper := (ab*lower-dot(b, w))/bb, (ab*upper-dot(b, w))/bb
+			return chordIntersection{overlap: true, first: [2]float64{lower, upper},
+				second: [2]float64{math.Max(0, math.Min(vLower, vUpper)), math.Min(1, math.Max(vLower, vUpper))}}
+		}
+
+		if upper >= lower-TOL/length {
+			u := (lower + upper) / 2
+			return closeAt(u, (ab*u-dot(b, w))/bb)
+		}
+
+		return chordIntersection{}
+	}
+
+	determinant := aa*bb - ab*ab
+	if determinant == 0 {
+		return chordIntersection{}
+	}
+
+	u := (bb*dot(a, w) - ab*dot(b, w)) / determinant
+	v := (ab*dot(a, w) - aa*dot(b, w)) / determinant
+	if u < -TOL/math.Sqrt(aa) || u > 1+TOL/math.Sqrt(aa) || v < -TOL/math.Sqrt(bb) || v > 1+TOL/math.Sqrt(bb) {
+		return chordIntersection{}
+	}
+
+	return closeAt(u, v)
+}
+
+// lineDistance returns the distance of point from the line through start and end, or from start if they coincide
+func lineDistance(point []float64, start []float64, end []float64) float64 {
+	direction, offset := difference(end, start), difference(point, start)
+	if length := dot(direction, direction); length > 0 {
+		scale := dot(direction, offset) / length
+		for d := range offset {
+			offset[d] -= scale * direction[d]
+		}
+	}
+	return math.Sqrt(dot(offset, offset))
+}
+
+// difference returns x - y
+func difference(x []float64, y []float64) []float64 {
+	result := make([]float64, len(x))
+	for d := range x {
+		result[d] = x[d] - y[d]
+	}
+	return result
+}
+
+// dot returns the inner product of x and y
+func dot(x []float64, y []float64) float64 {
+	var sum float64
+	for d := range x {
+		sum += x[d] * y[d]
+	}
+	return sum
+}
+
+// toFloat64 converts a point to float64
+func toFloat64[T Float](point []T) []float64 {
+	converted := make([]float64, len(point))
+	for d, value := range point {
+		converted[d] = float64(value)
+	}
+	return converted
+}
+
+// hull returns the corners of the axis aligned box around the control points, which contains the curve
+func (c *Bezier[T]) hull() ([]T, []T) {
+	lower := append([]T(nil), c.controlPoints[0]...)
+	upper := append([]T(nil), c.controlPoints[0]...)
+	for _, point := range c.controlPoints {
+		for d, value := range point {
+			if value < lower[d] {
+				lower[d] = value
+			}
+			if value > upper[d] {
+				upper[d] = value
+			}
+		}
+	}
+	return lower, upper
+}
+
+// refineIntersection improves the parameters of an approximate intersection by gauss-newton iterations on
+// the distance between the curves, the initial parameters are kept if the iteration leaves [0, 1] or does not improve them
+func (c *Bezier[T]) refineIntersection(other *Bezier[T], initial [2]T) [2]T {
+	const maxIteration = 10
+	firstHodograph := c.Differentiate()
+	secondHodograph := other.Differentiate()
+
+	distance := func(s T, t T) []T {
+		first, second := c.Eval(s), other.Eval(t)
+		for d := range first {
+			first[d] -= second[d]
+		}
+		return first
+	}
+	squaredNorm := func(x []T) float64 {
+		var norm float64
+		for _, value := range x {
+			norm += float64(value) * float64(value)
+		}
+		return norm
+	}
+
+	s, t := initial[0], initial[1]
+	residual := distance(s, t)
+	for iteration := 0; iteration < maxIteration; iteration++ {
+		ds, dt := firstHodograph.Eval(s), secondHodograph.Eval(t)
+
+		// normal equations of the 2 column jacobian [C'(s), -D'(t)]
+		var a11, a12, a22, b1, b2 float64
+		for d := range residual {
+			a11 += float64(ds[d]) * float64(ds[d])
+			a12 -= float64(ds[d]) * float64(dt[d])
+			a22 += float64(dt[d]) * float64(dt[d])
+			b1 -= float64(ds[d]) * float64(residual[d])
+			b2 += float64(dt[d]) * float64(residual[d])
+		}
+
+		determinant := a11*a22 - a12*a12
+		if determinant == 0 || math.IsNaN(determinant) {
+			break
+		}
+
+		nextS := s + T((a22*b1-a12*b2)/determinant)
+		nextT := t + T((a11*b2-a12*b1)/determinant)
+		if !(nextS >= 0 && nextS <= 1 && nextT >= 0 && nextT <= 1) {
+			break
+		}
+
+		nextResidual := distance(nextS, nextT)
+		if squaredNorm(nextResidual) > squaredNorm(residual) {
+			break
+		}
+		s, t, residual = nextS, nextT, nextResidual
+	}
+
+	return [2]T{s, t}
+}
+
+// boxDiagonal returns the distance between the points lower and upper
+func boxDiagonal[T Float](lower []T, upper []T) T {
+	var norm float64
+	for d := range lower {
+		difference := float64(upper[d] - lower[d])
+		norm += difference * difference
+	}
+	return T(math.Sqrt(norm))
+}
+
+// bezierSegments checks the endpoints and guide points of a piecewise cubic bezier curve and returns its segments
+func bezierSegments[T Float](endpoints [][2]T, leftGuidepoints [][2]T, rightGuidepoints [][2]T) ([]*Bezier[T], error) {
+	size := len(endpoints)
+
+	if len(leftGuidepoints) != len(rightGuidepoints) {
+		return nil, &DimensionError{Name: "rightGuidepoints", Expected: len(leftGuidepoints), Received: len(rightGuidepoints)}
+	}
+
+	if size-1 != len(leftGuidepoints) && size-1 != len(rightGuidepoints) {
+		return nil, &DimensionError{Name: "endpoints", Expected: len(leftGuidepoints) + 1, Received: size}
+	}
+
+	segments := make([]*Bezier[T], size-1)
+	for i := range segments {
+		segments[i] = &Bezier[T]{controlPoints: [][]T{
+			{endpoints[i][0], endpoints[i][1]},
+			{leftGuidepoints[i][0], leftGuidepoints[i][1]},
+			{rightGuidepoints[i][0], rightGuidepoints[i][1]},
+			{endpoints[i+1][0], endpoints[i+1][1]},
+		}}
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,262 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewBezier(t *testing.T) {
+	t.Run("float32", testNewBezier[float32])
+	t.Run("float64", testNewBezier[float64])
+}
+
+func testNewBezier[T Float](t *testing.T) {
+	curve, errA := NewBezier([][]T{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if degree, dimension := curve.Degree(), curve.Dimension(); degree != 3 || dimension != 2 {
+		t.Errorf("Expected degree 3 and dimension 2, received %v and %v", degree, dimension)
+	}
+
+	points := curve.EvalMany([]T{0, 0.5, 1})
+	expected := [][]float64{{0, 0}, {2, 1.5}, {4, 0}}
+	if !approxEqualTable(expected, points) {
+		t.Errorf("Expected %v, received %v", expected, points)
+	}
+
+	// x = 3t + 3t^2 - 2t^3 and y = 6t - 6t^2
+	if coefficients := curve.PowerCoefficients(); !approxEqualTable([][]float64{{0, 3, 3, -2}, {0, 6, -6, 0}}, coefficients) {
+		t.Errorf("Expected %v, received %v", [][]float64{{0, 3, 3, -2}, {0, 6, -6, 0}}, coefficients)
+	}
+
+	if derivative := curve.Derivative(0, 1); !approxEqualPoint([]float64{3, 6}, derivative, 10) {
+		t.Errorf("Expected %v, received %v", []float64{3, 6}, derivative)
+	}
+
+	if derivative := curve.Derivative(0.5, 2); !approxEqualPoint([]float64{0, -12}, derivative, 10) {
+		t.Errorf("Expected %v, received %v", []float64{0, -12}, derivative)
+	}
+
+	if derivative := curve.Derivative(0.5, 5); !approxEqualPoint([]float64{0, 0}, derivative, 1) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0}, derivative)
+	}
+
+	if derivative := curve.Derivative(0.5, -1); !math.IsNaN(float64(derivative[1])) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	_, errB := NewBezier([][]T{})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NewBezier([][]T{{0, 0}, {1, 2, 3}})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestBezierSplit(t *testing.T) {
+	t.Run("float32", testBezierSplit[float32])
+	t.Run("float64", testBezierSplit[float64])
+}
+
+func testBezierSplit[T Float](t *testing.T) {
+	curve, _ := NewBezier([][]T{{0, 0, 1}, {1, 2, 0}, {3, 2, 2}, {4, 0, -1}, {5, 1, 0}})
+	left, right := curve.Split(0.3)
+
+	if degree := left.Degree(); degree != 4 {
+		t.Errorf("Expected degree 4, received %v", degree)
+	}
+
+	for _, u := range []T{0, 0.25, 0.6, 1} {
+		expectedLeft := toFloat64(curve.Eval(0.3 * u))
+		if point := left.Eval(u); !approxEqualPoint(expectedLeft, point, 10) {
+			t.Errorf("Expected %v at %v, received %v", expectedLeft, u, point)
+		}
+
+		expectedRight := toFloat64(curve.Eval(0.3 + 0.7*u))
+		if point := right.Eval(u); !approxEqualPoint(expectedRight, point, 10) {
+			t.Errorf("Expected %v at %v, received %v", expectedRight, u, point)
+		}
+	}
+}
+
+func TestBezierElevateDegree(t *testing.T) {
+	t.Run("float32", testBezierElevateDegree[float32])
+	t.Run("float64", testBezierElevateDegree[float64])
+}
+
+func testBezierElevateDegree[T Float](t *testing.T) {
+	curve, _ := NewBezier([][]T{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+	elevated := curve.ElevateDegree().ElevateDegree()
+
+	if degree := elevated.Degree(); degree != 5 {
+		t.Errorf("Expected degree 5, received %v", degree)
+	}
+
+	// a line elevated to a quadratic puts the middle control point halfway
+	line, _ := NewBezier([][]T{{0, 2}, {4, 0}})
+	if controlPoints := line.ElevateDegree().ControlPoints(); !approxEqualTable([][]float64{{0, 2}, {2, 1}, {4, 0}}, controlPoints) {
+		t.Errorf("Expected %v, received %v", [][]float64{{0, 2}, {2, 1}, {4, 0}}, controlPoints)
+	}
+
+	for _, u := range []T{0, 0.2, 0.5, 0.9, 1} {
+		expected := toFloat64(curve.Eval(u))
+		if point := elevated.Eval(u); !approxEqualPoint(expected, point, 10) {
+			t.Errorf("Expected %v at %v, received %v", expected, u, point)
+		}
+	}
+}
+
+func TestBezierArcLength(t *testing.T) {
+	t.Run("float32", testBezierArcLength[float32])
+	t.Run("float64", testBezierArcLength[float64])
+}
+
+func testBezierArcLength[T Float](t *testing.T) {
+	// a straight line traced with varying speed
+	line, _ := NewBezier([][]T{{0, 0}, {1, 1}, {3, 3}})
+	length, errA := line.ArcLength(0, 1, 1e-10)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqual(3*math.Sqrt2, length, 10) {
+		t.Errorf("Expected %v, received %v", 3*math.Sqrt2, length)
+	}
+
+	// the parabola (t, t^2) has length (2 sqrt(5) + asinh(2)) / 4 over [0, 1]
+	parabola, _ := NewBezier([][]T{{0, 0}, {0.5, 0}, {1, 1}})
+	expected := (2*math.Sqrt(5) + math.Asinh(2)) / 4
+	length, errB := parabola.ArcLength(0, 1, 1e-10)
+
+	if errB != nil {
+		t.Errorf("Unexpected error %v", errB)
+	}
+
+	if !approxEqual(expected, length, 10) {
+		t.Errorf("Expected %v, received %v", expected, length)
+	}
+
+	if length, _ := parabola.ArcLength(1, 0, 1e-10); !approxEqual(-expected, length, 10) {
+		t.Errorf("Expected %v, received %v", -expected, length)
+	}
+
+	_, errC := parabola.ArcLength(0, 1, 0)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestBezierBoundingBox(t *testing.T) {
+	t.Run("float32", testBezierBoundingBox[float32])
+	t.Run("float64", testBezierBoundingBox[float64])
+}
+
+func testBezierBoundingBox[T Float](t *testing.T) {
+	// y = 6t - 6t^2 peaks at t = 1/2 while x is increasing, the control points reach y = 2
+	curve, _ := NewBezier([][]T{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+	lower, upper, err := curve.BoundingBox()
+
+	if err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if !approxEqualPoint([]float64{0, 0}, lower, 10) || !approxEqualPoint([]float64{4, 1.5}, upper, 10) {
+		t.Errorf("Expected [0 0] and [4 1.5], received %v and %v", lower, upper)
+	}
+
+	point, _ := NewBezier([][]T{{1, 2, 3}})
+	lower, upper, _ = point.BoundingBox()
+
+	if !approxEqualPoint([]float64{1, 2, 3}, lower, 1) || !approxEqualPoint([]float64{1, 2, 3}, upper, 1) {
+		t.Errorf("Expected [1 2 3] and [1 2 3], received %v and %v", lower, upper)
+	}
+}
+
+func TestBezierIntersect(t *testing.T) {
+	t.Run("float32", testBezierIntersect[float32])
+	t.Run("float64", testBezierIntersect[float64])
+}
+
+func testBezierIntersect[T Float](t *testing.T) {
+	// the arch (t, 4t(1 - t)) crosses the line y = 3/4 at t = 1/4 and t = 3/4
+	arch, _ := NewBezier([][]T{{0, 0}, {0.5, 2}, {1, 0}})
+	line, _ := NewBezier([][]T{{0, 0.75}, {1, 0.75}})
+
+	intersections, errA := arch.Intersect(line, 1e-5)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	expected := [][]float64{{0.25, 0.25}, {0.75, 0.75}}
+	if len(intersections) != len(expected) {
+		t.Fatalf("Expected %v, received %v", expected, intersections)
+	}
+
+	for i := range expected {
+		if !approxEqualPoint(expected[i], intersections[i][:], 100) {
+			t.Errorf("Expected %v, received %v", expected[i], intersections[i])
+		}
+	}
+
+	// the diagonals of the unit square cross in the middle
+	first, _ := NewBezier([][]T{{0, 0}, {1, 1}})
+	second, _ := NewBezier([][]T{{0, 1}, {1, 0}})
+	if intersections, _ := first.Intersect(second, 1e-5); len(intersections) != 1 || !approxEqualPoint([]float64{0.5, 0.5}, intersections[0][:], 100) {
+		t.Errorf("Expected [[0.5 0.5]], received %v", intersections)
+	}
+
+	far, _ := NewBezier([][]T{{0, 2}, {1, 2}})
+	if intersections, _ := arch.Intersect(far, 1e-5); len(intersections) != 0 {
+		t.Errorf("Expected no intersections, received %v", intersections)
+	}
+
+	// a curve coincides with itself and with its own left half, and the diagonal shares the piece from (0.5, 0.5) to (1, 1) with the longer line
+	cubic, _ := NewBezier([][]T{{0, 0}, {1, 2}, {2, -1}, {3, 1}})
+	left, _ := cubic.Split(0.5)
+	longer, _ := NewBezier([][]T{{0.5, 0.5}, {2, 2}})
+	overlaps := []struct {
+		first, second *Bezier[T]
+		expected      [2][]float64
+	}{
+		{first, first, [2][]float64{{0, 1}, {0, 1}}},
+		{cubic, left, [2][]float64{{0, 0.5}, {0, 1}}},
+		{first, longer, [2][]float64{{0.5, 1}, {0, 1.0 / 3}}},
+	}
+
+	for _, overlap := range overlaps {
+		_, err := overlap.first.Intersect(overlap.second, 1e-5)
+
+		var overlapError *OverlapError[T]
+		if !errors.As(err, &overlapError) || !errors.Is(err, ErrOverlappingCurves) {
+			t.Fatalf("Expected %v, received %v", ErrOverlappingCurves, err)
+		}
+
+		if !approxEqualPoint(overlap.expected[0], overlapError.First[:], 1e4) || !approxEqualPoint(overlap.expected[1], overlapError.Second[:], 1e4) {
+			t.Errorf("Expected %v, received %v and %v", overlap.expected, overlapError.First, overlapError.Second)
+		}
+	}
+
+	// the curve is split into too many pieces before they are flat within so small a tolerance
+	if _, err := cubic.Intersect(cubic, 1e-12); !errors.Is(err, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, err)
+	}
+
+	space, _ := NewBezier([][]T{{0, 0, 0}, {1, 1, 1}})
+	_, errB := arch.Intersect(space, 1e-5)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
@@ -0,0 +1,558 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+)
+
+// UniformKnots returns the controlPoints + degree + 1 equally spaced knots from a to b,
+// the curve is only defined between the knots at index degree and controlPoints
+func UniformKnots[T Float](controlPoints int, degree int, a T, b T) ([]T, error) {
+	if err := validateKnotArguments(controlPoints, degree); err != nil {
+		return nil, err
+	}
+
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	size := controlPoints + degree + 1
+	knots := make([]T, size)
+	for i := range knots {
+		knots[i] = T(float64(a) + (float64(b)-float64(a))*float64(i)/float64(size-1))
+	}
+	knots[size-1] = b
+
+	return knots, nil
+}
+
+// OpenUniformKnots returns a knot vector on [a, b] whose end knots are repeated degree + 1 times and whose
+// interior knots are equally spaced, the curve then starts and ends at its first and last control points
+func OpenUniformKnots[T Float](controlPoints int, degree int, a T, b T) ([]T, error) {
+	if err := validateKnotArguments(controlPoints, degree); err != nil {
+		return nil, err
+	}
+
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	knots := make([]T, controlPoints+degree+1)
+	segments := controlPoints - degree
+	for i := 0; i <= degree; i++ {
+		knots[i] = a
+		knots[controlPoints+i] = b
+	}
+	for j := 1; j < segments; j++ {
+		knots[degree+j] = T(float64(a) + (float64(b)-float64(a))*float64(j)/float64(segments))
+	}
+
+	return knots, nil
+}
+
+// ClampedKnots returns a clamped knot vector whose interior knots are placed by averaging the data parameters,
+// so that every knot span holds data and a least squares or interpolating fit is well posed
+// Algorithm from The NURBS Book - By Piegl and Tiller
+func ClampedKnots[T Float](parameters []T, controlPoints int, degree int) ([]T, error) {
+	if err := validateKnotArguments(controlPoints, degree); err != nil {
+		return nil, err
+	}
+
+	if degree < 1 {
+		return nil, &ArgumentError{Name: "degree", Value: degree, Reason: "must be at least 1"}
+	}
+
+	if err := validateParameters(parameters); err != nil {
+		return nil, err
+	}
+
+	size := len(parameters)
+	if size < controlPoints {
+		return nil, &DimensionError{Name: "parameters", Expected: controlPoints, Received: size}
+	}
+
+	a, b := parameters[0], parameters[size-1]
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	knots := make([]T, controlPoints+degree+1)
+	for i := 0; i <= degree; i++ {
+		knots[i] = a
+		knots[controlPoints+i] = b
+	}
+
+	for j := 1; j < controlPoints-degree; j++ {
+		if size == controlPoints {
+			// interpolation, each knot is the average of degree consecutive parameters
+			var sum T
+			for i := j; i < j+degree; i++ {
+				sum += parameters[i]
+			}
+			knots[degree+j] = sum / T(degree)
+		} else {
+			spacing := float64(size) / float64(controlPoints-degree)
+			i := int(float64(j) * spacing)
+			alpha := T(float64(j)*spacing - float64(i))
+			knots[degree+j] = (1-alpha)*parameters[i-1] + alpha*parameters[i]
+		}
+	}
+
+	return knots, nil
+}
+
+// ChordLengthParameters returns parameters in [0, 1] for the points proportional to the
+// accumulated distance between consecutive points
+func ChordLengthParameters[T Float](points [][]T) ([]T, error) {
+	if err := validatePoints("points", points); err != nil {
+		return nil, err
+	}
+
+	if len(points) < 2 {
+		return nil, &DimensionError{Name: "points", Expected: 2, Received: len(points)}
+	}
+
+	parameters := make([]T, len(points))
+	for i := 1; i < len(points); i++ {
+		var distance float64
+		for d := range points[i] {
+			difference := float64(points[i][d] - points[i-1][d])
+			distance += difference * difference
+		}
+		parameters[i] = parameters[i-1] + T(math.Sqrt(distance))
+	}
+
+	total := parameters[len(points)-1]
+	if total == 0 {
+		return nil, &ArgumentError{Name: "points", Value: points, Reason: "must not all be equal"}
+	}
+
+	for i := range parameters {
+		parameters[i] /= total
+	}
+	parameters[len(points)-1] = 1
+
+	return parameters, nil
+}
+
+// BSpline is a b-spline curve of arbitrary degree and dimension, it is a polynomial of the given
+// degree between consecutive knots and each control point only influences degree + 1 knot spans
+type BSpline[T Float] struct {
+	degree        int
+	knots         []T
+	controlPoints [][]T
+}
+
+// NewBSpline builds the b-spline curve with the given degree, knots and control points, there must be
+// len(controlPoints) + degree + 1 non-decreasing knots and no knot may be repeated more than degree + 1 times
+func NewBSpline[T Float](degree int, knots []T, controlPoints [][]T) (*BSpline[T], error) {
+	if degree < 0 {
+		return nil, &ArgumentError{Name: "degree", Value: degree, Reason: "must not be negative"}
+	}
+
+	if err := validatePoints("controlPoints", controlPoints); err != nil {
+		return nil, err
+	}
+
+	size := len(controlPoints)
+	if size < degree+1 {
+		return nil, &DimensionError{Name: "controlPoints", Expected: degree + 1, Received: size}
+	}
+
+	if len(knots) != size+degree+1 {
+		return nil, &DimensionError{Name: "knots", Expected: size + degree + 1, Received: len(knots)}
+	}
+
+	if err := validateKnots(knots, degree); err != nil {
+		return nil, err
+	}
+
+	if knots[degree] >= knots[size] {
+		return nil, &ArgumentError{Name: fmt.Sprintf("knots[%d]", size), Value: knots[size], Reason: fmt.Sprintf("must be greater than knots[%d] = %v", degree, knots[degree])}
+	}
+
+	return &BSpline[T]{degree: degree, knots: append([]T(nil), knots...), controlPoints: copyPoints(controlPoints)}, nil
+}
+
+// FitBSpline returns the b-spline with the given number of control points that fits the points at the parameters
+// in the least squares sense, the knots are placed with ClampedKnots and the fit interpolates when there are as
+// many control points as points
+func FitBSpline[T Float](parameters []T, points [][]T, controlPoints int, degree int) (*BSpline[T], error) {
+	if err := validatePoints("points", points); err != nil {
+		return nil, err
+	}
+
+	if len(parameters) != len(points) {
+		return nil, &DimensionError{Name: "parameters", Expected: len(points), Received: len(parameters)}
+	}
+
+	knots, err := ClampedKnots(parameters, controlPoints, degree)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([][]T, controlPoints)
+	for j := range columns {
+		columns[j] = make([]T, len(parameters))
+	}
+
+	for k, t := range parameters {
+		span := knotSpan(knots, degree, controlPoints, t)
+		basis := bsplineBasis(knots, degree, span, t)
+		for r, value := range basis {
+			columns[span-degree+r][k] = value
+		}
+	}
+
+	dimension := len(points[0])
+	fitted := make([][]T, controlPoints)
+	for j := range fitted {
+		fitted[j] = make([]T, dimension)
+	}
+
+	rhs := make([]T, len(points))
+	for d := 0; d < dimension; d++ {
+		for k := range points {
+			rhs[k] = points[k][d]
+		}
+		for j, value := range leastSquaresColumns(columns, rhs) {
+			fitted[j][d] = value
+		}
+	}
+
+	return &BSpline[T]{degree: degree, knots: knots, controlPoints: fitted}, nil
+}
+
+// Degree returns the degree of the curve
+func (s *BSpline[T]) Degree() int {
+	return s.degree
+}
+
+// Knots returns a copy of the knot vector
+func (s *BSpline[T]) Knots() []T {
+	return append([]T(nil), s.knots...)
+}
+
+// ControlPoints returns a copy of the control points
+func (s *BSpline[T]) ControlPoints() [][]T {
+	return copyPoints(s.controlPoints)
+}
+
+// Domain returns the parameter interval on which the curve is defined
+func (s *BSpline[T]) Domain() (T, T) {
+	return s.knots[s.degree], s.knots[len(s.controlPoints)]
+}
+
+// Eval returns the point of the curve at t using de boor's algorithm, outside of the
+// domain the polynomial of the first or last knot span is extended
+func (s *BSpline[T]) Eval(t T) []T {
+	p := s.degree
+	span := knotSpan(s.knots, p, len(s.controlPoints), t)
+
+	points := make([][]T, p+1)
+	for j := 0; j <= p; j++ {
+		points[j] = append([]T(nil), s.controlPoints[span-p+j]...)
+	}
+
+	for r := 1; r <= p; r++ {
+		for j := p; j >= r; j-- {
+			alpha := (t - s.knots[span-p+j]) / (s.knots[span+1+j-r] - s.knots[span-p+j])
+			for d := range points[j] {
+				points[j][d] = (1-alpha)*points[j-1][d] + alpha*points[j][d]
+			}
+		}
+	}
+
+	return points[p]
+}
+
+// EvalMany returns the point of the curve at each of ts
+func (s *BSpline[T]) EvalMany(ts []T) [][]T {
+	points := make([][]T, len(ts))
+	for i, t := range ts {
+		points[i] = s.Eval(t)
+	}
+	return points
+}
+
+// Differentiate returns the b-spline of one degree lower that is the derivative of the curve
+func (s *BSpline[T]) Differentiate() *BSpline[T] {
+	p := s.degree
+	size := len(s.controlPoints)
+	dimension := len(s.controlPoints[0])
+
+	if p == 0 {
+		zeros := make([][]T, size)
+		for i := range zeros {
+			zeros[i] = make([]T, dimension)
+		}
+		return &BSpline[T]{degree: 0, knots: append([]T(nil), s.knots...), controlPoints: zeros}
+	}
+
+	controlPoints := make([][]T, size-1)
+	for i := range controlPoints {
+		controlPoints[i] = make([]T, dimension)
+		width := s.knots[i+p+1] - s.knots[i+1]
+		if width == 0 {
+			continue
+		}
+		for d := range controlPoints[i] {
+			controlPoints[i][d] = T(p) * (s.controlPoints[i+1][d] - s.controlPoints[i][d]) / width
+		}
+	}
+
+	return &BSpline[T]{degree: p - 1, knots: append([]T(nil), s.knots[1:len(s.knots)-1]...), controlPoints: controlPoints}
+}
+
+// Derivative returns the derivative of the given order of the curve at t
+func (s *BSpline[T]) Derivative(t T, order int) []T {
+	if order < 0 {
+		nan := make([]T, len(s.controlPoints[0]))
+		for d := range nan {
+			nan[d] = T(math.NaN())
+		}
+		return nan
+	}
+
+	if order > s.degree {
+		return make([]T, len(s.controlPoints[0]))
+	}
+
+	derivative := s
+	for k := 0; k < order; k++ {
+		derivative = derivative.Differentiate()
+	}
+
+	return derivative.Eval(t)
+}
+
+// InsertKnot inserts the knot t without changing the curve using boehm's algorithm,
+// t must lie inside the domain and may not already be repeated degree + 1 times
+func (s *BSpline[T]) InsertKnot(t T) error {
+	lower, upper := s.Domain()
+	if !(t > lower && t < upper) {
+		return &ArgumentError{Name: "t", Value: t, Reason: fmt.Sprintf("must lie inside the domain [%v, %v]", lower, upper)}
+	}
+
+	p := s.degree
+	multiplicity := 0
+	for _, knot := range s.knots {
+		if knot == t {
+			multiplicity++
+		}
+	}
+	if multiplicity > p {
+		return &ArgumentError{Name: "t", Value: t, Reason: fmt.Sprintf("is already repeated %d times", multiplicity)}
+	}
+
+	size := len(s.controlPoints)
+	span := knotSpan(s.knots, p, size, t)
+
+	controlPoints := make([][]T, size+1)
+	for i := 0; i <= size; i++ {
+		switch {
+		case i <= span-p:
+			controlPoints[i] = s.controlPoints[i]
+		case i > span:
+			controlPoints[i] = s.controlPoints[i-1]
+		default:
+			alpha := (t - s.knots[i]) / (s.knots[i+p] - s.knots[i])
+			controlPoints[i] = make([]T, len(s.controlPoints[i]))
+			for d := range controlPoints[i] {
+				controlPoints[i][d] = (1-alpha)*s.controlPoints[i-1][d] + alpha*s.controlPoints[i][d]
+			}
+		}
+	}
+
+	knots := make([]T, 0, len(s.knots)+1)
+	knots = append(knots, s.knots[:span+1]...)
+	knots = append(knots, t)
+	knots = append(knots, s.knots[span+1:]...)
+
+	s.knots = knots
+	s.controlPoints = controlPoints
+
+	return nil
+}
+
+// NURBS is a non-uniform rational b-spline curve, a b-spline whose control points carry positive weights,
+// it can represent conic sections such as circles exactly
+type NURBS[T Float] struct {
+	homogeneous *BSpline[T]
+}
+
+// NewNURBS builds the rational b-spline curve with the given degree, knots, control points and weights
+func NewNURBS[T Float](degree int, knots []T, controlPoints [][]T, weights []T) (*NURBS[T], error) {
+	if len(weights) != len(controlPoints) {
+		return nil, &DimensionError{Name: "weights", Expected: len(controlPoints), Received: len(weights)}
+	}
+
+	for i, weight := range weights {
+		if !(weight > 0) || math.IsInf(float64(weight), 0) {
+			return nil, &ArgumentError{Name: fmt.Sprintf("weights[%d]", i), Value: weight, Reason: "must be positive and finite"}
+		}
+	}
+
+	homogeneous := make([][]T, len(controlPoints))
+	for i, point := range controlPoints {
+		homogeneous[i] = make([]T, len(point)+1)
+		for d, value := range point {
+			homogeneous[i][d] = weights[i] * value
+		}
+		homogeneous[i][len(point)] = weights[i]
+	}
+
+	spline, err := NewBSpline(degree, knots, homogeneous)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NURBS[T]{homogeneous: spline}, nil
+}
+
+// Degree returns the degree of the curve
+func (n *NURBS[T]) Degree() int {
+	return n.homogeneous.degree
+}
+
+// Knots returns a copy of the knot vector
+func (n *NURBS[T]) Knots() []T {
+	return n.homogeneous.Knots()
+}
+
+// ControlPoints returns a copy of the control points
+func (n *NURBS[T]) ControlPoints() [][]T {
+	controlPoints := make([][]T, len(n.homogeneous.controlPoints))
+	for i, point := range n.homogeneous.controlPoints {
+		dimension := len(point) - 1
+		controlPoints[i] = make([]T, dimension)
+		for d := 0; d < dimension; d++ {
+			controlPoints[i][d] = point[d] / point[dimension]
+		}
+	}
+	return controlPoints
+}
+
+// Weights returns a copy of the weights of the control points
+func (n *NURBS[T]) Weights() []T {
+	weights := make([]T, len(n.homogeneous.controlPoints))
+	for i, point := range n.homogeneous.controlPoints {
+		weights[i] = point[len(point)-1]
+	}
+	return weights
+}
+
+// Domain returns the parameter interval on which the curve is defined
+func (n *NURBS[T]) Domain() (T, T) {
+	return n.homogeneous.Domain()
+}
+
+// Eval returns the point of the curve at t
+func (n *NURBS[T]) Eval(t T) []T {
+	point := n.homogeneous.Eval(t)
+	dimension := len(point) - 1
+	for d := 0; d < dimension; d++ {
+		point[d] /= point[dimension]
+	}
+	return point[:dimension]
+}
+
+// EvalMany returns the point of the curve at each of ts
+func (n *NURBS[T]) EvalMany(ts []T) [][]T {
+	points := make([][]T, len(ts))
+	for i, t := range ts {
+		points[i] = n.Eval(t)
+	}
+	return points
+}
+
+// Derivative returns the derivative of the given order of the curve at t, the derivatives of the
+// homogeneous curve are combined with the quotient rule
+// Algorithm from The NURBS Book - By Piegl and Tiller
+func (n *NURBS[T]) Derivative(t T, order int) []T {
+	dimension := len(n.homogeneous.controlPoints[0]) - 1
+
+	if order < 0 {
+		nan := make([]T, dimension)
+		for d := range nan {
+			nan[d] = T(math.NaN())
+		}
+		return nan
+	}
+
+	homogeneous := make([][]T, order+1)
+	spline := n.homogeneous
+	for k := 0; k <= order; k++ {
+		homogeneous[k] = spline.Eval(t)
+		spline = spline.Differentiate()
+	}
+
+	weight := homogeneous[0][dimension]
+	derivatives := make([][]T, order+1)
+	for k := 0; k <= order; k++ {
+		derivatives[k] = append([]T(nil), homogeneous[k][:dimension]...)
+		binomial := T(1)
+		for i := 1; i <= k; i++ {
+			binomial = binomial * T(k-i+1) / T(i)
+			for d := 0; d < dimension; d++ {
+				derivatives[k][d] -= binomial * homogeneous[i][dimension] * derivatives[k-i][d]
+			}
+		}
+		for d := 0; d < dimension; d++ {
+			derivatives[k][d] /= weight
+		}
+	}
+
+	return derivatives[order]
+}
+
+// InsertKnot inserts the knot t without changing the curve, t must lie inside the domain
+// and may not already be repeated degree + 1 times
+func (n *NURBS[T]) InsertKnot(t T) error {
+	return n.homogeneous.InsertKnot(t)
+}
+
+// knotSpan returns the index k of the non-empty knot span [knots[k], knots[k+1]) that contains t,
+// values outside of the domain are given the first or last span
+func knotSpan[T Float](knots []T, degree int, controlPoints int, t T) int {
+	span := degree
+	for span < controlPoints-1 && (knots[span+1] <= t || knots[span] == knots[span+1]) {
+		span++
+	}
+	for span > degree && knots[span] == knots[span+1] {
+		span--
+	}
+	return span
+}
+
+// bsplineBasis returns the values at t of the degree + 1 basis functions that are non-zero on the given span
+// Algorithm from The NURBS Book - By Piegl and Tiller
+func bsplineBasis[T Float](knots []T, degree int, span int, t T) []T {
+	basis := make([]T, degree+1)
+	left := make([]T, degree+1)
+	right := make([]T, degree+1)
+
+	basis[0] = 1
+	for j := 1; j <= degree; j++ {
+		left[j] = t - knots[span+1-j]
+		right[j] = knots[span+j] - t
+		var saved T
+		for r := 0; r < j; r++ {
+			temp := basis[r] / (right[r+1] + left[j-r])
+			basis[r] = saved + right[r+1]*temp
+			saved = left[j-r] * temp
+		}
+		basis[j] = saved
+	}
+
+	return basis
+}
+
+// copyPoints returns a deep copy of points
+func copyPoints[T Float](points [][]T) [][]T {
+	copied := make([][]T, len(points))
+	for i, point := range points {
+		copied[i] = append([]T(nil), point...)
+	}
+	return copied
+}
@@ -0,0 +1,413 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// approxEqualPoint reports whether received matches expected to within testTolerance scaled by scale
+func approxEqualPoint[T Float](expected []float64, received []T, scale float64) bool {
+	if len(expected) != len(received) {
+		return false
+	}
+
+	for i := range expected {
+		if !approxEqual(expected[i], received[i], scale) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestUniformKnots(t *testing.T) {
+	t.Run("float32", testUniformKnots[float32])
+	t.Run("float64", testUniformKnots[float64])
+}
+
+func testUniformKnots[T Float](t *testing.T) {
+	knots, errA := UniformKnots[T](4, 2, 0, 6)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0, 1, 2, 3, 4, 5, 6}, knots) {
+		t.Errorf("Expected %v, received %v", []float64{0, 1, 2, 3, 4, 5, 6}, knots)
+	}
+
+	_, errB := UniformKnots[T](2, 2, 0, 1)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := UniformKnots[T](4, 2, 1, 0)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestOpenUniformKnots(t *testing.T) {
+	t.Run("float32", testOpenUniformKnots[float32])
+	t.Run("float64", testOpenUniformKnots[float64])
+}
+
+func testOpenUniformKnots[T Float](t *testing.T) {
+	knots, errA := OpenUniformKnots[T](5, 2, 0, 3)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0, 0, 0, 1, 2, 3, 3, 3}, knots) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0, 0, 1, 2, 3, 3, 3}, knots)
+	}
+
+	_, errB := OpenUniformKnots[T](5, -1, 0, 3)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestClampedKnots(t *testing.T) {
+	t.Run("float32", testClampedKnots[float32])
+	t.Run("float64", testClampedKnots[float64])
+}
+
+func testClampedKnots[T Float](t *testing.T) {
+	// as many control points as parameters averages the parameters
+	knots, errA := ClampedKnots([]T{0, 0.25, 0.5, 0.75, 1}, 5, 2)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0, 0, 0, 0.375, 0.625, 1, 1, 1}, knots) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0, 0, 0.375, 0.625, 1, 1, 1}, knots)
+	}
+
+	// fewer control points spread the interior knots over the parameters
+	parameters := make([]T, 11)
+	for i := range parameters {
+		parameters[i] = T(i) / 10
+	}
+
+	knots, errB := ClampedKnots(parameters, 4, 2)
+
+	if errB != nil {
+		t.Errorf("Unexpected error %v", errB)
+	}
+
+	if !approxEqualSlice([]float64{0, 0, 0, 0.45, 1, 1, 1}, knots) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0, 0, 0.45, 1, 1, 1}, knots)
+	}
+
+	_, errC := ClampedKnots([]T{0, 0.5, 0.25, 1}, 4, 2)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+
+	_, errD := ClampedKnots([]T{0, 0.5, 1}, 4, 2)
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+}
+
+func TestChordLengthParameters(t *testing.T) {
+	t.Run("float32", testChordLengthParameters[float32])
+	t.Run("float64", testChordLengthParameters[float64])
+}
+
+func testChordLengthParameters[T Float](t *testing.T) {
+	parameters, errA := ChordLengthParameters([][]T{{0, 0}, {3, 4}, {3, 5}})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{0, 5.0 / 6, 1}, parameters) {
+		t.Errorf("Expected %v, received %v", []float64{0, 5.0 / 6, 1}, parameters)
+	}
+
+	_, errB := ChordLengthParameters([][]T{{1, 1}, {1, 1}})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := ChordLengthParameters([][]T{{1, 1}})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestNewBSpline(t *testing.T) {
+	t.Run("float32", testNewBSpline[float32])
+	t.Run("float64", testNewBSpline[float64])
+}
+
+func testNewBSpline[T Float](t *testing.T) {
+	// a clamped cubic with four control points is the cubic bezier curve of those points
+	bezier, errA := NewBSpline(3, []T{0, 0, 0, 0, 1, 1, 1, 1}, [][]T{{0, 0}, {1, 2}, {3, 2}, {4, 0}})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if point := bezier.Eval(0.5); !approxEqualPoint([]float64{2, 1.5}, point, 10) {
+		t.Errorf("Expected %v, received %v", []float64{2, 1.5}, point)
+	}
+
+	if point := bezier.Eval(1); !approxEqualPoint([]float64{4, 0}, point, 10) {
+		t.Errorf("Expected %v, received %v", []float64{4, 0}, point)
+	}
+
+	if derivative := bezier.Derivative(0, 1); !approxEqualPoint([]float64{3, 6}, derivative, 10) {
+		t.Errorf("Expected %v, received %v", []float64{3, 6}, derivative)
+	}
+
+	if derivative := bezier.Derivative(0.5, 2); !approxEqualPoint([]float64{0, -12}, derivative, 100) {
+		t.Errorf("Expected %v, received %v", []float64{0, -12}, derivative)
+	}
+
+	if derivative := bezier.Derivative(0.5, 4); !approxEqualPoint([]float64{0, 0}, derivative, 1) {
+		t.Errorf("Expected %v, received %v", []float64{0, 0}, derivative)
+	}
+
+	if derivative := bezier.Derivative(0.5, -1); !math.IsNaN(float64(derivative[0])) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	// a linear b-spline on uniform knots joins its control points, the domain is [1, 3]
+	linear, errB := NewBSpline(1, []T{0, 1, 2, 3, 4}, [][]T{{0}, {1}, {4}})
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	if lower, upper := linear.Domain(); lower != 1 || upper != 3 {
+		t.Errorf("Expected domain [1, 3], received [%v, %v]", lower, upper)
+	}
+
+	points := linear.EvalMany([]T{1, 2, 2.5, 3, 3.5})
+	expected := [][]float64{{0}, {1}, {2.5}, {4}, {5.5}}
+	if !approxEqualTable(expected, points) {
+		t.Errorf("Expected %v, received %v", expected, points)
+	}
+
+	if derivative := linear.Derivative(2.5, 1); !approxEqualPoint([]float64{3}, derivative, 1) {
+		t.Errorf("Expected %v, received %v", []float64{3}, derivative)
+	}
+
+	_, errC := NewBSpline(2, []T{0, 0, 0, 1, 1}, [][]T{{0}, {1}, {2}})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := NewBSpline(1, []T{0, 2, 1, 3, 4}, [][]T{{0}, {1}, {2}})
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+
+	_, errE := NewBSpline(1, []T{0, 1, 2, 3, 4}, [][]T{{0}, {1, 1}, {2}})
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+
+	_, errF := NewBSpline(1, []T{0, 1, 1, 1, 4}, [][]T{{0}, {1}, {2}})
+
+	if !errors.Is(errF, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errF)
+	}
+}
+
+func TestBSplineInsertKnot(t *testing.T) {
+	t.Run("float32", testBSplineInsertKnot[float32])
+	t.Run("float64", testBSplineInsertKnot[float64])
+}
+
+func testBSplineInsertKnot[T Float](t *testing.T) {
+	knots, _ := OpenUniformKnots[T](6, 3, 0, 1)
+	controlPoints := [][]T{{0, 0, 1}, {1, 2, 0}, {2, -1, 3}, {4, 0, 2}, {5, 3, -1}, {7, 1, 0}}
+	spline, _ := NewBSpline(3, knots, controlPoints)
+	original, _ := NewBSpline(3, knots, controlPoints)
+
+	if err := spline.InsertKnot(0.3); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	// the interior knot 1/3 can be inserted until it is repeated degree + 1 times
+	for i := 0; i < 3; i++ {
+		if err := spline.InsertKnot(knots[4]); err != nil {
+			t.Errorf("Unexpected error %v", err)
+		}
+	}
+
+	if err := spline.InsertKnot(knots[4]); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := spline.InsertKnot(0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if size := len(spline.ControlPoints()); size != 10 {
+		t.Errorf("Expected 10 control points, received %v", size)
+	}
+
+	if size := len(spline.Knots()); size != 14 {
+		t.Errorf("Expected 14 knots, received %v", size)
+	}
+
+	for _, x := range []T{0, 0.1, 0.3, 0.32, knots[4], 0.5, 0.9, 1} {
+		reference := toFloat64(original.Eval(x))
+		if point := spline.Eval(x); !approxEqualPoint(reference, point, 10) {
+			t.Errorf("Expected %v at %v, received %v", reference, x, point)
+		}
+	}
+}
+
+func TestFitBSpline(t *testing.T) {
+	t.Run("float32", testFitBSpline[float32])
+	t.Run("float64", testFitBSpline[float64])
+}
+
+func testFitBSpline[T Float](t *testing.T) {
+	// (t, t^3 - t) is a cubic so it lies in the space of every clamped cubic b-spline
+	parameters := make([]T, 21)
+	points := make([][]T, 21)
+	for i := range parameters {
+		x := T(i) / 20
+		parameters[i] = x
+		points[i] = []T{x, x*x*x - x}
+	}
+
+	spline, errA := FitBSpline(parameters, points, 6, 3)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if point := spline.Eval(0.37); !approxEqualPoint([]float64{0.37, 0.37*0.37*0.37 - 0.37}, point, 100) {
+		t.Errorf("Expected %v, received %v", []float64{0.37, 0.37*0.37*0.37 - 0.37}, point)
+	}
+
+	// with as many control points as points the fit interpolates
+	data := [][]T{{0, 1}, {1, 3}, {2, 2}, {4, 5}, {5, 4}}
+	chord, _ := ChordLengthParameters(data)
+	interpolant, errB := FitBSpline(chord, data, 5, 3)
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	for i, x := range chord {
+		expected := []float64{float64(data[i][0]), float64(data[i][1])}
+		if point := interpolant.Eval(x); !approxEqualPoint(expected, point, 100) {
+			t.Errorf("Expected %v, received %v", expected, point)
+		}
+	}
+
+	_, errC := FitBSpline(parameters[:20], points, 6, 3)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := FitBSpline(chord, data, 6, 3)
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+}
+
+func TestNewNURBS(t *testing.T) {
+	t.Run("float32", testNewNURBS[float32])
+	t.Run("float64", testNewNURBS[float64])
+}
+
+func testNewNURBS[T Float](t *testing.T) {
+	// a quarter of the unit circle
+	weights := []T{1, T(math.Sqrt2 / 2), 1}
+	circle, errA := NewNURBS(2, []T{0, 0, 0, 1, 1, 1}, [][]T{{1, 0}, {1, 1}, {0, 1}}, weights)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if point := circle.Eval(0.5); !approxEqualPoint([]float64{math.Sqrt2 / 2, math.Sqrt2 / 2}, point, 10) {
+		t.Errorf("Expected %v, received %v", []float64{math.Sqrt2 / 2, math.Sqrt2 / 2}, point)
+	}
+
+	if derivative := circle.Derivative(0, 1); !approxEqualPoint([]float64{0, math.Sqrt2}, derivative, 10) {
+		t.Errorf("Expected %v, received %v", []float64{0, math.Sqrt2}, derivative)
+	}
+
+	for _, x := range []T{0, 0.2, 0.7, 1} {
+		point := circle.Eval(x)
+		velocity := circle.Derivative(x, 1)
+		acceleration := circle.Derivative(x, 2)
+
+		// |C| = 1 so C.C' = 0 and C.C'' + C'.C' = 0
+		if radius := point[0]*point[0] + point[1]*point[1]; !approxEqual(1, radius, 10) {
+			t.Errorf("Expected radius 1 at %v, received %v", x, radius)
+		}
+
+		if dot := point[0]*velocity[0] + point[1]*velocity[1]; !approxEqual(0, dot, 100) {
+			t.Errorf("Expected a tangent velocity at %v, received %v", x, dot)
+		}
+
+		if dot := point[0]*acceleration[0] + point[1]*acceleration[1] + velocity[0]*velocity[0] + velocity[1]*velocity[1]; !approxEqual(0, dot, 1000) {
+			t.Errorf("Expected a consistent acceleration at %v, received %v", x, dot)
+		}
+	}
+
+	if err := circle.InsertKnot(0.5); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if size := len(circle.Weights()); size != 4 {
+		t.Errorf("Expected 4 weights, received %v", size)
+	}
+
+	if point := circle.Eval(0.25); !approxEqual(1, point[0]*point[0]+point[1]*point[1], 10) {
+		t.Errorf("Expected radius 1, received %v", point)
+	}
+
+	if controlPoints := circle.ControlPoints(); !approxEqualPoint([]float64{1, 0}, controlPoints[0], 1) {
+		t.Errorf("Expected %v, received %v", []float64{1, 0}, controlPoints[0])
+	}
+
+	_, errB := NewNURBS(2, []T{0, 0, 0, 1, 1, 1}, [][]T{{1, 0}, {1, 1}, {0, 1}}, []T{1, 0, 1})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := NewNURBS(2, []T{0, 0, 0, 1, 1, 1}, [][]T{{1, 0}, {1, 1}, {0, 1}}, []T{1, 1})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestKnotSpan(t *testing.T) {
+	knots := []float64{0, 0, 0, 1, 1, 2, 3, 3, 3}
+
+	// the empty span [1, 1) is skipped and values past the ends use the end spans
+	for x, expected := range map[float64]int{-1: 2, 0: 2, 0.5: 2, 1: 4, 2.5: 5, 3: 5, 4: 5} {
+		if span := knotSpan(knots, 2, 6, x); span != expected {
+			t.Errorf("Expected span %v at %v, received %v", expected, x, span)
+		}
+	}
+}
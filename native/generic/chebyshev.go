@@ -0,0 +1,496 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+var _ Interpolant[float64] = (*ChebyshevSeries[float64])(nil)
+
+// ChebyshevLobattoPoints returns the N chebyshev points of the second kind, the extrema of T_(N-1),
+// mapped to [a, b] in ascending order, a single point is the midpoint
+func ChebyshevLobattoPoints[T Float](N int, a T, b T) ([]T, error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if err := validateSteps(N, 1); err != nil {
+		return nil, err
+	}
+
+	return chebyshevLobattoPoints(N, a, b), nil
+}
+
+// ChebyshevCoefficients returns the coefficients of the chebyshev series interpolating values
+// at the ascending chebyshev points of the second kind, computed with a type I discrete cosine transform by an FFT
+func ChebyshevCoefficients[T Float](values []T) ([]T, error) {
+	if len(values) == 0 {
+		return nil, &DimensionError{Name: "values", Expected: 1, Received: 0}
+	}
+
+	return chebyshevCoefficients(values), nil
+}
+
+// ChebyshevSeries is a truncated chebyshev series sum c_k T_k(u) on [a, b], where u maps [a, b] onto [-1, 1]
+type ChebyshevSeries[T Float] struct {
+	coefficients []T
+	a            T
+	b            T
+}
+
+// NewChebyshevSeries builds the chebyshev series of f on [a, b] interpolating it at N chebyshev points of the second kind
+func NewChebyshevSeries[T Float](f func(T) T, N int, a T, b T) (*ChebyshevSeries[T], error) {
+	points, err := ChebyshevLobattoPoints(N, a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]T, N)
+	if err := sampleFunction(f, points, values, 1); err != nil {
+		return nil, err
+	}
+
+	return &ChebyshevSeries[T]{coefficients: chebyshevCoefficients(values), a: a, b: b}, nil
+}
+
+// NewAdaptiveChebyshevSeries builds the chebyshev series of f on [a, b], doubling the number of chebyshev points
+// from 17 until the coefficients have decayed to machine precision, and chops the negligible tail
+func NewAdaptiveChebyshevSeries[T Float](f func(T) T, a T, b T, maxPoints int) (*ChebyshevSeries[T], error) {
+	const initialPoints = 17
+
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if maxPoints < initialPoints {
+		return nil, &ArgumentError{Name: "maxPoints", Value: maxPoints, Reason: fmt.Sprintf("must be at least %d", initialPoints)}
+	}
+
+	N := initialPoints
+	values := make([]T, N)
+	if err := sampleFunction(f, chebyshevLobattoPoints(N, a, b), values, 1); err != nil {
+		return nil, err
+	}
+
+	for attempts := 1; ; attempts++ {
+		coefficients := chebyshevCoefficients(values)
+
+		if length, resolved := chebyshevResolvedLength(coefficients); resolved {
+			return &ChebyshevSeries[T]{coefficients: coefficients[:length], a: a, b: b}, nil
+		}
+
+		if 2*N-1 > maxPoints {
+			return nil, &IterationError{Method: "NewAdaptiveChebyshevSeries", Iterations: attempts, Err: ErrMaxIterations}
+		}
+
+		// the points for 2N - 1 contain the previous points at the even indices
+		N = 2*N - 1
+		refined := make([]T, N)
+		for i := range values {
+			refined[2*i] = values[i]
+		}
+		if err := sampleFunction(f, chebyshevLobattoPoints(N, a, b), refined, 2); err != nil {
+			return nil, err
+		}
+		values = refined
+	}
+}
+
+// NewChebyshevSeriesFromCoefficients builds the chebyshev series on [a, b] with the given coefficients
+func NewChebyshevSeriesFromCoefficients[T Float](coefficients []T, a T, b T) (*ChebyshevSeries[T], error) {
+	if err := validateInterval(a, b); err != nil {
+		return nil, err
+	}
+
+	if len(coefficients) == 0 {
+		return nil, &DimensionError{Name: "coefficients", Expected: 1, Received: 0}
+	}
+
+	return &ChebyshevSeries[T]{coefficients: append([]T(nil), coefficients...), a: a, b: b}, nil
+}
+
+// Coefficients returns a copy of the chebyshev coefficients
+func (s *ChebyshevSeries[T]) Coefficients() []T {
+	return append([]T(nil), s.coefficients...)
+}
+
+// Length returns the number of chebyshev coefficients
+func (s *ChebyshevSeries[T]) Length() int {
+	return len(s.coefficients)
+}
+
+// Eval returns the value of the series at x using the clenshaw recurrence
+func (s *ChebyshevSeries[T]) Eval(x T) T {
+	u := (2*x - s.a - s.b) / (s.b - s.a)
+
+	var b1, b2 T
+	for k := len(s.coefficients) - 1; k >= 1; k-- {
+		b1, b2 = s.coefficients[k]+2*u*b1-b2, b1
+	}
+
+	return s.coefficients[0] + u*b1 - b2
+}
+
+// EvalMany returns the value of the series at each of xs
+func (s *ChebyshevSeries[T]) EvalMany(xs []T) []T {
+	return evalMany[T](s, xs)
+}
+
+// Derivative returns the derivative of the given order at x
+func (s *ChebyshevSeries[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	derivative := s
+	for k := 0; k < order; k++ {
+		derivative = derivative.Differentiate()
+	}
+
+	return derivative.Eval(x)
+}
+
+// Differentiate returns the chebyshev series of the derivative
+func (s *ChebyshevSeries[T]) Differentiate() *ChebyshevSeries[T] {
+	size := len(s.coefficients)
+	if size == 1 {
+		return &ChebyshevSeries[T]{coefficients: []T{0}, a: s.a, b: s.b}
+	}
+
+	// c'_(k-1) = c'_(k+1) + 2k c_k, read from the highest coefficient down
+	derivative := make([]T, size+1)
+	for k := size - 1; k >= 1; k-- {
+		derivative[k-1] = derivative[k+1] + 2*T(k)*s.coefficients[k]
+	}
+	derivative[0] /= 2
+
+	scale := 2 / (s.b - s.a)
+	derivative = derivative[:size-1]
+	for k := range derivative {
+		derivative[k] *= scale
+	}
+
+	return &ChebyshevSeries[T]{coefficients: derivative, a: s.a, b: s.b}
+}
+
+// Antiderivative returns the chebyshev series of the antiderivative that vanishes at a
+func (s *ChebyshevSeries[T]) Antiderivative() *ChebyshevSeries[T] {
+	size := len(s.coefficients)
+	coefficient := func(k int) T {
+		if k < size {
+			return s.coefficients[k]
+		}
+		return 0
+	}
+
+	scale := (s.b - s.a) / 2
+	antiderivative := make([]T, size+1)
+	antiderivative[1] = scale * (coefficient(0) - coefficient(2)/2)
+	for k := 2; k <= size; k++ {
+		antiderivative[k] = scale * (coefficient(k-1) - coefficient(k+1)) / (2 * T(k))
+	}
+
+	// T_k(-1) = (-1)^k fixes the constant term
+	sign := T(1)
+	for k := 1; k <= size; k++ {
+		antiderivative[0] += sign * antiderivative[k]
+		sign = -sign
+	}
+
+	return &ChebyshevSeries[T]{coefficients: antiderivative, a: s.a, b: s.b}
+}
+
+// Integral returns the integral of the series from a to b
+func (s *ChebyshevSeries[T]) Integral(a T, b T) T {
+	antiderivative := s.Antiderivative()
+	return antiderivative.Eval(b) - antiderivative.Eval(a)
+}
+
+// Domain returns the interval the series is defined on
+func (s *ChebyshevSeries[T]) Domain() (T, T) {
+	return s.a, s.b
+}
+
+// Truncate returns the shortest leading part of the series whose dropped coefficients sum to at most TOL in magnitude,
+// which bounds the change of the series anywhere on its domain by TOL
+func (s *ChebyshevSeries[T]) Truncate(TOL T) (*ChebyshevSeries[T], error) {
+	if err := validateTolerance(TOL); err != nil {
+		return nil, err
+	}
+
+	length := len(s.coefficients)
+	var tail T
+	for length > 1 {
+		tail += T(math.Abs(float64(s.coefficients[length-1])))
+		if tail > TOL {
+			break
+		}
+		length--
+	}
+
+	return &ChebyshevSeries[T]{coefficients: append([]T(nil), s.coefficients[:length]...), a: s.a, b: s.b}, nil
+}
+
+// Roots returns the real roots of the series in its domain in ascending order,
+// found as the eigenvalues of the colleague matrix
+func (s *ChebyshevSeries[T]) Roots() ([]T, error) {
+	eps := machineEpsilon[T]()
+	coefficients := make([]float64, len(s.coefficients))
+	var scale float64
+	for k, coefficient := range s.coefficients {
+		coefficients[k] = float64(coefficient)
+		scale = math.Max(scale, math.Abs(coefficients[k]))
+	}
+
+	// coefficients at the level of rounding errors would only add spurious roots
+	degree := len(coefficients) - 1
+	for degree > 0 && math.Abs(coefficients[degree]) <= float64(eps)*scale {
+		degree--
+	}
+
+	var roots []float64
+	switch degree {
+	case 0:
+		return []T{}, nil
+	case 1:
+		roots = []float64{-coefficients[0] / coefficients[1]}
+	default:
+		realParts, imagParts, err := hessenbergEigenvalues(colleagueMatrix(coefficients[:degree+1]))
+		if err != nil {
+			return nil, err
+		}
+
+		tolerance := math.Sqrt(float64(eps))
+		for i := range realParts {
+			if math.Abs(imagParts[i]) <= tolerance {
+				roots = append(roots, realParts[i])
+			}
+		}
+	}
+
+	center := (float64(s.a) + float64(s.b)) / 2
+	radius := (float64(s.b) - float64(s.a)) / 2
+	tolerance := math.Sqrt(float64(eps))
+
+	result := []T{}
+	for _, root := range roots {
+		if math.Abs(root) > 1+tolerance {
+			continue
+		}
+		root = math.Max(-1, math.Min(1, root))
+		result = append(result, T(center+radius*root))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result, nil
+}
+
+// colleagueMatrix returns the transposed colleague matrix of the chebyshev coefficients, which is upper hessenberg,
+// balanced, and has the roots of the series on [-1, 1] as its eigenvalues
+func colleagueMatrix(coefficients []float64) [][]float64 {
+	degree := len(coefficients) - 1
+	matrix := make([][]float64, degree)
+	for i := range matrix {
+		matrix[i] = make([]float64, degree)
+	}
+
+	// u T_0 = T_1 and u T_k = (T_(k-1) + T_(k+1)) / 2, with T_n eliminated using the series
+	matrix[1][0] = 1
+	for k := 1; k < degree-1; k++ {
+		matrix[k-1][k] = 0.5
+		matrix[k+1][k] = 0.5
+	}
+	matrix[degree-2][degree-1] = 0.5
+	for k := 0; k < degree; k++ {
+		matrix[k][degree-1] -= coefficients[k] / (2 * coefficients[degree])
+	}
+
+	balanceHessenberg(matrix)
+
+	return matrix
+}
+
+// chebyshevLobattoPoints returns the N ascending chebyshev points of the second kind on [a, b]
+func chebyshevLobattoPoints[T Float](N int, a T, b T) []T {
+	center := (float64(a) + float64(b)) / 2
+	radius := (float64(b) - float64(a)) / 2
+
+	if N == 1 {
+		return []T{T(center)}
+	}
+
+	points := make([]T, N)
+	for k := 0; k < N; k++ {
+		points[k] = T(center - radius*math.Cos(float64(k)*math.Pi/float64(N-1)))
+	}
+	points[0], points[N-1] = a, b
+
+	return points
+}
+
+// chebyshevCoefficients applies the type I discrete cosine transform to values at the ascending chebyshev points,
+// computed as the fourier transform of the values mirrored to a sequence of length 2n in O(n log n) operations
+func chebyshevCoefficients[T Float](values []T) []T {
+	size := len(values)
+	if size == 1 {
+		return []T{values[0]}
+	}
+
+	n := size - 1
+	mirrored := make([]complex128, 2*n)
+	for k := 0; k <= n; k++ {
+		mirrored[k] = complex(float64(values[k]), 0)
+		if k > 0 && k < n {
+			mirrored[2*n-k] = mirrored[k]
+		}
+	}
+	transform := fft(mirrored)
+
+	coefficients := make([]T, size)
+	for j := 0; j <= n; j++ {
+		coefficients[j] = T(real(transform[j]) / float64(n))
+		// the points ascend, so x_k = -cos(k pi / n) flips the sign of the odd coefficients
+		if j%2 == 1 {
+			coefficients[j] = -coefficients[j]
+		}
+	}
+	coefficients[0] /= 2
+	coefficients[n] /= 2
+
+	return coefficients
+}
+
+// fft returns the discrete fourier transform sum_k x_k e^(-2 pi i j k / N) of x, by the radix 2 cooley-tukey algorithm
+// if the length is a power of two and by bluestein's chirp z-transform on a power of two length otherwise
+func fft(x []complex128) []complex128 {
+	size := len(x)
+	if size&(size-1) == 0 {
+		transform := append([]complex128(nil), x...)
+		radix2FFT(transform, false)
+		return transform
+	}
+
+	length := 1
+	for length < 2*size-1 {
+		length <<= 1
+	}
+
+	// the chirp e^(-pi i k^2 / N) turns the transform into a convolution, k^2 is reduced modulo 2N for accuracy
+	chirp := make([]complex128, size)
+	for k := range chirp {
+		chirp[k] = cmplx.Rect(1, -math.Pi*float64((k*k)%(2*size))/float64(size))
+	}
+
+	a := make([]complex128, length)
+	b := make([]complex128, length)
+	for k := 0; k < size; k++ {
+		a[k] = x[k] * chirp[k]
+		b[k] = cmplx.Conj(chirp[k])
+		if k > 0 {
+			b[length-k] = b[k]
+		}
+	}
+
+	radix2FFT(a, false)
+	radix2FFT(b, false)
+	for k := range a {
+		a[k] *= b[k]
+	}
+	radix2FFT(a, true)
+
+	transform := make([]complex128, size)
+	for k := range transform {
+		transform[k] = a[k] * chirp[k] / complex(float64(length), 0)
+	}
+	return transform
+}
+
+// radix2FFT overwrites a, whose length is a power of two, with its discrete fourier transform,
+// or with the unnormalized inverse transform if inverse is set
+func radix2FFT(a []complex128, inverse bool) {
+	size := len(a)
+	for i, j := 1, 0; i < size; i++ {
+		bit := size >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+
+	sign := -1.0
+	if inverse {
+		sign = 1
+	}
+
+	for length := 2; length <= size; length <<= 1 {
+		half := length / 2
+		for k := 0; k < half; k++ {
+			twiddle := cmplx.Rect(1, sign*2*math.Pi*float64(k)/float64(length))
+			for start := 0; start < size; start += length {
+				u, v := a[start+k], a[start+k+half]*twiddle
+				a[start+k], a[start+k+half] = u+v, u-v
+			}
+		}
+	}
+}
+
+// chebyshevResolvedLength reports whether the tail of the coefficients has decayed to the level of rounding errors
+// and returns the length of the series without its negligible coefficients
+func chebyshevResolvedLength[T Float](coefficients []T) (int, bool) {
+	size := len(coefficients)
+	var scale T
+	for _, coefficient := range coefficients {
+		scale = T(math.Max(float64(scale), math.Abs(float64(coefficient))))
+	}
+
+	if scale == 0 {
+		return 1, true
+	}
+
+	threshold := 10 * machineEpsilon[T]() * T(math.Sqrt(float64(size))) * scale
+
+	tail := size / 8
+	if tail < 2 {
+		tail = 2
+	}
+	for k := size - tail; k < size; k++ {
+		if T(math.Abs(float64(coefficients[k]))) > threshold {
+			return size, false
+		}
+	}
+
+	length := size
+	for length > 1 && T(math.Abs(float64(coefficients[length-1]))) <= threshold {
+		length--
+	}
+
+	return length, true
+}
+
+// sampleFunction evaluates f at points[i] into values[i] for every stride-th index starting at stride - 1,
+// and rejects values that are not finite
+func sampleFunction[T Float](f func(T) T, points []T, values []T, stride int) error {
+	for i := stride - 1; i < len(points); i += stride {
+		values[i] = f(points[i])
+		if math.IsNaN(float64(values[i])) || math.IsInf(float64(values[i]), 0) {
+			return &ArgumentError{Name: "f", Value: values[i], Reason: fmt.Sprintf("must be finite, evaluated at %v", points[i])}
+		}
+	}
+
+	return nil
+}
+
+// machineEpsilon returns the distance from 1 to the next larger number of type T
+func machineEpsilon[T Float]() T {
+	eps := T(1)
+	for T(T(1)+eps/2) != 1 {
+		eps /= 2
+	}
+	return eps
+}
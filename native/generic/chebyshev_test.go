@@ -0,0 +1,334 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// approxEqualRoots reports whether received matches expected to within testTolerance scaled by scale
+func approxEqualRoots[T Float](expected []float64, received []T, scale float64) bool {
+	if len(expected) != len(received) {
+		return false
+	}
+
+	for i := range expected {
+		if !approxEqual(expected[i], received[i], scale) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestChebyshevLobattoPoints(t *testing.T) {
+	t.Run("float32", testChebyshevLobattoPoints[float32])
+	t.Run("float64", testChebyshevLobattoPoints[float64])
+}
+
+func testChebyshevLobattoPoints[T Float](t *testing.T) {
+	points, errA := ChebyshevLobattoPoints[T](5, 0, 2)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	expected := []float64{0, 1 - math.Sqrt(2)/2, 1, 1 + math.Sqrt(2)/2, 2}
+	if !approxEqualSlice(expected, points) {
+		t.Errorf("Expected %v, received %v", expected, points)
+	}
+
+	if points, _ := ChebyshevLobattoPoints[T](1, 0, 2); len(points) != 1 || points[0] != 1 {
+		t.Errorf("Expected [1], received %v", points)
+	}
+
+	_, errB := ChebyshevLobattoPoints[T](0, 0, 2)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestChebyshevCoefficients(t *testing.T) {
+	t.Run("float32", testChebyshevCoefficients[float32])
+	t.Run("float64", testChebyshevCoefficients[float64])
+}
+
+func testChebyshevCoefficients[T Float](t *testing.T) {
+	// 1 + 2 T_1 + 3 T_2 - T_3 = -4x^3 + 6x^2 + 5x - 2 at the points -1, -1/2, 1/2 and 1
+	points, _ := ChebyshevLobattoPoints[T](4, -1, 1)
+	values := make([]T, len(points))
+	for i, x := range points {
+		values[i] = -4*x*x*x + 6*x*x + 5*x - 2
+	}
+
+	coefficients, errA := ChebyshevCoefficients(values)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{1, 2, 3, -1}, coefficients) {
+		t.Errorf("Expected %v, received %v", []float64{1, 2, 3, -1}, coefficients)
+	}
+
+	// on 9 points the mirrored sequence has the power of two length 16
+	nine, _ := ChebyshevLobattoPoints[T](9, -1, 1)
+	quintic := make([]T, len(nine))
+	for i, x := range nine {
+		// T_5 - 2 T_1 + 0.5 = 16x^5 - 20x^3 + 3x + 0.5
+		quintic[i] = 16*x*x*x*x*x - 20*x*x*x + 3*x + 0.5
+	}
+
+	if coefficients, err := ChebyshevCoefficients(quintic); err != nil || !approxEqualSlice([]float64{0.5, -2, 0, 0, 0, 1, 0, 0, 0}, coefficients) {
+		t.Errorf("Expected %v, received %v and %v", []float64{0.5, -2, 0, 0, 0, 1, 0, 0, 0}, coefficients, err)
+	}
+
+	_, errB := ChebyshevCoefficients([]T{})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestFFT(t *testing.T) {
+	// the power of two lengths use cooley-tukey and the others bluestein
+	for _, size := range []int{1, 8, 12, 17} {
+		x := make([]complex128, size)
+		for k := range x {
+			x[k] = complex(math.Sin(float64(k+1)), math.Cos(float64(2*k)))
+		}
+
+		transform := fft(x)
+		for j := range x {
+			var expected complex128
+			for k := range x {
+				expected += x[k] * cmplx.Rect(1, -2*math.Pi*float64(j*k)/float64(size))
+			}
+
+			if cmplx.Abs(expected-transform[j]) > 1e-12*float64(size) {
+				t.Errorf("%d: expected %v at %d, received %v", size, expected, j, transform[j])
+			}
+		}
+	}
+}
+
+func TestNewChebyshevSeries(t *testing.T) {
+	t.Run("float32", testNewChebyshevSeries[float32])
+	t.Run("float64", testNewChebyshevSeries[float64])
+}
+
+func testNewChebyshevSeries[T Float](t *testing.T) {
+	cubic := func(x T) T { return x*x*x - 2*x + 1 }
+	series, errA := NewChebyshevSeries(cubic, 4, -1, 2)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := series.Eval(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	if derivative := series.Derivative(0.5, 1); !approxEqual(-1.25, derivative, 10) {
+		t.Errorf("Expected %v, received %v", -1.25, derivative)
+	}
+
+	if derivative := series.Derivative(0.5, 3); !approxEqual(6, derivative, 100) {
+		t.Errorf("Expected %v, received %v", 6, derivative)
+	}
+
+	if derivative := series.Derivative(0.5, 4); derivative != 0 {
+		t.Errorf("Expected 0, received %v", derivative)
+	}
+
+	if integral := series.Integral(2, -1); !approxEqual(-3.75, integral, 10) {
+		t.Errorf("Expected %v, received %v", -3.75, integral)
+	}
+
+	if lower, upper := series.Domain(); lower != -1 || upper != 2 {
+		t.Errorf("Expected domain [-1, 2], received [%v, %v]", lower, upper)
+	}
+
+	_, errB := NewChebyshevSeries(func(x T) T { return 1 / (x - 1) }, 3, -1, 1)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestNewAdaptiveChebyshevSeries(t *testing.T) {
+	t.Run("float32", testNewAdaptiveChebyshevSeries[float32])
+	t.Run("float64", testNewAdaptiveChebyshevSeries[float64])
+}
+
+func testNewAdaptiveChebyshevSeries[T Float](t *testing.T) {
+	exp := func(x T) T { return T(math.Exp(float64(x))) }
+	series, errA := NewAdaptiveChebyshevSeries(exp, -1, 1, 1025)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	// exp is resolved by the first 17 points and chopped below them
+	if length := series.Length(); length > 17 {
+		t.Errorf("Expected at most 17 coefficients, received %v", length)
+	}
+
+	for _, x := range []float64{-1, -0.3, 0.2, 0.9, 1} {
+		if value := series.Eval(T(x)); !approxEqual(math.Exp(x), value, 10) {
+			t.Errorf("Expected %v, received %v", math.Exp(x), value)
+		}
+		if derivative := series.Derivative(T(x), 1); !approxEqual(math.Exp(x), derivative, 100) {
+			t.Errorf("Expected %v, received %v", math.Exp(x), derivative)
+		}
+	}
+
+	if integral := series.Integral(-1, 1); !approxEqual(math.E-1/math.E, integral, 10) {
+		t.Errorf("Expected %v, received %v", math.E-1/math.E, integral)
+	}
+
+	// sin(20x) needs more than 17 points
+	oscillating := func(x T) T { return T(math.Sin(20 * float64(x))) }
+	oscillatingSeries, errB := NewAdaptiveChebyshevSeries(oscillating, 0, 2, 1025)
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	if length := oscillatingSeries.Length(); length <= 17 {
+		t.Errorf("Expected more than 17 coefficients, received %v", length)
+	}
+
+	if value := oscillatingSeries.Eval(1.3); !approxEqual(math.Sin(26), value, 10) {
+		t.Errorf("Expected %v, received %v", math.Sin(26), value)
+	}
+
+	// |x| is not smooth enough to be resolved with few points
+	_, errC := NewAdaptiveChebyshevSeries(func(x T) T { return T(math.Abs(float64(x))) }, -1, 1, 65)
+
+	if !errors.Is(errC, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errC)
+	}
+
+	_, errD := NewAdaptiveChebyshevSeries(exp, -1, 1, 16)
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+}
+
+func TestChebyshevSeriesTruncate(t *testing.T) {
+	t.Run("float32", testChebyshevSeriesTruncate[float32])
+	t.Run("float64", testChebyshevSeriesTruncate[float64])
+}
+
+func testChebyshevSeriesTruncate[T Float](t *testing.T) {
+	series, _ := NewChebyshevSeriesFromCoefficients([]T{1, 0.5, 0.01, 0.001, 0.0001}, -1, 1)
+
+	truncated, errA := series.Truncate(0.005)
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if coefficients := truncated.Coefficients(); !approxEqualSlice([]float64{1, 0.5, 0.01}, coefficients) {
+		t.Errorf("Expected %v, received %v", []float64{1, 0.5, 0.01}, coefficients)
+	}
+
+	for _, x := range []T{-1, -0.5, 0, 0.7, 1} {
+		if difference := math.Abs(float64(series.Eval(x) - truncated.Eval(x))); difference > 0.0011+1e-6 {
+			t.Errorf("Expected a difference of at most 0.0011 at %v, received %v", x, difference)
+		}
+	}
+
+	if length := series.Length(); length != 5 {
+		t.Errorf("Expected the series to be unchanged, received %v coefficients", length)
+	}
+
+	_, errB := series.Truncate(0)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestChebyshevSeriesRoots(t *testing.T) {
+	t.Run("float32", testChebyshevSeriesRoots[float32])
+	t.Run("float64", testChebyshevSeriesRoots[float64])
+}
+
+func testChebyshevSeriesRoots[T Float](t *testing.T) {
+	cosine := func(x T) T { return T(math.Cos(math.Pi * float64(x))) }
+	series, _ := NewAdaptiveChebyshevSeries(cosine, 0, 4, 1025)
+
+	roots, errA := series.Roots()
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualRoots([]float64{0.5, 1.5, 2.5, 3.5}, roots, 100) {
+		t.Errorf("Expected %v, received %v", []float64{0.5, 1.5, 2.5, 3.5}, roots)
+	}
+
+	// T_3 has the roots -sqrt(3)/2, 0 and sqrt(3)/2, the linear and constant series are handled directly
+	cubic, _ := NewChebyshevSeriesFromCoefficients([]T{0, 0, 0, 1}, -1, 1)
+	if roots, _ := cubic.Roots(); !approxEqualRoots([]float64{-math.Sqrt(3) / 2, 0, math.Sqrt(3) / 2}, roots, 10) {
+		t.Errorf("Expected %v, received %v", []float64{-math.Sqrt(3) / 2, 0, math.Sqrt(3) / 2}, roots)
+	}
+
+	linear, _ := NewChebyshevSeriesFromCoefficients([]T{1, 2}, 0, 2)
+	if roots, _ := linear.Roots(); !approxEqualSlice([]float64{0.5}, roots) {
+		t.Errorf("Expected %v, received %v", []float64{0.5}, roots)
+	}
+
+	constant, _ := NewChebyshevSeriesFromCoefficients([]T{1}, 0, 2)
+	if roots, _ := constant.Roots(); len(roots) != 0 {
+		t.Errorf("Expected no roots, received %v", roots)
+	}
+
+	// x^2 + 1 has no real roots
+	positive, _ := NewChebyshevSeries(func(x T) T { return x*x + 1 }, 5, -1, 1)
+	if roots, _ := positive.Roots(); len(roots) != 0 {
+		t.Errorf("Expected no roots, received %v", roots)
+	}
+}
+
+func TestHessenbergEigenvalues(t *testing.T) {
+	// a companion matrix of (x - 1)(x - 2)(x^2 + 1) = x^4 - 3x^3 + 3x^2 - 3x + 2
+	matrix := [][]float64{{3, -3, 3, -2}, {1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}}
+	balanceHessenberg(matrix)
+
+	realParts, imagParts, err := hessenbergEigenvalues(matrix)
+
+	if err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+
+	expected := map[complex128]bool{1: false, 2: false, 1i: false, -1i: false}
+	for i := range realParts {
+		for eigenvalue := range expected {
+			if math.Abs(realParts[i]-real(eigenvalue)) < 1e-10 && math.Abs(imagParts[i]-imag(eigenvalue)) < 1e-10 {
+				expected[eigenvalue] = true
+			}
+		}
+	}
+
+	for eigenvalue, found := range expected {
+		if !found {
+			t.Errorf("Expected eigenvalue %v, received %v and %v", eigenvalue, realParts, imagParts)
+		}
+	}
+}
+
+func TestMachineEpsilon(t *testing.T) {
+	if eps := machineEpsilon[float64](); eps != math.Nextafter(1, 2)-1 {
+		t.Errorf("Expected %v, received %v", math.Nextafter(1, 2)-1, eps)
+	}
+
+	if eps := machineEpsilon[float32](); eps != math.Nextafter32(1, 2)-1 {
+		t.Errorf("Expected %v, received %v", math.Nextafter32(1, 2)-1, eps)
+	}
+}
@@ -0,0 +1,8 @@
+// Package generic implements the native numerical methods once for any floating point type,
+// the native/f32 and native/f64 packages are thin wrappers around its float32 and float64 instantiations
+package generic
+
+// Float is the constraint satisfied by the floating point types the methods are instantiated with
+type Float interface {
+	~float32 | ~float64
+}
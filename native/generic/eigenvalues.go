@@ -0,0 +1,235 @@
+package generic
+
+import "math"
+
+// balanceHessenberg scales the rows and columns of the upper hessenberg matrix a by powers of two
+// so that their norms are comparable, the eigenvalues are unchanged and the form is kept
+// Algorithm from Numerical Recipes - By Press, Teukolsky, Vetterling and Flannery
+func balanceHessenberg(a [][]float64) {
+	const radix = 2.0
+	size := len(a)
+	done := false
+
+	for !done {
+		done = true
+		for i := 0; i < size; i++ {
+			var rowNorm, columnNorm float64
+			for j := 0; j < size; j++ {
+				if j != i {
+					columnNorm += math.Abs(a[j][i])
+					rowNorm += math.Abs(a[i][j])
+				}
+			}
+
+			if columnNorm == 0 || rowNorm == 0 {
+				continue
+			}
+
+			sum := columnNorm + rowNorm
+			factor := 1.0
+			for g := rowNorm / radix; columnNorm < g; {
+				factor *= radix
+				columnNorm *= radix * radix
+			}
+			for g := rowNorm * radix; columnNorm > g; {
+				factor /= radix
+				columnNorm /= radix * radix
+			}
+
+			if (columnNorm+rowNorm)/factor < 0.95*sum {
+				done = false
+				for j := 0; j < size; j++ {
+					a[i][j] /= factor
+					a[j][i] *= factor
+				}
+			}
+		}
+	}
+}
+
+// hessenbergEigenvalues returns the real and imaginary parts of the eigenvalues of the upper hessenberg matrix a
+// using the francis double shift QR algorithm, a is overwritten
+// Algorithm from Numerical Recipes - By Press, Teukolsky, Vetterling and Flannery
+func hessenbergEigenvalues(a [][]float64) (realParts []float64, imagParts []float64, err error) {
+	const maxIteration = 30
+	size := len(a)
+	realParts = make([]float64, size)
+	imagParts = make([]float64, size)
+
+	var norm float64
+	for i := 0; i < size; i++ {
+		for j := i - 1; j < size; j++ {
+			if j >= 0 {
+				norm += math.Abs(a[i][j])
+			}
+		}
+	}
+
+	var p, q, r, s, w, x, y, z, shift float64
+	last := size - 1
+
+	for last >= 0 {
+		iterations := 0
+		for {
+			// look for a single small subdiagonal element to split the matrix
+			l := last
+			for ; l >= 1; l-- {
+				s = math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0 {
+					s = norm
+				}
+				if math.Abs(a[l][l-1])+s == s {
+					a[l][l-1] = 0
+					break
+				}
+			}
+
+			x = a[last][last]
+			if l == last {
+				realParts[last] = x + shift
+				last--
+			} else {
+				y = a[last-1][last-1]
+				w = a[last][last-1] * a[last-1][last]
+
+				if l == last-1 {
+					p = 0.5 * (y - x)
+					q = p*p + w
+					z = math.Sqrt(math.Abs(q))
+					x += shift
+
+					if q >= 0 {
+						z = p + math.Copysign(z, p)
+						realParts[last-1] = x + z
+						realParts[last] = x + z
+						if z != 0 {
+							realParts[last] = x - w/z
+						}
+					} else {
+						realParts[last-1] = x + p
+						realParts[last] = x + p
+						imagParts[last-1] = -z
+						imagParts[last] = z
+					}
+
+					last -= 2
+				} else {
+					if iterations == maxIteration {
+						return nil, nil, &IterationError{Method: "hessenbergEigenvalues", Iterations: iterations, Err: ErrMaxIterations}
+					}
+
+					// exceptional shifts break cycles that the francis shifts can fall into
+					if iterations == 10 || iterations == 20 {
+						shift += x
+						for i := 0; i <= last; i++ {
+							a[i][i] -= x
+						}
+						s = math.Abs(a[last][last-1]) + math.Abs(a[last-1][last-2])
+						x = 0.75 * s
+						y = x
+						w = -0.4375 * s * s
+					}
+					iterations++
+
+					// look for two consecutive small subdiagonal elements
+					m := last - 2
+					for ; m >= l; m-- {
+						z = a[m][m]
+						r = x - z
+						s = y - z
+						p = (r*s-w)/a[m+1][m] + a[m][m+1]
+						q = a[m+1][m+1] - z - r - s
+						r = a[m+2][m+1]
+						s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+						p /= s
+						q /= s
+						r /= s
+						if m == l {
+							break
+						}
+						u := math.Abs(a[m][m-1]) * (math.Abs(q) + math.Abs(r))
+						v := math.Abs(p) * (math.Abs(a[m-1][m-1]) + math.Abs(z) + math.Abs(a[m+1][m+1]))
+						if u+v == v {
+							break
+						}
+					}
+
+					for i := m + 2; i <= last; i++ {
+						a[i][i-2] = 0
+						if i != m+2 {
+							a[i][i-3] = 0
+						}
+					}
+
+					// double shift QR step on rows l to last and columns m to last
+					for k := m; k <= last-1; k++ {
+						if k != m {
+							p = a[k][k-1]
+							q = a[k+1][k-1]
+							r = 0
+							if k != last-1 {
+								r = a[k+2][k-1]
+							}
+							x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+							if x != 0 {
+								p /= x
+								q /= x
+								r /= x
+							}
+						}
+
+						s = math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+						if s == 0 {
+							continue
+						}
+
+						if k == m {
+							if l != m {
+								a[k][k-1] = -a[k][k-1]
+							}
+						} else {
+							a[k][k-1] = -s * x
+						}
+
+						p += s
+						x = p / s
+						y = q / s
+						z = r / s
+						q /= p
+						r /= p
+
+						for j := k; j <= last; j++ {
+							p = a[k][j] + q*a[k+1][j]
+							if k != last-1 {
+								p += r * a[k+2][j]
+								a[k+2][j] -= p * z
+							}
+							a[k+1][j] -= p * y
+							a[k][j] -= p * x
+						}
+
+						bottom := last
+						if k+3 < last {
+							bottom = k + 3
+						}
+						for i := l; i <= bottom; i++ {
+							p = x*a[i][k] + y*a[i][k+1]
+							if k != last-1 {
+								p += z * a[i][k+2]
+								a[i][k+2] -= p * r
+							}
+							a[i][k+1] -= p * q
+							a[i][k] -= p
+						}
+					}
+				}
+			}
+
+			if l >= last-1 {
+				break
+			}
+		}
+	}
+
+	return realParts, imagParts, nil
+}
@@ -0,0 +1,207 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotSquare is returned when a method requires a square matrix
+	ErrNotSquare = errors.New("Matrix is not square")
+	// ErrSingular is returned when a matrix can not be factorized because it is singular
+	ErrSingular = errors.New("Matrix is singular")
+	// ErrMaxIterations is returned when a method does not converge within the maximum number of iterations
+	ErrMaxIterations = errors.New("Maximum number of iterations exceeded")
+	// ErrDimensionMismatch is returned when the lengths or dimensions of the inputs do not match
+	ErrDimensionMismatch = errors.New("Dimensions do not match")
+	// ErrStepSizeUnderflow is returned when an adaptive method needs a step smaller than the minimum step size
+	ErrStepSizeUnderflow = errors.New("Minimum step size exceeded")
+	// ErrDivisionByZero is returned when an iteration would divide by zero
+	ErrDivisionByZero = errors.New("Division by zero")
+	// ErrDivergence is returned when an iterate is no longer a finite number
+	ErrDivergence = errors.New("Method diverged")
+	// ErrInvalidArgument is returned when an argument is outside of the domain a method accepts
+	ErrInvalidArgument = errors.New("Invalid argument")
+	// ErrNotBracketed is returned when a bracketing method is given an interval without a sign change
+	ErrNotBracketed = errors.New("Interval does not bracket a root")
+	// ErrDuplicateNodes is returned when two interpolation nodes coincide
+	ErrDuplicateNodes = errors.New("Nodes are not distinct")
+	// ErrUnsortedNodes is returned when a method requires increasing interpolation nodes and they are out of order
+	ErrUnsortedNodes = errors.New("Nodes are not in increasing order")
+	// ErrNotSymmetric is returned when a method requires a matrix equal to its transpose
+	ErrNotSymmetric = errors.New("Matrix is not symmetric")
+	// ErrNotPositiveDefinite is returned when a factorization of a positive-definite matrix meets a negative pivot
+	ErrNotPositiveDefinite = errors.New("Matrix is not positive-definite")
+	// ErrOverlappingCurves is returned when two curves coincide along a piece of non-zero length
+	ErrOverlappingCurves = errors.New("Curves overlap")
+)
+
+// IterationError records which iterative method failed and after how many iterations
+type IterationError struct {
+	Method     string
+	Iterations int
+	Err        error
+}
+
+func (e *IterationError) Error() string {
+	return fmt.Sprintf("%s: %v after %d iterations", e.Method, e.Err, e.Iterations)
+}
+
+// Unwrap returns the underlying error
+func (e *IterationError) Unwrap() error {
+	return e.Err
+}
+
+// DimensionError records which input has the wrong length, it unwraps to ErrDimensionMismatch
+type DimensionError struct {
+	Name     string
+	Expected int
+	Received int
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("%v: length of %s is %d, expected %d", ErrDimensionMismatch, e.Name, e.Received, e.Expected)
+}
+
+// Unwrap returns ErrDimensionMismatch
+func (e *DimensionError) Unwrap() error {
+	return ErrDimensionMismatch
+}
+
+// SingularError records the pivot at which a factorization failed, it unwraps to ErrSingular
+type SingularError struct {
+	Pivot int
+}
+
+func (e *SingularError) Error() string {
+	return fmt.Sprintf("%v: zero pivot at %d", ErrSingular, e.Pivot)
+}
+
+// Unwrap returns ErrSingular
+func (e *SingularError) Unwrap() error {
+	return ErrSingular
+}
+
+// SymmetricError records an entry that differs from its transposed entry, it unwraps to ErrNotSymmetric
+type SymmetricError struct {
+	Row int
+	Col int
+}
+
+func (e *SymmetricError) Error() string {
+	return fmt.Sprintf("%v: entry (%d, %d) differs from entry (%d, %d)", ErrNotSymmetric, e.Row, e.Col, e.Col, e.Row)
+}
+
+// Unwrap returns ErrNotSymmetric
+func (e *SymmetricError) Unwrap() error {
+	return ErrNotSymmetric
+}
+
+// DefiniteError records the pivot at which a factorization of a positive-definite matrix found a negative value,
+// it unwraps to ErrNotPositiveDefinite
+type DefiniteError[T Float] struct {
+	Pivot int
+	Value T
+}
+
+func (e *DefiniteError[T]) Error() string {
+	return fmt.Sprintf("%v: pivot %d is %v", ErrNotPositiveDefinite, e.Pivot, e.Value)
+}
+
+// Unwrap returns ErrNotPositiveDefinite
+func (e *DefiniteError[T]) Unwrap() error {
+	return ErrNotPositiveDefinite
+}
+
+// OverlapError records the parameter ranges over which two curves coincide, it unwraps to ErrOverlappingCurves
+type OverlapError[T Float] struct {
+	First  [2]T
+	Second [2]T
+}
+
+func (e *OverlapError[T]) Error() string {
+	return fmt.Sprintf("%v: %v of the first curve and %v of the second", ErrOverlappingCurves, e.First, e.Second)
+}
+
+// Unwrap returns ErrOverlappingCurves
+func (e *OverlapError[T]) Unwrap() error {
+	return ErrOverlappingCurves
+}
+
+// StepSizeError records where the step size of an adaptive method fell below the minimum step size,
+// it unwraps to ErrStepSizeUnderflow
+type StepSizeError[T Float] struct {
+	Theta    T
+	StepSize T
+	MinStep  T
+}
+
+func (e *StepSizeError[T]) Error() string {
+	return fmt.Sprintf("%v: step size %v at %v is below %v", ErrStepSizeUnderflow, e.StepSize, e.Theta, e.MinStep)
+}
+
+// Unwrap returns ErrStepSizeUnderflow
+func (e *StepSizeError[T]) Unwrap() error {
+	return ErrStepSizeUnderflow
+}
+
+// ArgumentError records which argument was rejected and why, it unwraps to ErrInvalidArgument
+type ArgumentError struct {
+	Name   string
+	Value  interface{}
+	Reason string
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("%v: %s is %v, %s", ErrInvalidArgument, e.Name, e.Value, e.Reason)
+}
+
+// Unwrap returns ErrInvalidArgument
+func (e *ArgumentError) Unwrap() error {
+	return ErrInvalidArgument
+}
+
+// BracketError records the function values at the ends of an interval that does not bracket a root,
+// it unwraps to ErrNotBracketed
+type BracketError[T Float] struct {
+	A  T
+	B  T
+	FA T
+	FB T
+}
+
+func (e *BracketError[T]) Error() string {
+	return fmt.Sprintf("%v: f(%v) = %v and f(%v) = %v", ErrNotBracketed, e.A, e.FA, e.B, e.FB)
+}
+
+// Unwrap returns ErrNotBracketed
+func (e *BracketError[T]) Unwrap() error {
+	return ErrNotBracketed
+}
+
+// NodeError records an interpolation node that duplicates or is out of order with an earlier node,
+// it unwraps to ErrDuplicateNodes or ErrUnsortedNodes and matches ErrInvalidArgument
+type NodeError[T Float] struct {
+	Name     string
+	Index    int
+	Value    T
+	Previous int
+	Err      error
+}
+
+func (e *NodeError[T]) Error() string {
+	if e.Err == ErrDuplicateNodes {
+		return fmt.Sprintf("%v: %s[%d] is %v, the same as %s[%d]", e.Err, e.Name, e.Index, e.Value, e.Name, e.Previous)
+	}
+	return fmt.Sprintf("%v: %s[%d] is %v, less than %s[%d]", e.Err, e.Name, e.Index, e.Value, e.Name, e.Previous)
+}
+
+// Unwrap returns ErrDuplicateNodes or ErrUnsortedNodes
+func (e *NodeError[T]) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidArgument, rejected nodes are invalid arguments as well
+func (e *NodeError[T]) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
@@ -0,0 +1,41 @@
+package generic
+
+import "math"
+
+// testTolerance is the relative tolerance used to compare results computed in T with float64 references
+func testTolerance[T Float]() float64 {
+	if T(1)+T(1e-10) == T(1) {
+		return 1e-5
+	}
+	return 1e-12
+}
+
+// approxEqualSlice reports whether received matches expected to within testTolerance
+func approxEqualSlice[T Float](expected []float64, received []T) bool {
+	if len(expected) != len(received) {
+		return false
+	}
+
+	for i := range expected {
+		if math.Abs(expected[i]-float64(received[i])) > testTolerance[T]()*math.Max(1, math.Abs(expected[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// approxEqualTable reports whether every row of received matches expected to within testTolerance
+func approxEqualTable[T Float](expected [][]float64, received [][]T) bool {
+	if len(expected) != len(received) {
+		return false
+	}
+
+	for i := range expected {
+		if !approxEqualSlice(expected[i], received[i]) {
+			return false
+		}
+	}
+
+	return true
+}
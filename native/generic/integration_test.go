@@ -0,0 +1,340 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestEuler1D(t *testing.T) {
+	t.Run("float32", testEuler1D[float32])
+	t.Run("float64", testEuler1D[float64])
+}
+
+func testEuler1D[T Float](t *testing.T) {
+	f := func(x, omega T) T {
+		return omega - x*x + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initValue := T(0.5)
+	result, err := Euler1D(a, b, N, initValue, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if (result - 4.865784) > 0.000001 {
+		t.Fail()
+	}
+}
+
+func TestTrapezoidRule(t *testing.T) {
+	t.Run("float32", testTrapezoidRule[float32])
+	t.Run("float64", testTrapezoidRule[float64])
+}
+
+func testTrapezoidRule[T Float](t *testing.T) {
+	f := func(x T) T {
+		return T(math.Sin(float64(x)))
+	}
+	a := T(0.0)
+	b := T(math.Pi / 4)
+	result, err := TrapezoidRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if (result - 0.2776801) > 0.0000001 {
+		t.Fail()
+	}
+}
+
+func TestSimpsonRule(t *testing.T) {
+	t.Run("float32", testSimpsonRule[float32])
+	t.Run("float64", testSimpsonRule[float64])
+}
+
+func testSimpsonRule[T Float](t *testing.T) {
+	f := func(x T) T {
+		return T(math.Sin(float64(x)))
+	}
+	a := T(0.0)
+	b := T(math.Pi / 4)
+	result, err := SimpsonRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if (result - 0.2929326) > 0.0000001 {
+		t.Fail()
+	}
+}
+
+func TestSimpson38Rule(t *testing.T) {
+	t.Run("float32", testSimpson38Rule[float32])
+	t.Run("float64", testSimpson38Rule[float64])
+}
+
+func testSimpson38Rule[T Float](t *testing.T) {
+	f := func(x T) T {
+		return T(math.Sin(float64(x)))
+	}
+	a := T(0.0)
+	b := T(math.Pi / 4)
+	result, err := Simpson38Rule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if (result - 0.2929107) > 0.0000001 {
+		t.Fail()
+	}
+}
+
+func TestBooleRule(t *testing.T) {
+	t.Run("float32", testBooleRule[float32])
+	t.Run("float64", testBooleRule[float64])
+}
+
+func testBooleRule[T Float](t *testing.T) {
+	f := func(x T) T {
+		return T(math.Sin(float64(x)))
+	}
+	a := T(0.0)
+	b := T(math.Pi / 4)
+	result, err := BooleRule(a, b, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if (result - 0.29289318) > 0.0000001 {
+		t.Fail()
+	}
+}
+
+func TestRungeKutta(t *testing.T) {
+	t.Run("float32", testRungeKutta[float32])
+	t.Run("float64", testRungeKutta[float64])
+}
+
+func testRungeKutta[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - T(math.Pow(float64(x), 2)) + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initialCondition := T(0.5)
+	solutionMatrixA, err := RungeKutta2(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixA[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	solutionMatrixB, err := RungeKutta4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixB[10][1]); math.Abs(result-5.3054720) > 1e-2 {
+		t.Fail()
+	}
+	TOL := T(1e-5)
+	maxStep := T(0.25)
+	minStep := T(0.01)
+	solutionMatrixC, err := RungeKuttaFehlbery(a, b, initialCondition, TOL, maxStep, minStep, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixC[9][1]); math.Abs(result-5.3054720) > 1e-4 {
+		t.Fail()
+	}
+	if last := solutionMatrixC[len(solutionMatrixC)-1][0]; last != b {
+		t.Errorf("Expected last mesh point %v, received %v", b, last)
+	}
+	_, errD := RungeKuttaFehlbery(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError[T]
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
+}
+
+func TestModifiedEuler(t *testing.T) {
+	t.Run("float32", testModifiedEuler[float32])
+	t.Run("float64", testModifiedEuler[float64])
+}
+
+func testModifiedEuler[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - T(math.Pow(float64(x), 2)) + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initialCondition := T(0.5)
+	solutionMatrix, err := ModifiedEuler(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrix[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+}
+
+func TestHeun(t *testing.T) {
+	t.Run("float32", testHeun[float32])
+	t.Run("float64", testHeun[float64])
+}
+
+func testHeun[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - T(math.Pow(float64(x), 2)) + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initialCondition := T(0.5)
+	solutionMatrix, err := Heun(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrix[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+}
+
+func TestAdamsBashforth(t *testing.T) {
+	t.Run("float32", testAdamsBashforth[float32])
+	t.Run("float64", testAdamsBashforth[float64])
+}
+
+func testAdamsBashforth[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - T(math.Pow(float64(x), 2)) + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initialCondition1 := T(0.5)
+	initialCondition2 := T(0.8292986)
+	initialCondition3 := T(1.2140877)
+	initialCondition4 := T(1.6489406)
+	initialCondition5 := T(2.1272295)
+	solutionMatrixA, err := AdamsBashforth2(a, b, N, initialCondition1, initialCondition2, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixA[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	solutionMatrixB, err := AdamsBashforth3(a, b, N, initialCondition1, initialCondition2, initialCondition3, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixB[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	solutionMatrixC, err := AdamsBashforth4(a, b, N, initialCondition1, initialCondition2, initialCondition3, initialCondition4, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if result := float64(solutionMatrixC[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	solutionMatrixD, err := AdamsBashforth5(a, b, N, initialCondition1,
+		initialCondition2, initialCondition3, initialCondition4, initialCondition5, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixD[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+}
+
+func TestAdamsBashforthMoulton(t *testing.T) {
+	t.Run("float32", testAdamsBashforthMoulton[float32])
+	t.Run("float64", testAdamsBashforthMoulton[float64])
+}
+
+func testAdamsBashforthMoulton[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - T(math.Pow(float64(x), 2)) + 1
+	}
+	a := T(0.0)
+	b := T(2.0)
+	N := 10
+	initialCondition := T(0.5)
+	solutionMatrixA, err := AdamsBashforthMoulton3(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixA[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	solutionMatrixB, err := AdamsBashforthMoulton4(a, b, N, initialCondition, f)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if result := float64(solutionMatrixB[10][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	maxStep := T(0.2)
+	minStep := T(0.01)
+	TOL := T(1e-5)
+	solutionMatrixC, err := AdamsBashforthMoulton(a, b, initialCondition, TOL, maxStep, minStep, f)
+	if err != nil {
+		t.Fail()
+	}
+	if result := float64(solutionMatrixC[21][1]); math.Abs(result-5.3054720) > 1e-1 {
+		t.Fail()
+	}
+	_, errD := AdamsBashforthMoulton(a, b, initialCondition, 1e-12, maxStep, 0.1, f)
+	var stepSizeErr *StepSizeError[T]
+	if !errors.As(errD, &stepSizeErr) || stepSizeErr.StepSize >= 0.1 {
+		t.Errorf("Expected step size underflow, received %v", errD)
+	}
+}
+
+func TestIntegrationValidation(t *testing.T) {
+	t.Run("float32", testIntegrationValidation[float32])
+	t.Run("float64", testIntegrationValidation[float64])
+}
+
+func testIntegrationValidation[T Float](t *testing.T) {
+	f := func(x, y T) T {
+		return y - x*x + 1
+	}
+	g := func(x T) T {
+		return T(math.Sin(float64(x)))
+	}
+
+	if _, err := Euler1D(0, 2, 0, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for N = 0, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := SimpsonRule(1, 1, g); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a = b, received %v", ErrInvalidArgument, err)
+	}
+
+	if _, err := RungeKutta4(2, 0, 10, 0.5, f); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v for a > b, received %v", ErrInvalidArgument, err)
+	}
+
+	var argumentErr *ArgumentError
+	if _, err := AdamsBashforth4(0, 2, 2, 0.5, 0.5, 0.5, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton4(0, 2, 2, 0.5, f); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 0, 0.25, 0.01, f); !errors.As(err, &argumentErr) || argumentErr.Name != "TOL" {
+		t.Errorf("Expected ArgumentError for TOL, received %v", err)
+	}
+
+	if _, err := RungeKuttaFehlbery(0, 2, 0.5, 1e-5, 0.01, 0.25, f); !errors.As(err, &argumentErr) || argumentErr.Name != "maxStep" {
+		t.Errorf("Expected ArgumentError for maxStep, received %v", err)
+	}
+
+	if _, err := AdamsBashforthMoulton(0, 2, 0.5, 1e-5, 0.2, 0, f); !errors.As(err, &argumentErr) || argumentErr.Name != "minStep" {
+		t.Errorf("Expected ArgumentError for minStep, received %v", err)
+	}
+}
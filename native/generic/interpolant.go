@@ -0,0 +1,385 @@
+package generic
+
+import (
+	"math"
+	"sort"
+)
+
+// Interpolant is an interpolating function that can be evaluated, differentiated and integrated
+type Interpolant[T Float] interface {
+	// Eval returns the value of the interpolant at x
+	Eval(x T) T
+	// EvalMany returns the value of the interpolant at each of xs
+	EvalMany(xs []T) []T
+	// Derivative returns the derivative of the given order at x, a negative order returns NaN
+	Derivative(x T, order int) T
+	// Integral returns the integral of the interpolant from a to b
+	Integral(a T, b T) T
+	// Domain returns the interval spanned by the interpolation nodes
+	Domain() (T, T)
+}
+
+var (
+	_ Interpolant[float64] = (*NewtonPolynomial[float64])(nil)
+	_ Interpolant[float64] = (*PiecewisePolynomial[float64])(nil)
+)
+
+// NewtonPolynomial is an interpolating polynomial in newton's divided-difference form,
+// coefficients[k] multiplies (x - nodes[0])...(x - nodes[k-1])
+type NewtonPolynomial[T Float] struct {
+	nodes        []T
+	coefficients []T
+}
+
+// NewNewtonInterpolant builds newton's divided-difference interpolating polynomial through the given points
+func NewNewtonInterpolant[T Float](xValues []T, functionValues []T) (*NewtonPolynomial[T], error) {
+	if len(xValues) == 0 {
+		return nil, &DimensionError{Name: "xValues", Expected: 1, Received: 0}
+	}
+
+	tableValues, err := NewtonDividedDifference(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]T, len(xValues))
+	copy(nodes, xValues)
+
+	coefficients := make([]T, len(tableValues))
+	for i := range tableValues {
+		coefficients[i] = tableValues[i][i]
+	}
+
+	return &NewtonPolynomial[T]{nodes: nodes, coefficients: coefficients}, nil
+}
+
+// NewHermiteInterpolant builds the hermite interpolating polynomial matching the function values and derivatives
+func NewHermiteInterpolant[T Float](xValues []T, functionValues []T, dfunctionValues []T) (*NewtonPolynomial[T], error) {
+	if len(xValues) == 0 {
+		return nil, &DimensionError{Name: "xValues", Expected: 1, Received: 0}
+	}
+
+	coefficients, err := Hermite(xValues, functionValues, dfunctionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]T, 2*len(xValues))
+	for i, x := range xValues {
+		nodes[2*i] = x
+		nodes[2*i+1] = x
+	}
+
+	return &NewtonPolynomial[T]{nodes: nodes, coefficients: coefficients}, nil
+}
+
+// Coefficients returns a copy of the divided-difference coefficients
+func (p *NewtonPolynomial[T]) Coefficients() []T {
+	coefficients := make([]T, len(p.coefficients))
+	copy(coefficients, p.coefficients)
+	return coefficients
+}
+
+// Eval returns the value of the polynomial at x using nested multiplication
+func (p *NewtonPolynomial[T]) Eval(x T) T {
+	size := len(p.coefficients)
+	value := p.coefficients[size-1]
+	for k := size - 2; k >= 0; k-- {
+		value = value*(x-p.nodes[k]) + p.coefficients[k]
+	}
+	return value
+}
+
+// EvalMany returns the value of the polynomial at each of xs
+func (p *NewtonPolynomial[T]) EvalMany(xs []T) []T {
+	return evalMany[T](p, xs)
+}
+
+// Derivative returns the derivative of the given order at x, carrying the scaled taylor coefficients
+// through the nested multiplication
+func (p *NewtonPolynomial[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	size := len(p.coefficients)
+	taylor := make([]T, order+1)
+	taylor[0] = p.coefficients[size-1]
+	for k := size - 2; k >= 0; k-- {
+		for j := order; j >= 1; j-- {
+			taylor[j] = taylor[j]*(x-p.nodes[k]) + taylor[j-1]
+		}
+		taylor[0] = taylor[0]*(x-p.nodes[k]) + p.coefficients[k]
+	}
+
+	return factorial[T](order) * taylor[order]
+}
+
+// Integral returns the exact integral of the polynomial from a to b
+func (p *NewtonPolynomial[T]) Integral(a T, b T) T {
+	shift := p.nodes[0]
+	size := len(p.coefficients)
+
+	// expand into powers of (x - shift) by repeated multiplication with (x - nodes[k])
+	power := []T{p.coefficients[size-1]}
+	for k := size - 2; k >= 0; k-- {
+		root := p.nodes[k] - shift
+		next := make([]T, len(power)+1)
+		for j := range power {
+			next[j+1] += power[j]
+			next[j] -= root * power[j]
+		}
+		next[0] += p.coefficients[k]
+		power = next
+	}
+
+	return antiderivative(power, b-shift) - antiderivative(power, a-shift)
+}
+
+// Domain returns the smallest and largest interpolation nodes
+func (p *NewtonPolynomial[T]) Domain() (T, T) {
+	lower, upper := p.nodes[0], p.nodes[0]
+	for _, x := range p.nodes {
+		if x < lower {
+			lower = x
+		}
+		if x > upper {
+			upper = x
+		}
+	}
+	return lower, upper
+}
+
+// PiecewisePolynomial is an interpolant made of polynomial pieces, piece i covers [breakpoints[i], breakpoints[i+1]]
+// and coefficients[i] holds its coefficients of ascending powers of x - breakpoints[i].
+// Outside of the breakpoints the first and last pieces are extended.
+type PiecewisePolynomial[T Float] struct {
+	breakpoints  []T
+	coefficients [][]T
+}
+
+// NewNaturalCubicSplineInterpolant builds the natural cubic spline through the given points
+func NewNaturalCubicSplineInterpolant[T Float](xValues []T, functionValues []T) (*PiecewisePolynomial[T], error) {
+	if len(xValues) < 2 {
+		return nil, &DimensionError{Name: "xValues", Expected: 2, Received: len(xValues)}
+	}
+
+	solutionTable, err := NaturalCubicSpline(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// NewClampedCubicSplineInterpolant builds the clamped cubic spline through the given points with end slopes df0 and dfN
+func NewClampedCubicSplineInterpolant[T Float](xValues []T, functionValues []T, df0 T, dfN T) (*PiecewisePolynomial[T], error) {
+	if len(xValues) < 2 {
+		return nil, &DimensionError{Name: "xValues", Expected: 2, Received: len(xValues)}
+	}
+
+	solutionTable, err := ClampedCubicSpline(xValues, functionValues, df0, dfN)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// NewNotAKnotCubicSplineInterpolant builds the not-a-knot cubic spline through the given points
+func NewNotAKnotCubicSplineInterpolant[T Float](xValues []T, functionValues []T) (*PiecewisePolynomial[T], error) {
+	solutionTable, err := NotAKnotCubicSpline(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// NewPeriodicCubicSplineInterpolant builds the periodic cubic spline through the given points
+func NewPeriodicCubicSplineInterpolant[T Float](xValues []T, functionValues []T) (*PiecewisePolynomial[T], error) {
+	solutionTable, err := PeriodicCubicSpline(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// NewMonotoneCubicSplineInterpolant builds the monotone piecewise cubic hermite interpolant through the given points
+func NewMonotoneCubicSplineInterpolant[T Float](xValues []T, functionValues []T) (*PiecewisePolynomial[T], error) {
+	solutionTable, err := MonotoneCubicSpline(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// NewAkimaSplineInterpolant builds the akima spline through the given points
+func NewAkimaSplineInterpolant[T Float](xValues []T, functionValues []T) (*PiecewisePolynomial[T], error) {
+	solutionTable, err := AkimaSpline(xValues, functionValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCubicSplineInterpolant(xValues, solutionTable), nil
+}
+
+// newCubicSplineInterpolant reads the {a, b, c, d} rows of a cubic spline solution table into pieces
+func newCubicSplineInterpolant[T Float](xValues []T, solutionTable [][]T) *PiecewisePolynomial[T] {
+	breakpoints := make([]T, len(xValues))
+	copy(breakpoints, xValues)
+
+	coefficients := make([][]T, len(xValues)-1)
+	for i := range coefficients {
+		coefficients[i] = []T{solutionTable[0][i], solutionTable[1][i], solutionTable[2][i], solutionTable[3][i]}
+	}
+
+	return &PiecewisePolynomial[T]{breakpoints: breakpoints, coefficients: coefficients}
+}
+
+// NewBezierCurveInterpolant builds the x and y components of the piecewise cubic bezier curve,
+// segment i is traced as the parameter runs over [i, i+1]
+func NewBezierCurveInterpolant[T Float](endpoints [][2]T, leftGuidepoints [][2]T, rightGuidepoints [][2]T) (*PiecewisePolynomial[T], *PiecewisePolynomial[T], error) {
+	solutionTable, err := BezierCurve(endpoints, leftGuidepoints, rightGuidepoints)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	segments := len(solutionTable[0])
+	if segments == 0 {
+		return nil, nil, &DimensionError{Name: "endpoints", Expected: 2, Received: len(endpoints)}
+	}
+
+	breakpoints := make([]T, segments+1)
+	for i := range breakpoints {
+		breakpoints[i] = T(i)
+	}
+
+	xCoefficients := make([][]T, segments)
+	yCoefficients := make([][]T, segments)
+	for i := 0; i < segments; i++ {
+		xCoefficients[i] = append([]T(nil), solutionTable[0][i][:]...)
+		yCoefficients[i] = append([]T(nil), solutionTable[1][i][:]...)
+	}
+
+	x := &PiecewisePolynomial[T]{breakpoints: breakpoints, coefficients: xCoefficients}
+	y := &PiecewisePolynomial[T]{breakpoints: append([]T(nil), breakpoints...), coefficients: yCoefficients}
+
+	return x, y, nil
+}
+
+// Breakpoints returns a copy of the breakpoints
+func (p *PiecewisePolynomial[T]) Breakpoints() []T {
+	return append([]T(nil), p.breakpoints...)
+}
+
+// Coefficients returns a copy of the coefficients of piece i
+func (p *PiecewisePolynomial[T]) Coefficients(i int) []T {
+	return append([]T(nil), p.coefficients[i]...)
+}
+
+// piece returns the index of the piece used to evaluate x
+func (p *PiecewisePolynomial[T]) piece(x T) int {
+	return sort.Search(len(p.coefficients)-1, func(i int) bool {
+		return x < p.breakpoints[i+1]
+	})
+}
+
+// Eval returns the value of the interpolant at x
+func (p *PiecewisePolynomial[T]) Eval(x T) T {
+	i := p.piece(x)
+	return horner(p.coefficients[i], x-p.breakpoints[i])
+}
+
+// EvalMany returns the value of the interpolant at each of xs
+func (p *PiecewisePolynomial[T]) EvalMany(xs []T) []T {
+	return evalMany[T](p, xs)
+}
+
+// Derivative returns the derivative of the given order at x
+func (p *PiecewisePolynomial[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	i := p.piece(x)
+	coefficients := p.coefficients[i]
+	if order >= len(coefficients) {
+		return 0
+	}
+
+	derivative := make([]T, len(coefficients)-order)
+	for m := order; m < len(coefficients); m++ {
+		derivative[m-order] = coefficients[m] * factorial[T](m) / factorial[T](m-order)
+	}
+
+	return horner(derivative, x-p.breakpoints[i])
+}
+
+// Integral returns the exact integral of the interpolant from a to b
+func (p *PiecewisePolynomial[T]) Integral(a T, b T) T {
+	if a > b {
+		return -p.Integral(b, a)
+	}
+
+	var integral T
+	last := len(p.coefficients) - 1
+	for i, coefficients := range p.coefficients {
+		lower, upper := a, b
+		if i > 0 && lower < p.breakpoints[i] {
+			lower = p.breakpoints[i]
+		}
+		if i < last && upper > p.breakpoints[i+1] {
+			upper = p.breakpoints[i+1]
+		}
+		if lower >= upper {
+			continue
+		}
+		integral += antiderivative(coefficients, upper-p.breakpoints[i]) - antiderivative(coefficients, lower-p.breakpoints[i])
+	}
+
+	return integral
+}
+
+// Domain returns the first and last breakpoints
+func (p *PiecewisePolynomial[T]) Domain() (T, T) {
+	return p.breakpoints[0], p.breakpoints[len(p.breakpoints)-1]
+}
+
+// evalMany evaluates interpolant at each of xs
+func evalMany[T Float](interpolant Interpolant[T], xs []T) []T {
+	values := make([]T, len(xs))
+	for i, x := range xs {
+		values[i] = interpolant.Eval(x)
+	}
+	return values
+}
+
+// horner evaluates the polynomial with coefficients of ascending powers at x
+func horner[T Float](coefficients []T, x T) T {
+	var value T
+	for m := len(coefficients) - 1; m >= 0; m-- {
+		value = value*x + coefficients[m]
+	}
+	return value
+}
+
+// antiderivative evaluates the antiderivative vanishing at 0 of the polynomial with coefficients of ascending powers at x
+func antiderivative[T Float](coefficients []T, x T) T {
+	var value T
+	for m := len(coefficients) - 1; m >= 0; m-- {
+		value = value*x + coefficients[m]/T(m+1)
+	}
+	return value * x
+}
+
+// factorial returns n! as a T
+func factorial[T Float](n int) T {
+	value := T(1)
+	for i := 2; i <= n; i++ {
+		value *= T(i)
+	}
+	return value
+}
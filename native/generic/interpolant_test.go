@@ -0,0 +1,311 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// approxEqual reports whether received matches expected to within testTolerance scaled by scale
+func approxEqual[T Float](expected float64, received T, scale float64) bool {
+	return math.Abs(expected-float64(received)) <= scale*testTolerance[T]()*math.Max(1, math.Abs(expected))
+}
+
+func TestNewNewtonInterpolant(t *testing.T) {
+	t.Run("float32", testNewNewtonInterpolant[float32])
+	t.Run("float64", testNewNewtonInterpolant[float64])
+}
+
+func testNewNewtonInterpolant[T Float](t *testing.T) {
+	// x^3 - 2x + 1 is reproduced exactly by four nodes
+	interpolant, errA := NewNewtonInterpolant([]T{-1, 0, 1, 2}, []T{2, 1, 0, 5})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(0.5); !approxEqual(0.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.125, value)
+	}
+
+	values := interpolant.EvalMany([]T{-1, 1.5})
+	if len(values) != 2 || !approxEqual(2, values[0], 10) || !approxEqual(1.375, values[1], 10) {
+		t.Errorf("Expected [2 1.375], received %v", values)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 1); !approxEqual(-1.25, derivative, 10) {
+		t.Errorf("Expected %v, received %v", -1.25, derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 2); !approxEqual(3, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 3, derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 3); !approxEqual(6, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 6, derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, 4); derivative != 0 {
+		t.Errorf("Expected 0, received %v", derivative)
+	}
+
+	if derivative := interpolant.Derivative(0.5, -1); !math.IsNaN(float64(derivative)) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	if integral := interpolant.Integral(0, 2); !approxEqual(2, integral, 10) {
+		t.Errorf("Expected %v, received %v", 2, integral)
+	}
+
+	if integral := interpolant.Integral(2, -1); !approxEqual(-3.75, integral, 10) {
+		t.Errorf("Expected %v, received %v", -3.75, integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != -1 || upper != 2 {
+		t.Errorf("Expected domain [-1, 2], received [%v, %v]", lower, upper)
+	}
+
+	_, errB := NewNewtonInterpolant([]T{1, 2}, []T{1})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NewNewtonInterpolant([]T{}, []T{})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestNewHermiteInterpolant(t *testing.T) {
+	t.Run("float32", testNewHermiteInterpolant[float32])
+	t.Run("float64", testNewHermiteInterpolant[float64])
+}
+
+func testNewHermiteInterpolant[T Float](t *testing.T) {
+	// x^3 and its derivative at two nodes determine the cubic
+	interpolant, errA := NewHermiteInterpolant([]T{0, 2}, []T{0, 8}, []T{0, 12})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(1.5); !approxEqual(3.375, value, 10) {
+		t.Errorf("Expected %v, received %v", 3.375, value)
+	}
+
+	if derivative := interpolant.Derivative(1.5, 1); !approxEqual(6.75, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 6.75, derivative)
+	}
+
+	if integral := interpolant.Integral(0, 2); !approxEqual(4, integral, 10) {
+		t.Errorf("Expected %v, received %v", 4, integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != 0 || upper != 2 {
+		t.Errorf("Expected domain [0, 2], received [%v, %v]", lower, upper)
+	}
+
+	_, errB := NewHermiteInterpolant([]T{0, 2}, []T{0, 8}, []T{0})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewNaturalCubicSplineInterpolant(t *testing.T) {
+	t.Run("float32", testNewNaturalCubicSplineInterpolant[float32])
+	t.Run("float64", testNewNaturalCubicSplineInterpolant[float64])
+}
+
+func testNewNaturalCubicSplineInterpolant[T Float](t *testing.T) {
+	xValues := []T{0, 1, 2, 3, 4}
+	functionValues := []T{1, T(math.Exp(1)), T(math.Exp(2)), T(math.Exp(3)), T(math.Exp(4))}
+	interpolant, errA := NewNaturalCubicSplineInterpolant(xValues, functionValues)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	values := interpolant.EvalMany(xValues)
+	for i := range xValues {
+		if !approxEqual(float64(functionValues[i]), values[i], 10) {
+			t.Errorf("Expected %v at %v, received %v", functionValues[i], xValues[i], values[i])
+		}
+	}
+
+	// the pieces join with matching second derivatives, each piece has unit width
+	for i := 1; i < 4; i++ {
+		left := interpolant.Coefficients(i - 1)
+		right := interpolant.Derivative(xValues[i], 2)
+		if !approxEqual(float64(2*left[2]+6*left[3]), right, 100) {
+			t.Errorf("Expected continuous second derivative at %v, received %v and %v", xValues[i], 2*left[2]+6*left[3], right)
+		}
+	}
+
+	if derivative := interpolant.Derivative(0, 2); math.Abs(float64(derivative)) > 1e-4 {
+		t.Errorf("Expected natural boundary, received %v", derivative)
+	}
+
+	// integral of the natural spline of e^x on [0, 3] from Numerical Analysis - By Burden and Faires
+	burdenFaires, _ := NewNaturalCubicSplineInterpolant(xValues[:4], functionValues[:4])
+	if integral := burdenFaires.Integral(0, 3); math.Abs(float64(integral)-19.55229) > 1e-5 {
+		t.Errorf("Expected %v, received %v", 19.55229, integral)
+	}
+
+	if integral := interpolant.Integral(0.5, 3.5); !approxEqual(float64(interpolant.Integral(0.5, 2)+interpolant.Integral(2, 3.5)), integral, 10) {
+		t.Errorf("Expected integrals to be additive, received %v", integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != 0 || upper != 4 {
+		t.Errorf("Expected domain [0, 4], received [%v, %v]", lower, upper)
+	}
+
+	_, errB := NewNaturalCubicSplineInterpolant([]T{0}, []T{1})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewClampedCubicSplineInterpolant(t *testing.T) {
+	t.Run("float32", testNewClampedCubicSplineInterpolant[float32])
+	t.Run("float64", testNewClampedCubicSplineInterpolant[float64])
+}
+
+func testNewClampedCubicSplineInterpolant[T Float](t *testing.T) {
+	// a clamped spline with the exact end slopes reproduces a cubic
+	interpolant, errA := NewClampedCubicSplineInterpolant([]T{0, 1, 2, 3}, []T{0, 1, 8, 27}, 0, 27)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(1.5); !approxEqual(3.375, value, 10) {
+		t.Errorf("Expected %v, received %v", 3.375, value)
+	}
+
+	if derivative := interpolant.Derivative(2.5, 1); !approxEqual(18.75, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 18.75, derivative)
+	}
+
+	if derivative := interpolant.Derivative(2.5, 2); !approxEqual(15, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 15, derivative)
+	}
+
+	if derivative := interpolant.Derivative(2.5, 4); derivative != 0 {
+		t.Errorf("Expected 0, received %v", derivative)
+	}
+
+	if integral := interpolant.Integral(0, 3); !approxEqual(20.25, integral, 10) {
+		t.Errorf("Expected %v, received %v", 20.25, integral)
+	}
+
+	if integral := interpolant.Integral(3, 0.5); !approxEqual(-20.234375, integral, 10) {
+		t.Errorf("Expected %v, received %v", -20.234375, integral)
+	}
+
+	breakpoints := interpolant.Breakpoints()
+	breakpoints[0] = 10
+	if lower, _ := interpolant.Domain(); lower != 0 {
+		t.Errorf("Expected breakpoints to be copied, received domain starting at %v", lower)
+	}
+}
+
+func TestNewBezierCurveInterpolant(t *testing.T) {
+	t.Run("float32", testNewBezierCurveInterpolant[float32])
+	t.Run("float64", testNewBezierCurveInterpolant[float64])
+}
+
+func testNewBezierCurveInterpolant[T Float](t *testing.T) {
+	x, y, errA := NewBezierCurveInterpolant([][2]T{{0, 0}, {1, 0}, {2, 2}}, [][2]T{{2, 1}, {1, 1}}, [][2]T{{0, 1}, {2, 1}})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	endpoints := [][2]float64{{0, 0}, {1, 0}, {2, 2}}
+	for i, endpoint := range endpoints {
+		if !approxEqual(endpoint[0], x.Eval(T(i)), 10) || !approxEqual(endpoint[1], y.Eval(T(i)), 10) {
+			t.Errorf("Expected %v at %v, received (%v, %v)", endpoint, i, x.Eval(T(i)), y.Eval(T(i)))
+		}
+	}
+
+	// x(t) = 6t - 12t^2 + 7t^3 and y(t) = 3t - 3t^2 on the first segment
+	if value := x.Eval(0.5); !approxEqual(0.875, value, 10) {
+		t.Errorf("Expected %v, received %v", 0.875, value)
+	}
+
+	if derivative := y.Derivative(0.5, 1); !approxEqual(0, derivative, 10) {
+		t.Errorf("Expected %v, received %v", 0, derivative)
+	}
+
+	if coefficients := x.Coefficients(0); !approxEqualSlice([]float64{0, 6, -12, 7}, coefficients) {
+		t.Errorf("Expected %v, received %v", []float64{0, 6, -12, 7}, coefficients)
+	}
+
+	if lower, upper := x.Domain(); lower != 0 || upper != 2 {
+		t.Errorf("Expected domain [0, 2], received [%v, %v]", lower, upper)
+	}
+
+	_, _, errB := NewBezierCurveInterpolant([][2]T{{0, 0}, {1, 0}}, [][2]T{{2, 1}}, [][2]T{})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewShapeCubicSplineInterpolants(t *testing.T) {
+	t.Run("float32", testNewShapeCubicSplineInterpolants[float32])
+	t.Run("float64", testNewShapeCubicSplineInterpolants[float64])
+}
+
+func testNewShapeCubicSplineInterpolants[T Float](t *testing.T) {
+	xValues := []T{0, 1, 2.5, 3, 4}
+	notAKnot, errA := NewNotAKnotCubicSplineInterpolant(xValues, []T{1, 0, 11.625, 22, 57})
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := notAKnot.Eval(2); !approxEqual(5, value, 10) {
+		t.Errorf("Expected %v, received %v", 5, value)
+	}
+
+	periodic, errB := NewPeriodicCubicSplineInterpolant([]T{0, 0.25, 0.5, 0.75, 1}, []T{0, 1, 0, -1, 0})
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	if difference := periodic.Derivative(0, 1) - periodic.Derivative(1, 1); !approxEqual(0, difference, 100) {
+		t.Errorf("Expected matching end slopes, received a difference of %v", difference)
+	}
+
+	monotone, errC := NewMonotoneCubicSplineInterpolant(xValues, []T{0, 0, 0.1, 0.9, 1})
+
+	if errC != nil {
+		t.Fatalf("Unexpected error %v", errC)
+	}
+
+	if value := monotone.Eval(0.5); value != 0 {
+		t.Errorf("Expected 0 on the flat piece, received %v", value)
+	}
+
+	akima, errD := NewAkimaSplineInterpolant([]T{0, 1, 2, 3}, []T{0, 1, 1, 0})
+
+	if errD != nil {
+		t.Fatalf("Unexpected error %v", errD)
+	}
+
+	if value := akima.Eval(1.5); !approxEqual(1.125, value, 10) {
+		t.Errorf("Expected %v, received %v", 1.125, value)
+	}
+
+	_, errE := NewAkimaSplineInterpolant([]T{0}, []T{0})
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+}
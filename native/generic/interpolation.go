@@ -0,0 +1,712 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SortNodes sorts xValues into increasing order in place and applies the same permutation to each of values,
+// so that data given in any order can be passed to the methods that require increasing nodes
+func SortNodes[T Float](xValues []T, values ...[]T) error {
+	for k, series := range values {
+		if len(series) != len(xValues) {
+			return &DimensionError{Name: fmt.Sprintf("values[%d]", k), Expected: len(xValues), Received: len(series)}
+		}
+	}
+
+	for i, x := range xValues {
+		if math.IsNaN(float64(x)) {
+			return &ArgumentError{Name: fmt.Sprintf("xValues[%d]", i), Value: x, Reason: "must not be NaN"}
+		}
+	}
+
+	order := make([]int, len(xValues))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return xValues[order[i]] < xValues[order[j]] })
+
+	permuted := make([]T, len(xValues))
+	for _, series := range append([][]T{xValues}, values...) {
+		for i, k := range order {
+			permuted[i] = series[k]
+		}
+		copy(series, permuted)
+	}
+
+	return nil
+}
+
+// NewtonForwardDividedDifference is for calculating the coefficients for newton's forward divided-difference interpolating polynomial
+func NewtonForwardDividedDifference[T Float](xValues []T, functionValues []T) ([]T, error) {
+	tableValues, err := NewtonDividedDifference(xValues, functionValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	solutionSet := make([]T, len(tableValues))
+
+	for i := 0; i < len(tableValues); i++ {
+		solutionSet[i] = tableValues[i][i]
+	}
+
+	return solutionSet, nil
+}
+
+// NewtonBackwardsDividedDifference is for calculating the coefficients for newton's backwards divided-difference interpolating polynomial
+func NewtonBackwardsDividedDifference[T Float](xValues []T, functionValues []T) ([]T, error) {
+	tableValues, err := NewtonDividedDifference(xValues, functionValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	solutionSet := make([]T, len(tableValues))
+
+	for i := 0; i < len(tableValues); i++ {
+		solutionSet[i] = tableValues[len(tableValues)-1][i]
+	}
+
+	return solutionSet, nil
+}
+
+// StirlingCenterDividedDifference is for calculating the coefficients for stirling's center divided-difference interpolating polynomial
+// if xValues or functionValues has an even number of elements, the last elements will be removed.
+func StirlingCenterDividedDifference[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	if len(xValues)%2 == 0 || len(functionValues)%2 == 0 {
+		xValues = xValues[:len(xValues)-1]
+		functionValues = functionValues[:len(functionValues)-1]
+	}
+
+	tableValues, err := NewtonDividedDifference(xValues, functionValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	solutionSet := make([][]T, len(tableValues))
+
+	for i := 0; i < len(tableValues); i++ {
+		if i%2 == 0 {
+			solutionSet[i] = make([]T, 1)
+		} else {
+			solutionSet[i] = make([]T, 2)
+		}
+
+	}
+
+	middle := int(math.Floor(float64(len(tableValues)) / 2))
+
+	for i := 0; i < len(tableValues); i++ {
+		if i%2 == 1 {
+			solutionSet[i][0] = tableValues[middle][i]
+			middle++
+			solutionSet[i][1] = tableValues[middle][i]
+		} else {
+			solutionSet[i][0] = tableValues[middle][i]
+		}
+	}
+
+	return solutionSet, nil
+}
+
+// NewtonDividedDifference is for calculating the coefficients for newton's divided-difference interpolating polynomial
+func NewtonDividedDifference[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	tableValues := make([][]T, size)
+
+	for i := 0; i < size; i++ {
+		tableValues[i] = make([]T, i+1)
+		tableValues[i][0] = functionValues[i]
+	}
+
+	for i := 1; i < size; i++ {
+		for j := 1; j <= i; j++ {
+			tableValues[i][j] = (tableValues[i][j-1] - tableValues[i-1][j-1]) / (xValues[i] - xValues[i-j])
+		}
+	}
+
+	return tableValues, nil
+}
+
+// NevilleIterated is for determining the table values of neville iterated interpolation
+func NevilleIterated[T Float](valueToApprox T, xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	tableValues := make([][]T, size)
+
+	for i := 0; i < size; i++ {
+		tableValues[i] = make([]T, i+1)
+		tableValues[i][0] = functionValues[i]
+	}
+
+	for i := 1; i < size; i++ {
+		for j := 1; j <= i; j++ {
+			tableValues[i][j] = ((valueToApprox-xValues[i-j])*tableValues[i][j-1] - (valueToApprox-xValues[i])*tableValues[i-1][j-1]) / (xValues[i] - xValues[i-j])
+		}
+	}
+
+	return tableValues, nil
+}
+
+// BulirschStoerRational is for determining the table values of bulirsch-stoer rational interpolation,
+// tableValues[i][j] is the rational interpolant through the points i-j to i evaluated at valueToApprox
+// with a denominator degree equal to or one more than the numerator degree
+// Algorithm from Introduction to Numerical Analysis - By Stoer and Bulirsch
+func BulirschStoerRational[T Float](valueToApprox T, xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	tableValues := make([][]T, size)
+
+	for i := 0; i < size; i++ {
+		tableValues[i] = make([]T, i+1)
+		tableValues[i][0] = functionValues[i]
+	}
+
+	for i := 1; i < size; i++ {
+		for j := 1; j <= i; j++ {
+			difference := tableValues[i][j-1] - tableValues[i-1][j-1]
+
+			switch {
+			case difference == 0 || valueToApprox == xValues[i]:
+				tableValues[i][j] = tableValues[i][j-1]
+			case valueToApprox == xValues[i-j]:
+				tableValues[i][j] = tableValues[i-1][j-1]
+			default:
+				// the table is extended by a column of zeros on the left
+				var previous T
+				if j > 1 {
+					previous = tableValues[i-1][j-2]
+				}
+				ratio := (valueToApprox - xValues[i-j]) / (valueToApprox - xValues[i])
+				tableValues[i][j] = tableValues[i][j-1] + difference/(ratio*(1-difference/(tableValues[i][j-1]-previous))-1)
+			}
+		}
+	}
+
+	return tableValues, nil
+}
+
+// Hermite is for determining the coefficients of the hermite interpolation polynomial
+func Hermite[T Float](xValues []T, functionValues []T, dfunctionValues []T) ([]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 1, false); err != nil {
+		return nil, err
+	}
+
+	if err := validateValues("dfunctionValues", dfunctionValues, size); err != nil {
+		return nil, err
+	}
+
+	valueDoubleSet := make([]T, 2*size)
+
+	tableValues := make([][]T, 2*size)
+
+	for i := 0; i < size; i++ {
+		tableValues[2*i] = make([]T, 2*i+1)
+		tableValues[2*i+1] = make([]T, 2*(i+1))
+	}
+
+	valueDoubleSet[0] = xValues[0]
+	valueDoubleSet[1] = xValues[0]
+
+	tableValues[0][0] = functionValues[0]
+	tableValues[1][0] = functionValues[0]
+	tableValues[1][1] = dfunctionValues[0]
+
+	for i := 1; i < size; i++ {
+		valueDoubleSet[2*i] = xValues[i]
+		valueDoubleSet[2*i+1] = xValues[i]
+
+		tableValues[2*i][0] = functionValues[i]
+		tableValues[2*i+1][0] = functionValues[i]
+		tableValues[2*i+1][1] = dfunctionValues[i]
+
+		tableValues[2*i][1] = (tableValues[2*i][0] - tableValues[2*i-1][0]) / (valueDoubleSet[2*i] - valueDoubleSet[2*i-1])
+	}
+
+	for i := 2; i < 2*size; i++ {
+		for j := 2; j <= i; j++ {
+			tableValues[i][j] = (tableValues[i][j-1] - tableValues[i-1][j-1]) / (valueDoubleSet[i] - valueDoubleSet[i-j])
+		}
+	}
+
+	solutionSet := make([]T, 2*size)
+
+	for i := 0; i < 2*size; i++ {
+		solutionSet[i] = tableValues[i][i]
+	}
+
+	return solutionSet, nil
+}
+
+// NaturalCubicSpline is used for finding the coefficients solution set of the natural cubic spline
+func NaturalCubicSpline[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	stepLengthSet := make([]T, size-1)
+
+	for i := 0; i < size-1; i++ {
+		stepLengthSet[i] = xValues[i+1] - xValues[i]
+	}
+
+	alpha := make([]T, size-1)
+
+	for i := 1; i < size-1; i++ {
+		alpha[i] = 3.0*(functionValues[i+1]-functionValues[i])/stepLengthSet[i] - 3.0*(functionValues[i]-functionValues[i-1])/stepLengthSet[i-1]
+	}
+
+	solvingSetA := make([]T, size)
+	solvingSetB := make([]T, size-1)
+	solvingSetC := make([]T, size)
+
+	solvingSetA[0] = 1
+	solvingSetB[0] = 0
+	solvingSetC[0] = 0
+
+	for i := 1; i < size-1; i++ {
+		solvingSetA[i] = 2.0*(xValues[i+1]-xValues[i-1]) - stepLengthSet[i-1]*solvingSetB[i-1]
+		solvingSetB[i] = stepLengthSet[i] / solvingSetA[i]
+		solvingSetC[i] = (alpha[i] - stepLengthSet[i-1]*solvingSetC[i-1]) / solvingSetA[i]
+	}
+
+	solvingSetA[size-1] = 1
+	solvingSetC[size-1] = 0
+
+	var solutionSetA []T
+	solutionSetA = functionValues[:size-1]
+	solutionSetB := make([]T, size-1)
+	solutionSetC := make([]T, size)
+	solutionSetD := make([]T, size-1)
+
+	solutionSetC[size-1] = 0
+
+	sizeSolutionSet := len(solutionSetB)
+
+	for i := sizeSolutionSet - 1; i >= 0; i-- {
+		solutionSetC[i] = solvingSetC[i] - solvingSetB[i]*solutionSetC[i+1]
+		solutionSetB[i] = (functionValues[i+1]-functionValues[i])/stepLengthSet[i] - stepLengthSet[i]*(solutionSetC[i+1]+2.0*solutionSetC[i])/3.0
+		solutionSetD[i] = (solutionSetC[i+1] - solutionSetC[i]) / (3.0 * stepLengthSet[i])
+	}
+
+	solutionSetC = solutionSetC[:size-1]
+
+	solutionTable := [][]T{}
+
+	solutionTable = append(solutionTable, solutionSetA)
+	solutionTable = append(solutionTable, solutionSetB)
+	solutionTable = append(solutionTable, solutionSetC)
+	solutionTable = append(solutionTable, solutionSetD)
+
+	return solutionTable, nil
+}
+
+// ClampedCubicSpline is for finding the coefficients solution set of the clamped cubic spline
+func ClampedCubicSpline[T Float](xValues []T, functionValues []T, df0 T, dfN T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	if math.IsNaN(float64(df0)) {
+		return nil, &ArgumentError{Name: "df0", Value: df0, Reason: "must not be NaN"}
+	}
+
+	if math.IsNaN(float64(dfN)) {
+		return nil, &ArgumentError{Name: "dfN", Value: dfN, Reason: "must not be NaN"}
+	}
+
+	stepLengthSet := make([]T, size-1)
+
+	for i := 0; i < size-1; i++ {
+		stepLengthSet[i] = xValues[i+1] - xValues[i]
+	}
+
+	alpha := make([]T, size)
+
+	alpha[0] = 3.0*(functionValues[1]-functionValues[0])/stepLengthSet[0] - 3.0*df0
+	alpha[size-1] = 3.0*dfN - 3.0*(functionValues[size-1]-functionValues[size-2])/stepLengthSet[size-2]
+
+	for i := 1; i < size-1; i++ {
+		alpha[i] = 3.0*(functionValues[i+1]-functionValues[i])/stepLengthSet[i] - 3.0*(functionValues[i]-functionValues[i-1])/stepLengthSet[i-1]
+	}
+
+	solvingSetA := make([]T, size)
+	solvingSetB := make([]T, size-1)
+	solvingSetC := make([]T, size)
+
+	solvingSetA[0] = 2.0 * stepLengthSet[0]
+	solvingSetB[0] = 0.5
+	solvingSetC[0] = alpha[0] / solvingSetA[0]
+
+	for i := 1; i < size-1; i++ {
+		solvingSetA[i] = 2.0*(xValues[i+1]-xValues[i-1]) - stepLengthSet[i-1]*solvingSetB[i-1]
+		solvingSetB[i] = stepLengthSet[i] / solvingSetA[i]
+		solvingSetC[i] = (alpha[i] - stepLengthSet[i-1]*solvingSetC[i-1]) / solvingSetA[i]
+	}
+
+	solvingSetA[size-1] = stepLengthSet[size-2] * (2.0 - solvingSetB[size-2])
+	solvingSetC[size-1] = (alpha[size-1] - stepLengthSet[size-2]*solvingSetC[size-2]) / solvingSetA[size-1]
+
+	var solutionSetA []T
+	solutionSetA = functionValues[:size-1]
+	solutionSetB := make([]T, size-1)
+	solutionSetC := make([]T, size)
+	solutionSetD := make([]T, size-1)
+
+	solutionSetC[size-1] = solvingSetC[size-1]
+
+	sizeSolutionSet := len(solutionSetB)
+
+	for i := sizeSolutionSet - 1; i >= 0; i-- {
+		solutionSetC[i] = solvingSetC[i] - solvingSetB[i]*solutionSetC[i+1]
+		solutionSetB[i] = (functionValues[i+1]-functionValues[i])/stepLengthSet[i] - stepLengthSet[i]*(solutionSetC[i+1]+2.0*solutionSetC[i])/3.0
+		solutionSetD[i] = (solutionSetC[i+1] - solutionSetC[i]) / (3.0 * stepLengthSet[i])
+	}
+
+	solutionSetC = solutionSetC[:size-1]
+
+	solutionTable := [][]T{}
+
+	solutionTable = append(solutionTable, solutionSetA)
+	solutionTable = append(solutionTable, solutionSetB)
+	solutionTable = append(solutionTable, solutionSetC)
+	solutionTable = append(solutionTable, solutionSetD)
+
+	return solutionTable, nil
+}
+
+// NotAKnotCubicSpline is for finding the coefficients solution set of the not-a-knot cubic spline,
+// whose third derivative is continuous at the second and second to last points
+func NotAKnotCubicSpline[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 4, true); err != nil {
+		return nil, err
+	}
+
+	stepLengthSet, alpha := cubicSplineSystem(xValues, functionValues)
+
+	// the end conditions d_0 = d_1 and d_(n-3) = d_(n-2) eliminate c_0 and c_(n-1) from the first and last equations
+	interior := size - 2
+	lower := make([]T, interior)
+	diagonal := make([]T, interior)
+	upper := make([]T, interior)
+	rhs := make([]T, interior)
+
+	for i := 1; i <= interior; i++ {
+		lower[i-1] = stepLengthSet[i-1]
+		diagonal[i-1] = 2.0 * (stepLengthSet[i-1] + stepLengthSet[i])
+		upper[i-1] = stepLengthSet[i]
+		rhs[i-1] = alpha[i]
+	}
+
+	h0, h1 := stepLengthSet[0], stepLengthSet[1]
+	diagonal[0] += h0 * (h0 + h1) / h1
+	upper[0] -= h0 * h0 / h1
+
+	hLast, hPrevious := stepLengthSet[size-2], stepLengthSet[size-3]
+	diagonal[interior-1] += hLast * (hLast + hPrevious) / hPrevious
+	lower[interior-1] -= hLast * hLast / hPrevious
+
+	secondDerivatives := make([]T, size)
+	copy(secondDerivatives[1:], solveTridiagonal(lower, diagonal, upper, rhs))
+	secondDerivatives[0] = ((h0+h1)*secondDerivatives[1] - h0*secondDerivatives[2]) / h1
+	secondDerivatives[size-1] = ((hLast+hPrevious)*secondDerivatives[size-2] - hLast*secondDerivatives[size-3]) / hPrevious
+
+	return cubicSplineTable(functionValues, stepLengthSet, secondDerivatives), nil
+}
+
+// PeriodicCubicSpline is for finding the coefficients solution set of the periodic cubic spline,
+// the first and last function values must be equal and the first and second derivatives match at the ends
+func PeriodicCubicSpline[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 3, true); err != nil {
+		return nil, err
+	}
+
+	if functionValues[0] != functionValues[size-1] {
+		return nil, &ArgumentError{Name: "functionValues", Value: functionValues[size-1], Reason: fmt.Sprintf("must end with the first value %v", functionValues[0])}
+	}
+
+	stepLengthSet, alpha := cubicSplineSystem(xValues, functionValues)
+
+	// c_(n-1) = c_0, so the equations for c_0 to c_(n-2) wrap around
+	periods := size - 1
+	lower := make([]T, periods)
+	diagonal := make([]T, periods)
+	upper := make([]T, periods)
+	rhs := make([]T, periods)
+
+	lower[0] = stepLengthSet[periods-1]
+	diagonal[0] = 2.0 * (stepLengthSet[periods-1] + stepLengthSet[0])
+	upper[0] = stepLengthSet[0]
+	rhs[0] = 3.0*(functionValues[1]-functionValues[0])/stepLengthSet[0] - 3.0*(functionValues[periods]-functionValues[periods-1])/stepLengthSet[periods-1]
+
+	for i := 1; i < periods; i++ {
+		lower[i] = stepLengthSet[i-1]
+		diagonal[i] = 2.0 * (stepLengthSet[i-1] + stepLengthSet[i])
+		upper[i] = stepLengthSet[i]
+		rhs[i] = alpha[i]
+	}
+
+	secondDerivatives := make([]T, size)
+	copy(secondDerivatives, solveCyclicTridiagonal(lower, diagonal, upper, rhs))
+	secondDerivatives[size-1] = secondDerivatives[0]
+
+	return cubicSplineTable(functionValues, stepLengthSet, secondDerivatives), nil
+}
+
+// MonotoneCubicSpline is for finding the coefficients solution set of the fritsch-carlson monotone piecewise cubic
+// hermite interpolant (PCHIP), which does not overshoot the data and keeps it monotone where the data is monotone
+func MonotoneCubicSpline[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	stepLengthSet, slopes := secantSlopes(xValues, functionValues)
+	derivatives := make([]T, size)
+
+	if size == 2 {
+		derivatives[0], derivatives[1] = slopes[0], slopes[0]
+		return hermiteCubicSplineTable(functionValues, stepLengthSet, slopes, derivatives), nil
+	}
+
+	// a weighted harmonic mean of the neighbouring slopes, or a flat derivative at a local extremum
+	for i := 1; i < size-1; i++ {
+		if slopes[i-1]*slopes[i] <= 0 {
+			continue
+		}
+		weightA := 2*stepLengthSet[i] + stepLengthSet[i-1]
+		weightB := stepLengthSet[i] + 2*stepLengthSet[i-1]
+		derivatives[i] = (weightA + weightB) / (weightA/slopes[i-1] + weightB/slopes[i])
+	}
+
+	derivatives[0] = monotoneEndDerivative(stepLengthSet[0], stepLengthSet[1], slopes[0], slopes[1])
+	derivatives[size-1] = monotoneEndDerivative(stepLengthSet[size-2], stepLengthSet[size-3], slopes[size-2], slopes[size-3])
+
+	return hermiteCubicSplineTable(functionValues, stepLengthSet, slopes, derivatives), nil
+}
+
+// AkimaSpline is for finding the coefficients solution set of the akima spline, whose derivatives are weighted
+// by the change of the neighbouring slopes so that outliers only affect nearby intervals
+func AkimaSpline[T Float](xValues []T, functionValues []T) ([][]T, error) {
+	size := len(xValues)
+
+	if err := validateSamples(xValues, functionValues, 2, true); err != nil {
+		return nil, err
+	}
+
+	stepLengthSet, slopes := secantSlopes(xValues, functionValues)
+	derivatives := make([]T, size)
+
+	if size == 2 {
+		derivatives[0], derivatives[1] = slopes[0], slopes[0]
+		return hermiteCubicSplineTable(functionValues, stepLengthSet, slopes, derivatives), nil
+	}
+
+	// two slopes are extrapolated linearly past each end, extended[i+2] is the slope of interval i
+	extended := make([]T, size+3)
+	copy(extended[2:], slopes)
+	extended[1] = 2*extended[2] - extended[3]
+	extended[0] = 2*extended[1] - extended[2]
+	extended[size+1] = 2*extended[size] - extended[size-1]
+	extended[size+2] = 2*extended[size+1] - extended[size]
+
+	for i := 0; i < size; i++ {
+		weightA := T(math.Abs(float64(extended[i+3] - extended[i+2])))
+		weightB := T(math.Abs(float64(extended[i+1] - extended[i])))
+		if weightA+weightB == 0 {
+			derivatives[i] = (extended[i+1] + extended[i+2]) / 2
+		} else {
+			derivatives[i] = (weightA*extended[i+1] + weightB*extended[i+2]) / (weightA + weightB)
+		}
+	}
+
+	return hermiteCubicSplineTable(functionValues, stepLengthSet, slopes, derivatives), nil
+}
+
+// cubicSplineSystem returns the step lengths and the interior right hand sides shared by the cubic splines
+func cubicSplineSystem[T Float](xValues []T, functionValues []T) ([]T, []T) {
+	size := len(xValues)
+	stepLengthSet := make([]T, size-1)
+
+	for i := 0; i < size-1; i++ {
+		stepLengthSet[i] = xValues[i+1] - xValues[i]
+	}
+
+	alpha := make([]T, size)
+
+	for i := 1; i < size-1; i++ {
+		alpha[i] = 3.0*(functionValues[i+1]-functionValues[i])/stepLengthSet[i] - 3.0*(functionValues[i]-functionValues[i-1])/stepLengthSet[i-1]
+	}
+
+	return stepLengthSet, alpha
+}
+
+// cubicSplineTable returns the a, b, c and d rows of a cubic spline from the halved second derivatives c at every point
+func cubicSplineTable[T Float](functionValues []T, stepLengthSet []T, secondDerivatives []T) [][]T {
+	pieces := len(stepLengthSet)
+	solutionTable := [][]T{make([]T, pieces), make([]T, pieces), make([]T, pieces), make([]T, pieces)}
+
+	for i := 0; i < pieces; i++ {
+		solutionTable[0][i] = functionValues[i]
+		solutionTable[1][i] = (functionValues[i+1]-functionValues[i])/stepLengthSet[i] - stepLengthSet[i]*(secondDerivatives[i+1]+2.0*secondDerivatives[i])/3.0
+		solutionTable[2][i] = secondDerivatives[i]
+		solutionTable[3][i] = (secondDerivatives[i+1] - secondDerivatives[i]) / (3.0 * stepLengthSet[i])
+	}
+
+	return solutionTable
+}
+
+// secantSlopes returns the step lengths and the slopes of the secants between consecutive points
+func secantSlopes[T Float](xValues []T, functionValues []T) ([]T, []T) {
+	pieces := len(xValues) - 1
+	stepLengthSet := make([]T, pieces)
+	slopes := make([]T, pieces)
+
+	for i := 0; i < pieces; i++ {
+		stepLengthSet[i] = xValues[i+1] - xValues[i]
+		slopes[i] = (functionValues[i+1] - functionValues[i]) / stepLengthSet[i]
+	}
+
+	return stepLengthSet, slopes
+}
+
+// hermiteCubicSplineTable returns the a, b, c and d rows of the piecewise cubic hermite interpolant with the given derivatives
+func hermiteCubicSplineTable[T Float](functionValues []T, stepLengthSet []T, slopes []T, derivatives []T) [][]T {
+	pieces := len(stepLengthSet)
+	solutionTable := [][]T{make([]T, pieces), make([]T, pieces), make([]T, pieces), make([]T, pieces)}
+
+	for i := 0; i < pieces; i++ {
+		solutionTable[0][i] = functionValues[i]
+		solutionTable[1][i] = derivatives[i]
+		solutionTable[2][i] = (3*slopes[i] - 2*derivatives[i] - derivatives[i+1]) / stepLengthSet[i]
+		solutionTable[3][i] = (derivatives[i] + derivatives[i+1] - 2*slopes[i]) / (stepLengthSet[i] * stepLengthSet[i])
+	}
+
+	return solutionTable
+}
+
+// monotoneEndDerivative returns the shape preserving three point derivative at an end point,
+// h and slope belong to the end interval and hNext and slopeNext to its neighbour
+func monotoneEndDerivative[T Float](h T, hNext T, slope T, slopeNext T) T {
+	derivative := ((2*h+hNext)*slope - h*slopeNext) / (h + hNext)
+
+	if derivative*slope <= 0 {
+		return 0
+	}
+
+	if slope*slopeNext <= 0 && math.Abs(float64(derivative)) > math.Abs(float64(3*slope)) {
+		return 3 * slope
+	}
+
+	return derivative
+}
+
+// solveTridiagonal solves the tridiagonal system with lower[i] and upper[i] next to diagonal[i] in row i
+// by gaussian elimination without pivoting, which is stable for the diagonally dominant spline systems
+func solveTridiagonal[T Float](lower []T, diagonal []T, upper []T, rhs []T) []T {
+	size := len(diagonal)
+	modifiedUpper := make([]T, size)
+	solution := make([]T, size)
+
+	pivot := diagonal[0]
+	solution[0] = rhs[0] / pivot
+	for i := 1; i < size; i++ {
+		modifiedUpper[i-1] = upper[i-1] / pivot
+		pivot = diagonal[i] - lower[i]*modifiedUpper[i-1]
+		solution[i] = (rhs[i] - lower[i]*solution[i-1]) / pivot
+	}
+
+	for i := size - 2; i >= 0; i-- {
+		solution[i] -= modifiedUpper[i] * solution[i+1]
+	}
+
+	return solution
+}
+
+// solveCyclicTridiagonal solves the tridiagonal system whose corners lower[0] at the end of the first row
+// and upper[size-1] at the start of the last row wrap around, using the sherman-morrison formula
+// Algorithm from Numerical Recipes - By Press, Teukolsky, Vetterling and Flannery
+func solveCyclicTridiagonal[T Float](lower []T, diagonal []T, upper []T, rhs []T) []T {
+	size := len(diagonal)
+
+	if size == 2 {
+		// both corners share the positions of the off diagonal entries
+		return solveTridiagonal([]T{0, lower[1] + upper[1]}, diagonal, []T{upper[0] + lower[0], 0}, rhs)
+	}
+
+	corner, otherCorner := upper[size-1], lower[0]
+	gamma := -diagonal[0]
+
+	modifiedDiagonal := append([]T(nil), diagonal...)
+	modifiedDiagonal[0] = diagonal[0] - gamma
+	modifiedDiagonal[size-1] = diagonal[size-1] - corner*otherCorner/gamma
+
+	solution := solveTridiagonal(lower, modifiedDiagonal, upper, rhs)
+
+	correction := make([]T, size)
+	correction[0] = gamma
+	correction[size-1] = corner
+	correctionSolution := solveTridiagonal(lower, modifiedDiagonal, upper, correction)
+
+	factor := (solution[0] + otherCorner*solution[size-1]/gamma) / (1 + correctionSolution[0] + otherCorner*correctionSolution[size-1]/gamma)
+	for i := range solution {
+		solution[i] -= factor * correctionSolution[i]
+	}
+
+	return solution
+}
+
+// BezierCurve is for constructing the cubic bezier curves in parametric form
+func BezierCurve[T Float](endpoints [][2]T, leftGuidepoints [][2]T, rightGuidepoints [][2]T) ([][][4]T, error) {
+	segments, err := bezierSegments(endpoints, leftGuidepoints, rightGuidepoints)
+	if err != nil {
+		return nil, err
+	}
+
+	solutionSetA := make([][4]T, len(segments))
+	solutionSetB := make([][4]T, len(segments))
+
+	for i, segment := range segments {
+		coefficients := segment.PowerCoefficients()
+		copy(solutionSetA[i][:], coefficients[0])
+		copy(solutionSetB[i][:], coefficients[1])
+	}
+
+	solutionTable := make([][][4]T, 2)
+
+	solutionTable[0] = solutionSetA
+	solutionTable[1] = solutionSetB
+
+	return solutionTable, nil
+}
@@ -0,0 +1,548 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSortNodes(t *testing.T) {
+	t.Run("float32", testSortNodes[float32])
+	t.Run("float64", testSortNodes[float64])
+}
+
+func testSortNodes[T Float](t *testing.T) {
+	xValues := []T{2, 0, 3, 1}
+	functionValues := []T{4, 0, 9, 1}
+	dfunctionValues := []T{4, 0, 6, 2}
+
+	if err := SortNodes(xValues, functionValues, dfunctionValues); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if !approxEqualSlice([]float64{0, 1, 2, 3}, xValues) || !approxEqualSlice([]float64{0, 1, 4, 9}, functionValues) || !approxEqualSlice([]float64{0, 2, 4, 6}, dfunctionValues) {
+		t.Errorf("Expected [0 1 2 3], [0 1 4 9] and [0 2 4 6], received %v, %v and %v", xValues, functionValues, dfunctionValues)
+	}
+
+	if err := SortNodes(xValues, functionValues[:3]); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := SortNodes([]T{1, T(math.NaN())}); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestNewtonDividedDifference(t *testing.T) {
+	t.Run("float32", testNewtonDividedDifference[float32])
+	t.Run("float64", testNewtonDividedDifference[float64])
+}
+
+func testNewtonDividedDifference[T Float](t *testing.T) {
+	testTableA, errA := NewtonDividedDifference([]T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	solutionTable := [][]float64{{0.9153827}, {0.4873198, -1.426876333333333}, {0.8960778, 1.3625266666666664, 4.649004999999998}, {0.2769871, -2.063635666666668, -5.710270555555559, -11.51030617283951}, {0.7866039, 1.6987226666666655, 6.2705972222222215, 13.312075308641978, 20.68531790123457}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionTable, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionTable, testTableA)
+	}
+
+	_, errB := NewtonDividedDifference([]T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	// a repeated node would divide by zero
+	_, errC := NewtonDividedDifference([]T{1, 1.3, 1.6, 1.3}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871})
+
+	var nodeErr *NodeError[T]
+	if !errors.As(errC, &nodeErr) || nodeErr.Index != 3 || nodeErr.Previous != 1 || !errors.Is(errC, ErrDuplicateNodes) {
+		t.Errorf("Expected NodeError for xValues[3], received %v", errC)
+	}
+
+	_, errD := NewtonDividedDifference([]T{1, 1.3, 1.6}, []T{0.9153827, T(math.NaN()), 0.8960778})
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+}
+
+func TestNewtonForwardDividedDifference(t *testing.T) {
+	t.Run("float32", testNewtonForwardDividedDifference[float32])
+	t.Run("float64", testNewtonForwardDividedDifference[float64])
+}
+
+func testNewtonForwardDividedDifference[T Float](t *testing.T) {
+	testSetA, errA := NewtonForwardDividedDifference([]T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	solutionSet := []float64{0.9153827, -1.426876333333333, 4.649004999999998, -11.51030617283951, 20.68531790123457}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualSlice(solutionSet, testSetA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetA)
+	}
+
+	_, errB := NewtonForwardDividedDifference([]T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNewtonBackwardsDividedDifference(t *testing.T) {
+	t.Run("float32", testNewtonBackwardsDividedDifference[float32])
+	t.Run("float64", testNewtonBackwardsDividedDifference[float64])
+}
+
+func testNewtonBackwardsDividedDifference[T Float](t *testing.T) {
+	testSetA, errA := NewtonBackwardsDividedDifference([]T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	solutionSet := []float64{0.7866039, 1.6987226666666655, 6.2705972222222215, 13.312075308641978, 20.68531790123457}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualSlice(solutionSet, testSetA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetA)
+	}
+
+	_, errB := NewtonBackwardsDividedDifference([]T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestStrilingCenterDividedDifference(t *testing.T) {
+	t.Run("float32", testStrilingCenterDividedDifference[float32])
+	t.Run("float64", testStrilingCenterDividedDifference[float64])
+}
+
+func testStrilingCenterDividedDifference[T Float](t *testing.T) {
+	testSetA, errA := StirlingCenterDividedDifference([]T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	solutionSet := [][]float64{{0.8960778}, {1.3625266666666664, -2.063635666666668}, {-5.710270555555559}, {-11.51030617283951, 13.312075308641978}, {20.68531790123457}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testSetA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetA)
+	}
+
+	testSetB, errB := StirlingCenterDividedDifference([]T{1, 1.3, 1.6, 1.9, 2.2, 2.5}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039, 0.0837184})
+
+	if errB != nil {
+		t.Errorf("Error %v", errB)
+	}
+
+	if !approxEqualTable(solutionSet, testSetB) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetB)
+	}
+
+	_, errC := StirlingCenterDividedDifference([]T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if errC == nil {
+		t.Error("Expected Error")
+	}
+}
+
+// []T{0.7651977, 0.6200860, 0.4554022, 0.2818186, 0.1103623}
+func TestNevilleIterated(t *testing.T) {
+	t.Run("float32", testNevilleIterated[float32])
+	t.Run("float64", testNevilleIterated[float64])
+}
+
+func testNevilleIterated[T Float](t *testing.T) {
+	testTableA, errA := NevilleIterated(1.5, []T{1, 1.3, 1.6, 1.9, 2.2}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	solutionSet := [][]float64{{0.9153827}, {0.4873198, 0.2019445333333335}, {0.8960778, 0.7598251333333332, 0.6668450333333332}, {0.2769871, 1.102441366666667, 0.8740305444444444, 0.7819480950617284}, {0.7866039, -0.40250196666666593, 1.3532652555555562, 0.9805271469135803, 0.8646893666666667}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	_, errB := NevilleIterated(1.5, []T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestBulirschStoerRational(t *testing.T) {
+	t.Run("float32", testBulirschStoerRational[float32])
+	t.Run("float64", testBulirschStoerRational[float64])
+}
+
+func testBulirschStoerRational[T Float](t *testing.T) {
+	// 1 / (x - 1.05) is reproduced by every rational interpolant through two or more points
+	xValues := []T{0, 0.25, 0.5, 0.75, 1}
+	functionValues := make([]T, len(xValues))
+	for i, x := range xValues {
+		functionValues[i] = 1 / (x - 1.05)
+	}
+
+	testTableA, errA := BulirschStoerRational(0.9, xValues, functionValues)
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	for i := range testTableA {
+		if !approxEqual(float64(functionValues[i]), testTableA[i][0], 1) {
+			t.Errorf("Expected %v, received %v", functionValues[i], testTableA[i][0])
+		}
+
+		for j := 1; j <= i; j++ {
+			if !approxEqual(-1/0.15, testTableA[i][j], 1000) {
+				t.Errorf("Expected %v at %v %v, received %v", -1/0.15, i, j, testTableA[i][j])
+			}
+		}
+	}
+
+	testTableB, _ := BulirschStoerRational(0.5, xValues, functionValues)
+
+	if !approxEqual(float64(functionValues[2]), testTableB[4][4], 10) {
+		t.Errorf("Expected %v, received %v", functionValues[2], testTableB[4][4])
+	}
+
+	_, errC := BulirschStoerRational(0.9, []T{1, 1.3, 1.6, 1.9}, []T{0.9153827, 0.4873198, 0.8960778, 0.2769871, 0.7866039})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestHermite(t *testing.T) {
+	t.Run("float32", testHermite[float32])
+	t.Run("float64", testHermite[float64])
+}
+
+func testHermite[T Float](t *testing.T) {
+	testSetA, errA := Hermite([]T{1.3, 1.6, 1.9}, []T{0.4873198, 0.8960778, 0.2769871}, []T{-0.0293884, 1.3455501, -0.741541})
+
+	solutionSet := []float64{0.4873198, -0.0293884, 4.639716888888888, -15.65435148148147, -5.318758641975368, 207.24067901234616}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualSlice(solutionSet, testSetA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetA)
+	}
+
+	_, errB := Hermite([]T{1.3, 1.6}, []T{0.4873198, 0.8960778, 0.2769871}, []T{-0.0293884, 1.3455501, -0.741541})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := Hermite([]T{1.3, 1.6, 1.9}, []T{0.4873198, 0.8960778, 0.2769871}, []T{-0.0293884, 1.3455501})
+
+	if errC == nil {
+		t.Error("Expected Error")
+	}
+}
+
+func TestNaturalCubicSpline(t *testing.T) {
+	t.Run("float32", testNaturalCubicSpline[float32])
+	t.Run("float64", testNaturalCubicSpline[float64])
+}
+
+func testNaturalCubicSpline[T Float](t *testing.T) {
+	testTableA, errA := NaturalCubicSpline([]T{0, 1, 2, 3, 4}, []T{1, T(math.Exp(1)), T(math.Exp(2)), T(math.Exp(3)), T(math.Exp(4))})
+
+	solutionSet := [][]float64{{1, 2.718281828459045, 7.38905609893065, 20.085536923187668}, {1.1111266016600037, 2.9325922820571275, 6.325672566903437, 23.86648273451499}, {0, 1.8214656803971239, 1.571614604449186, 15.969195563162367}, {0.6071552267990413, -0.08328369198264592, 4.7991936529043935, -5.323065187720789}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	_, errB := NaturalCubicSpline([]T{0, 1, 2, 3}, []T{1, T(math.Exp(1)), T(math.Exp(2)), T(math.Exp(3)), T(math.Exp(4))})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	// splines need increasing nodes, SortNodes puts them in order
+	xValues, functionValues := []T{2, 0, 3, 1}, []T{4, 0, 9, 1}
+	_, errC := NaturalCubicSpline(xValues, functionValues)
+
+	if !errors.Is(errC, ErrUnsortedNodes) {
+		t.Errorf("Expected %v, received %v", ErrUnsortedNodes, errC)
+	}
+
+	if err := SortNodes(xValues, functionValues); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if _, err := NaturalCubicSpline(xValues, functionValues); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, errD := NaturalCubicSpline([]T{0, 1, 1, 2}, []T{0, 1, 1, 4})
+
+	if !errors.Is(errD, ErrDuplicateNodes) {
+		t.Errorf("Expected %v, received %v", ErrDuplicateNodes, errD)
+	}
+
+	_, errE := NaturalCubicSpline([]T{0}, []T{0})
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+}
+
+func TestClampedCubicSpline(t *testing.T) {
+	t.Run("float32", testClampedCubicSpline[float32])
+	t.Run("float64", testClampedCubicSpline[float64])
+}
+
+func testClampedCubicSpline[T Float](t *testing.T) {
+	testTableA, errA := ClampedCubicSpline([]T{0, 1, 2, 3, 4}, []T{1, T(math.Exp(1)), T(math.Exp(2)), T(math.Exp(3)), T(math.Exp(4))}, 1, T(math.Exp(4)))
+
+	solutionSet := [][]float64{{1, 2.718281828459045, 7.38905609893065, 20.085536923187668}, {0.9999999999999999, 2.698742769368434, 7.372197219318214, 19.914233637544577}, {0.4561027160087011, 1.2426400533597335, 3.430814396590047, 9.111222021636316}, {0.26217911245034414, 0.7293914477434379, 1.8934692083487563, 5.487157450775675}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	_, errB := ClampedCubicSpline([]T{0, 1, 2, 3}, []T{1, T(math.Exp(1)), T(math.Exp(2)), T(math.Exp(3)), T(math.Exp(4))}, 1, T(math.Exp(4)))
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestNotAKnotCubicSpline(t *testing.T) {
+	t.Run("float32", testNotAKnotCubicSpline[float32])
+	t.Run("float64", testNotAKnotCubicSpline[float64])
+}
+
+func testNotAKnotCubicSpline[T Float](t *testing.T) {
+	// the not-a-knot spline reproduces x^3 - 2x + 1, so each piece holds its taylor coefficients
+	testTableA, errA := NotAKnotCubicSpline([]T{0, 1, 2.5, 3, 4}, []T{1, 0, 11.625, 22, 57})
+
+	solutionSet := [][]float64{{1, 0, 11.625, 22}, {-2, 1, 16.75, 25}, {0, 3, 7.5, 9}, {1, 1, 1, 1}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	_, errB := NotAKnotCubicSpline([]T{0, 1, 2}, []T{1, 0, 5})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NotAKnotCubicSpline([]T{0, 1, 2, 3}, []T{1, 0, 5})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestPeriodicCubicSpline(t *testing.T) {
+	t.Run("float32", testPeriodicCubicSpline[float32])
+	t.Run("float64", testPeriodicCubicSpline[float64])
+}
+
+func testPeriodicCubicSpline[T Float](t *testing.T) {
+	for _, xValues := range [][]T{{0, 0.25, 0.5, 0.75, 1}, {0, 0.5, 1}, {0, 0.1, 0.4, 0.5, 0.8, 1}} {
+		functionValues := make([]T, len(xValues))
+		for i, x := range xValues {
+			functionValues[i] = T(math.Sin(2 * math.Pi * float64(x)))
+		}
+		functionValues[len(xValues)-1] = functionValues[0]
+
+		testTable, err := PeriodicCubicSpline(xValues, functionValues)
+
+		if err != nil {
+			t.Fatalf("Unexpected error %v", err)
+		}
+
+		// the first and second derivatives at the end of the last piece match those at the start of the first piece
+		last := len(xValues) - 2
+		h := float64(xValues[last+1] - xValues[last])
+		b, c, d := float64(testTable[1][last]), float64(testTable[2][last]), float64(testTable[3][last])
+
+		if slope := b + 2*c*h + 3*d*h*h; !approxEqual(slope, testTable[1][0], 100) {
+			t.Errorf("Expected periodic slope %v, received %v for %v", slope, testTable[1][0], xValues)
+		}
+
+		if curvature := c + 3*d*h; !approxEqual(curvature, testTable[2][0], 100) {
+			t.Errorf("Expected periodic curvature %v, received %v for %v", curvature, testTable[2][0], xValues)
+		}
+	}
+
+	_, errB := PeriodicCubicSpline([]T{0, 0.5, 1}, []T{0, 1, 0.5})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := PeriodicCubicSpline([]T{0, 1}, []T{0, 0})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestMonotoneCubicSpline(t *testing.T) {
+	t.Run("float32", testMonotoneCubicSpline[float32])
+	t.Run("float64", testMonotoneCubicSpline[float64])
+}
+
+func testMonotoneCubicSpline[T Float](t *testing.T) {
+	testTableA, errA := MonotoneCubicSpline([]T{0, 1, 2}, []T{0, 1, 3})
+
+	solutionSet := [][]float64{{0, 1}, {0.5, 4.0 / 3}, {2.0 / 3, 5.0 / 6}, {-1.0 / 6, -1.0 / 6}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	// a step in the data does not make the curve overshoot or lose monotonicity
+	xValues := []T{0, 1, 2, 3, 4, 5}
+	functionValues := []T{0, 0, 0.1, 0.9, 1, 1}
+	testTableB, errB := MonotoneCubicSpline(xValues, functionValues)
+
+	if errB != nil {
+		t.Errorf("Error %v", errB)
+	}
+
+	previous := T(0)
+	for i := 0; i < len(xValues)-1; i++ {
+		for s := T(0); s <= 1; s += 0.125 {
+			value := testTableB[0][i] + s*(testTableB[1][i]+s*(testTableB[2][i]+s*testTableB[3][i]))
+			if value < previous-T(testTolerance[T]()) || value > 1+T(testTolerance[T]()) {
+				t.Errorf("Expected a monotone curve in [0, 1], received %v after %v at %v", value, previous, xValues[i]+s)
+			}
+			previous = value
+		}
+	}
+
+	testTableC, _ := MonotoneCubicSpline([]T{0, 2}, []T{1, 5})
+
+	if !approxEqualTable([][]float64{{1}, {2}, {0}, {0}}, testTableC) {
+		t.Errorf("Expected a line, received %v", testTableC)
+	}
+
+	_, errD := MonotoneCubicSpline([]T{0}, []T{0})
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+}
+
+func TestAkimaSpline(t *testing.T) {
+	t.Run("float32", testAkimaSpline[float32])
+	t.Run("float64", testAkimaSpline[float64])
+}
+
+func testAkimaSpline[T Float](t *testing.T) {
+	// with linearly extrapolated end slopes the akima spline reproduces the parabola 1.5x - 0.5x^2
+	testTableA, errA := AkimaSpline([]T{0, 1, 2, 3}, []T{0, 1, 1, 0})
+
+	solutionSet := [][]float64{{0, 1, 1}, {1.5, 0.5, -0.5}, {-0.5, -0.5, -0.5}, {0, 0, 0}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualTable(solutionSet, testTableA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testTableA)
+	}
+
+	// an outlier only changes the pieces next to it
+	testTableB, errB := AkimaSpline([]T{0, 1, 2, 3, 4, 5, 6, 7}, []T{0, 0, 0, 0, 1, 0, 0, 0})
+
+	if errB != nil {
+		t.Errorf("Error %v", errB)
+	}
+
+	for _, piece := range []int{0, 1, 6} {
+		for row := 0; row < 4; row++ {
+			if testTableB[row][piece] != 0 {
+				t.Errorf("Expected piece %d to be flat, received %v", piece, testTableB[row][piece])
+			}
+		}
+	}
+
+	_, errC := AkimaSpline([]T{0, 1, 2}, []T{0, 1})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestSolveCyclicTridiagonal(t *testing.T) {
+	// [[4 1 2] [1 4 1] [3 1 4]] x = [7 6 8] has the solution [1 1 1]
+	solution := solveCyclicTridiagonal([]float64{2, 1, 1}, []float64{4, 4, 4}, []float64{1, 1, 3}, []float64{7, 6, 8})
+
+	if !approxEqualSlice([]float64{1, 1, 1}, solution) {
+		t.Errorf("Expected %v, received %v", []float64{1, 1, 1}, solution)
+	}
+}
+
+func TestBezierCurve(t *testing.T) {
+	t.Run("float32", testBezierCurve[float32])
+	t.Run("float64", testBezierCurve[float64])
+}
+
+func testBezierCurve[T Float](t *testing.T) {
+	testSetA, errA := BezierCurve([][2]T{{0, 0}, {1, 0}}, [][2]T{{2, 1}}, [][2]T{{0, 1}})
+
+	solutionSet := [][][4]T{{{0, 6, -12, 7}}, {{0, 3, -3, 0}}}
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !reflect.DeepEqual(solutionSet, testSetA) {
+		t.Errorf("Expected %v, received %v", solutionSet, testSetA)
+	}
+
+	_, errB := BezierCurve([][2]T{{0, 0}, {1, 0}}, [][2]T{{2, 1}, {4, 1}}, [][2]T{{0, 1}, {0, 4}})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := BezierCurve([][2]T{{0, 0}, {1, 0}}, [][2]T{{2, 1}}, [][2]T{{0, 1}, {0, 4}})
+
+	if errC == nil {
+		t.Error("Expected Error")
+	}
+}
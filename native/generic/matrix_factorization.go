@@ -0,0 +1,344 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+)
+
+// blockSize is the number of columns eliminated together by the blocked factorizations, the rows of a block that
+// update the trailing matrix are kept this wide so that they stay in cache
+const blockSize = 64
+
+// LU returns the L U factorization P A = L U of the n x n row-major matrix a with partial pivoting, where L is unit
+// lower triangular, U is upper triangular and row i of P A is row P[i] of a, a is not modified,
+// the elimination is blocked so that the trailing matrix is updated by blocks of rows kept in cache
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func LU[T Float](a []T, n int) (L []T, U []T, P []int, err error) {
+	if err := validateDense("a", a, n); err != nil {
+		return nil, nil, nil, err
+	}
+
+	lu := append([]T(nil), a...)
+	P = make([]int, n)
+	for i := range P {
+		P[i] = i
+	}
+
+	for start := 0; start < n; start += blockSize {
+		end := blockEnd(start, n)
+
+		// the panel of columns start to end is eliminated swapping whole rows,
+		// so that each interchange applies to the columns on the left and the right of the panel alike
+		for k := start; k < end; k++ {
+			pivot := k
+			for i := k + 1; i < n; i++ {
+				if math.Abs(float64(lu[i*n+k])) > math.Abs(float64(lu[pivot*n+k])) {
+					pivot = i
+				}
+			}
+
+			if lu[pivot*n+k] == 0 {
+				return nil, nil, nil, &SingularError{Pivot: k}
+			}
+
+			if pivot != k {
+				swapRows(lu, n, k, pivot)
+				P[k], P[pivot] = P[pivot], P[k]
+			}
+
+			rowK := lu[k*n+k+1 : k*n+end]
+			for i := k + 1; i < n; i++ {
+				lu[i*n+k] /= lu[k*n+k]
+				subtractScaled(lu[i*n+k+1:i*n+end], lu[i*n+k], rowK)
+			}
+		}
+
+		// the block row of U right of the panel solves L11 U12 = A12
+		for k := start; k < end; k++ {
+			rowK := lu[k*n+end : k*n+n]
+			for i := k + 1; i < end; i++ {
+				subtractScaled(lu[i*n+end:i*n+n], lu[i*n+k], rowK)
+			}
+		}
+
+		// the trailing matrix is updated with A22 - L21 U12 one block of columns at a time
+		for column := end; column < n; column += blockSize {
+			last := blockEnd(column, n)
+			for i := end; i < n; i++ {
+				rowI := lu[i*n+column : i*n+last]
+				for k := start; k < end; k++ {
+					subtractScaled(rowI, lu[i*n+k], lu[k*n+column:k*n+last])
+				}
+			}
+		}
+	}
+
+	L, U = make([]T, n*n), make([]T, n*n)
+	for i := 0; i < n; i++ {
+		copy(L[i*n:i*n+i], lu[i*n:i*n+i])
+		L[i*n+i] = 1
+		copy(U[i*n+i:i*n+n], lu[i*n+i:i*n+n])
+	}
+
+	return L, U, P, nil
+}
+
+// LDLt returns the unit lower triangular L and the diagonal D of the L D L^T factorization of the n x n row-major
+// matrix a if it is symmetric positive-definite else error, a is not modified
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func LDLt[T Float](a []T, n int) (L []T, D []T, err error) {
+	l, D, err := symmetricFactorization(a, n, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return lowerTriangle(l, n, true), D, nil
+}
+
+// Cholesky returns the lower triangular L of the L L^T factorization of the n x n row-major matrix a
+// if it is symmetric positive-definite else error, a is not modified
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func Cholesky[T Float](a []T, n int) (L []T, err error) {
+	l, _, err := symmetricFactorization(a, n, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return lowerTriangle(l, n, false), nil
+}
+
+// ForwardSubstitution solves L y = b for the n x n row-major lower triangular matrix L, the entries above the diagonal are not read
+func ForwardSubstitution[T Float](L []T, n int, b []T) ([]T, error) {
+	if err := validateTriangularSystem("L", L, n, "b", b); err != nil {
+		return nil, err
+	}
+
+	y := make([]T, n)
+	for i := 0; i < n; i++ {
+		if L[i*n+i] == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+
+		sum := b[i]
+		for j, value := range L[i*n : i*n+i] {
+			sum -= value * y[j]
+		}
+		y[i] = sum / L[i*n+i]
+	}
+
+	return y, nil
+}
+
+// BackwardSubstitution solves U x = y for the n x n row-major upper triangular matrix U, the entries below the diagonal are not read
+func BackwardSubstitution[T Float](U []T, n int, y []T) ([]T, error) {
+	if err := validateTriangularSystem("U", U, n, "y", y); err != nil {
+		return nil, err
+	}
+
+	x := make([]T, n)
+	for i := n - 1; i >= 0; i-- {
+		if U[i*n+i] == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+
+		sum := y[i]
+		for j, value := range U[i*n+i+1 : i*n+n] {
+			sum -= value * x[i+1+j]
+		}
+		x[i] = sum / U[i*n+i]
+	}
+
+	return x, nil
+}
+
+// SolveLU solves A x = b given the factorization P A = L U returned by LU, so that a factorization
+// can be reused for any number of right hand sides
+func SolveLU[T Float](L []T, U []T, P []int, n int, b []T) ([]T, error) {
+	if err := validateValues("b", b, n); err != nil {
+		return nil, err
+	}
+
+	if len(P) != n {
+		return nil, &DimensionError{Name: "P", Expected: n, Received: len(P)}
+	}
+
+	permuted := make([]T, n)
+	for i, row := range P {
+		if row < 0 || row >= n {
+			return nil, &ArgumentError{Name: fmt.Sprintf("P[%d]", i), Value: row, Reason: fmt.Sprintf("must be a row of the %d x %d matrix", n, n)}
+		}
+		permuted[i] = b[row]
+	}
+
+	y, err := ForwardSubstitution(L, n, permuted)
+	if err != nil {
+		return nil, err
+	}
+
+	return BackwardSubstitution(U, n, y)
+}
+
+// SolveLDLt solves A x = b given the factorization A = L D L^T returned by LDLt
+func SolveLDLt[T Float](L []T, D []T, n int, b []T) ([]T, error) {
+	if err := validateValues("D", D, n); err != nil {
+		return nil, err
+	}
+
+	y, err := ForwardSubstitution(L, n, b)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, pivot := range D {
+		if pivot == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+		y[i] /= pivot
+	}
+
+	return transposedSubstitution(L, n, y)
+}
+
+// SolveCholesky solves A x = b given the factorization A = L L^T returned by Cholesky
+func SolveCholesky[T Float](L []T, n int, b []T) ([]T, error) {
+	y, err := ForwardSubstitution(L, n, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return transposedSubstitution(L, n, y)
+}
+
+// symmetricFactorization returns a copy of the symmetric matrix a with its lower triangle overwritten by the
+// L D L^T factorization, or by L L^T if root is set, and the pivots D, only the lower triangle of the copy is read,
+// the elimination is blocked like LU with the columns of the panel kept transposed for the trailing update
+func symmetricFactorization[T Float](a []T, n int, root bool) (l []T, D []T, err error) {
+	if err := validateSymmetric(a, n); err != nil {
+		return nil, nil, err
+	}
+
+	l = append([]T(nil), a...)
+	D = make([]T, n)
+	// panel holds the columns L D of the panel, or L for a cholesky factorization, as rows
+	panel := make([]T, minInt(blockSize, n)*n)
+
+	for start := 0; start < n; start += blockSize {
+		end := blockEnd(start, n)
+
+		for k := start; k < end; k++ {
+			pivot := l[k*n+k]
+			if err := validateDefinitePivot(pivot, k); err != nil {
+				return nil, nil, err
+			}
+			D[k] = pivot
+
+			diagonal, weight := pivot, pivot
+			if root {
+				diagonal, weight = T(math.Sqrt(float64(pivot))), 1
+				l[k*n+k] = diagonal
+			}
+
+			products := panel[(k-start)*n : (k-start+1)*n]
+			for i := k + 1; i < n; i++ {
+				l[i*n+k] /= diagonal
+				products[i] = l[i*n+k] * weight
+				// within the panel only the columns up to the diagonal of row i are updated
+				subtractScaled(l[i*n+k+1:i*n+minInt(i+1, end)], l[i*n+k], products[k+1:minInt(i+1, end)])
+			}
+		}
+
+		for column := end; column < n; column += blockSize {
+			last := blockEnd(column, n)
+			for i := column; i < n; i++ {
+				upper := minInt(i+1, last)
+				rowI := l[i*n+column : i*n+upper]
+				for k := start; k < end; k++ {
+					subtractScaled(rowI, l[i*n+k], panel[(k-start)*n+column:(k-start)*n+upper])
+				}
+			}
+		}
+	}
+
+	return l, D, nil
+}
+
+// transposedSubstitution solves L^T x = y for the n x n row-major lower triangular matrix L by columns of L^T,
+// so that the rows of L are read contiguously, y is overwritten
+func transposedSubstitution[T Float](L []T, n int, y []T) ([]T, error) {
+	for i := n - 1; i >= 0; i-- {
+		if L[i*n+i] == 0 {
+			return nil, &SingularError{Pivot: i}
+		}
+
+		y[i] /= L[i*n+i]
+		subtractScaled(y[:i], y[i], L[i*n:i*n+i])
+	}
+
+	return y, nil
+}
+
+// validateTriangularSystem checks the n x n row-major matrix and that the right hand side has n entries
+func validateTriangularSystem[T Float](matrixName string, matrix []T, n int, vectorName string, vector []T) error {
+	if err := validateDense(matrixName, matrix, n); err != nil {
+		return err
+	}
+
+	return validateValues(vectorName, vector, n)
+}
+
+// validateDefinitePivot checks that the pivot of a symmetric factorization is positive, a zero pivot is singular
+func validateDefinitePivot[T Float](pivot T, k int) error {
+	if pivot == 0 {
+		return &SingularError{Pivot: k}
+	}
+
+	if !(pivot > 0) {
+		return &DefiniteError[T]{Pivot: k, Value: pivot}
+	}
+
+	return nil
+}
+
+// lowerTriangle returns the lower triangle of the n x n row-major matrix l, with a unit diagonal if unit is set
+func lowerTriangle[T Float](l []T, n int, unit bool) []T {
+	lower := make([]T, n*n)
+	for i := 0; i < n; i++ {
+		copy(lower[i*n:i*n+i+1], l[i*n:i*n+i+1])
+		if unit {
+			lower[i*n+i] = 1
+		}
+	}
+
+	return lower
+}
+
+// subtractScaled sets row to row - factor x, the innermost loop of the factorizations
+func subtractScaled[T Float](row []T, factor T, x []T) {
+	if factor == 0 {
+		return
+	}
+
+	for j, value := range x[:len(row)] {
+		row[j] -= factor * value
+	}
+}
+
+// swapRows interchanges the rows i and j of the row-major matrix a with n columns
+func swapRows[T Float](a []T, n int, i int, j int) {
+	for k := 0; k < n; k++ {
+		a[i*n+k], a[j*n+k] = a[j*n+k], a[i*n+k]
+	}
+}
+
+// blockEnd returns the end of the block starting at start, which is at most limit
+func blockEnd(start int, limit int) int {
+	return minInt(start+blockSize, limit)
+}
+
+// minInt returns the lesser of a and b
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,321 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// testMatrix returns an n x n row-major matrix with entries spread over [-1, 1] and a dominant diagonal,
+// which is symmetric positive-definite if symmetric is set, n above blockSize spans several blocks
+func testMatrix[T Float](n int, symmetric bool) []T {
+	a := make([]T, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if symmetric {
+				a[i*n+j] = T(math.Cos(float64(i + j + i*j)))
+			} else {
+				a[i*n+j] = T(math.Sin(float64(i*n + j + 1)))
+			}
+		}
+		a[i*n+i] += T(n)
+	}
+	return a
+}
+
+// denseProduct returns the product of the n x n row-major matrices a and b, and of b^T if transpose is set, in float64
+func denseProduct[T Float](a []T, b []T, n int, transpose bool) []float64 {
+	product := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				if transpose {
+					product[i*n+j] += float64(a[i*n+k]) * float64(b[j*n+k])
+				} else {
+					product[i*n+j] += float64(a[i*n+k]) * float64(b[k*n+j])
+				}
+			}
+		}
+	}
+	return product
+}
+
+// factorizationResidual returns the largest difference of expected and received relative to the largest entry of expected
+func factorizationResidual[T Float](expected []T, received []float64) float64 {
+	var difference, scale float64
+	for i := range expected {
+		difference = math.Max(difference, math.Abs(float64(expected[i])-received[i]))
+		scale = math.Max(scale, math.Abs(float64(expected[i])))
+	}
+	return difference / scale
+}
+
+// denseOnes returns the right hand side a (1, ..., 1) of the n x n row-major matrix a
+func denseOnes[T Float](a []T, n int) []T {
+	b := make([]T, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			b[i] += a[i*n+j]
+		}
+	}
+	return b
+}
+
+// onesSolution returns the expected solution (1, ..., 1) of size n
+func onesSolution(n int) []float64 {
+	ones := make([]float64, n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	return ones
+}
+
+func TestLU(t *testing.T) {
+	t.Run("float32", testLU[float32])
+	t.Run("float64", testLU[float64])
+}
+
+func testLU[T Float](t *testing.T) {
+	// the first pivot is zero without row exchanges
+	L, U, P, errA := LU([]T{0, 2, 1, 1, 1, 1, 2, 1, 3}, 3)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if P[0] != 2 || P[1] != 0 || P[2] != 1 {
+		t.Errorf("Expected [2 0 1], received %v", P)
+	}
+
+	if !approxEqualSlice([]float64{1, 0, 0, 0, 1, 0, 0.5, 0.25, 1}, L) {
+		t.Errorf("Expected [1 0 0 0 1 0 0.5 0.25 1], received %v", L)
+	}
+
+	if !approxEqualSlice([]float64{2, 1, 3, 0, 2, 1, 0, 0, -0.75}, U) {
+		t.Errorf("Expected [2 1 3 0 2 1 0 0 -0.75], received %v", U)
+	}
+
+	if x, err := SolveLU(L, U, P, 3, []T{7, 6, 13}); err != nil || !approxEqualSlice([]float64{1, 2, 3}, x) {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	for _, n := range []int{blockSize - 1, 2*blockSize + 7} {
+		a := testMatrix[T](n, false)
+		L, U, P, err := LU(a, n)
+
+		if err != nil {
+			t.Fatalf("%d: unexpected error %v", n, err)
+		}
+
+		permuted := make([]T, n*n)
+		for i, row := range P {
+			copy(permuted[i*n:(i+1)*n], a[row*n:(row+1)*n])
+		}
+
+		if residual := factorizationResidual(permuted, denseProduct(L, U, n, false)); residual > 100*testTolerance[T]() {
+			t.Errorf("%d: expected P A = L U, received a residual of %v", n, residual)
+		}
+
+		for i := 0; i < n; i++ {
+			if L[i*n+i] != 1 || U[i*n+i] == 0 {
+				t.Errorf("%d: expected a unit L and nonzero pivots, received %v and %v at %d", n, L[i*n+i], U[i*n+i], i)
+				break
+			}
+		}
+
+		if x, err := SolveLU(L, U, P, n, denseOnes(a, n)); err != nil || maxNormDiffFloat(onesSolution(n), x) > 100*testTolerance[T]() {
+			t.Errorf("%d: expected ones, received %v and %v", n, x, err)
+		}
+	}
+
+	_, _, _, errB := LU([]T{1, 2, 2, 4}, 2)
+
+	var singularErr *SingularError
+	if !errors.As(errB, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected a SingularError at pivot 1, received %v", errB)
+	}
+
+	_, _, _, errC := LU([]T{1, 2, 3}, 2)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, errD := SolveLU(L, U, []int{0, 1, 3}, 3, []T{7, 6, 13})
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+}
+
+func TestLDLt(t *testing.T) {
+	t.Run("float32", testLDLt[float32])
+	t.Run("float64", testLDLt[float64])
+}
+
+func testLDLt[T Float](t *testing.T) {
+	// example from Numerical Analysis - By Burden and Faires
+	L, D, errA := LDLt([]T{4, -1, 1, -1, 4.25, 2.75, 1, 2.75, 3.5}, 3)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{1, 0, 0, -0.25, 1, 0, 0.25, 0.75, 1}, L) {
+		t.Errorf("Expected [1 0 0 -0.25 1 0 0.25 0.75 1], received %v", L)
+	}
+
+	if !approxEqualSlice([]float64{4, 4, 1}, D) {
+		t.Errorf("Expected [4 4 1], received %v", D)
+	}
+
+	for _, n := range []int{blockSize - 1, 2*blockSize + 7} {
+		a := testMatrix[T](n, true)
+		L, D, err := LDLt(a, n)
+
+		if err != nil {
+			t.Fatalf("%d: unexpected error %v", n, err)
+		}
+
+		scaled := make([]T, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j <= i; j++ {
+				scaled[i*n+j] = L[i*n+j] * D[j]
+			}
+		}
+
+		if residual := factorizationResidual(a, denseProduct(scaled, L, n, true)); residual > 100*testTolerance[T]() {
+			t.Errorf("%d: expected A = L D L^T, received a residual of %v", n, residual)
+		}
+
+		if x, err := SolveLDLt(L, D, n, denseOnes(a, n)); err != nil || maxNormDiffFloat(onesSolution(n), x) > 100*testTolerance[T]() {
+			t.Errorf("%d: expected ones, received %v and %v", n, x, err)
+		}
+	}
+
+	_, _, errB := LDLt([]T{1, 2, 2, 1}, 2)
+
+	var definiteErr *DefiniteError[T]
+	if !errors.As(errB, &definiteErr) || definiteErr.Pivot != 1 || definiteErr.Value != -3 {
+		t.Errorf("Expected a DefiniteError of -3 at pivot 1, received %v", errB)
+	}
+
+	_, _, errC := LDLt([]T{1, 2, 3, 1}, 2)
+
+	if !errors.Is(errC, ErrNotSymmetric) {
+		t.Errorf("Expected %v, received %v", ErrNotSymmetric, errC)
+	}
+
+	_, _, errD := LDLt([]T{0, 1, 1, 0}, 2)
+
+	if !errors.Is(errD, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errD)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	t.Run("float32", testCholesky[float32])
+	t.Run("float64", testCholesky[float64])
+}
+
+func testCholesky[T Float](t *testing.T) {
+	L, errA := Cholesky([]T{4, -1, 1, -1, 4.25, 2.75, 1, 2.75, 3.5}, 3)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{2, 0, 0, -0.5, 2, 0, 0.5, 1.5, 1}, L) {
+		t.Errorf("Expected [2 0 0 -0.5 2 0 0.5 1.5 1], received %v", L)
+	}
+
+	for _, n := range []int{blockSize - 1, 2*blockSize + 7} {
+		a := testMatrix[T](n, true)
+		L, err := Cholesky(a, n)
+
+		if err != nil {
+			t.Fatalf("%d: unexpected error %v", n, err)
+		}
+
+		if residual := factorizationResidual(a, denseProduct(L, L, n, true)); residual > 100*testTolerance[T]() {
+			t.Errorf("%d: expected A = L L^T, received a residual of %v", n, residual)
+		}
+
+		if x, err := SolveCholesky(L, n, denseOnes(a, n)); err != nil || maxNormDiffFloat(onesSolution(n), x) > 100*testTolerance[T]() {
+			t.Errorf("%d: expected ones, received %v and %v", n, x, err)
+		}
+	}
+
+	_, errB := Cholesky([]T{1, 2, 2, 1}, 2)
+
+	if !errors.Is(errB, ErrNotPositiveDefinite) {
+		t.Errorf("Expected %v, received %v", ErrNotPositiveDefinite, errB)
+	}
+
+	_, errC := Cholesky([]T{1, 2, 3}, 2)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestForwardSubstitution(t *testing.T) {
+	t.Run("float32", testForwardSubstitution[float32])
+	t.Run("float64", testForwardSubstitution[float64])
+}
+
+func testForwardSubstitution[T Float](t *testing.T) {
+	// the entries above the diagonal are not read
+	if y, err := ForwardSubstitution([]T{2, 9, 9, 1, 1, 9, -1, 2, 4}, 3, []T{2, 3, 13}); err != nil || !approxEqualSlice([]float64{1, 2, 2.5}, y) {
+		t.Errorf("Expected [1 2 2.5], received %v and %v", y, err)
+	}
+
+	_, errA := ForwardSubstitution([]T{1, 0, 1, 0}, 2, []T{1, 1})
+
+	var singularErr *SingularError
+	if !errors.As(errA, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected a SingularError at pivot 1, received %v", errA)
+	}
+
+	_, errB := ForwardSubstitution([]T{1, 0, 1, 1}, 2, []T{1})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestBackwardSubstitution(t *testing.T) {
+	t.Run("float32", testBackwardSubstitution[float32])
+	t.Run("float64", testBackwardSubstitution[float64])
+}
+
+func testBackwardSubstitution[T Float](t *testing.T) {
+	// the entries below the diagonal are not read
+	if x, err := BackwardSubstitution([]T{2, 1, -1, 9, 1, 2, 9, 9, 4}, 3, []T{1, 8, 12}); err != nil || !approxEqualSlice([]float64{1, 2, 3}, x) {
+		t.Errorf("Expected [1 2 3], received %v and %v", x, err)
+	}
+
+	_, errA := BackwardSubstitution([]T{0, 1, 0, 1}, 2, []T{1, 1})
+
+	var singularErr *SingularError
+	if !errors.As(errA, &singularErr) || singularErr.Pivot != 0 {
+		t.Errorf("Expected a SingularError at pivot 0, received %v", errA)
+	}
+
+	_, errB := BackwardSubstitution([]T{1, 1, 0, 1}, 0, []T{})
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+// maxNormDiffFloat returns the infinity norm of x - y for a float64 reference x
+func maxNormDiffFloat[T Float](x []float64, y []T) float64 {
+	norm := float64(0)
+	for i := range x {
+		if diff := math.Abs(x[i] - float64(y[i])); diff > norm || math.IsNaN(diff) {
+			norm = diff
+		}
+	}
+	return norm
+}
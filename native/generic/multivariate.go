@@ -0,0 +1,526 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// RadialBasis selects the kernel of a radial basis function interpolant
+type RadialBasis int
+
+const (
+	// GaussianBasis is the kernel exp(-(epsilon r)^2)
+	GaussianBasis RadialBasis = iota
+	// MultiquadricBasis is the kernel sqrt(1 + (epsilon r)^2)
+	MultiquadricBasis
+	// ThinPlateBasis is the kernel r^2 log(r), it does not use the shape parameter
+	ThinPlateBasis
+)
+
+// BilinearInterpolant interpolates values on a rectilinear grid with a bilinear polynomial in each cell
+type BilinearInterpolant[T Float] struct {
+	xValues []T
+	yValues []T
+	values  [][]T
+}
+
+// NewBilinearInterpolant builds the bilinear interpolant of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing
+func NewBilinearInterpolant[T Float](xValues []T, yValues []T, functionValues [][]T) (*BilinearInterpolant[T], error) {
+	if err := validateGrid(xValues, yValues, functionValues, 2); err != nil {
+		return nil, err
+	}
+
+	return &BilinearInterpolant[T]{
+		xValues: append([]T(nil), xValues...),
+		yValues: append([]T(nil), yValues...),
+		values:  copyPoints(functionValues),
+	}, nil
+}
+
+// Eval returns the value of the interpolant at (x, y), outside of the grid the nearest cell is extended
+func (p *BilinearInterpolant[T]) Eval(x T, y T) T {
+	i, j := gridCell(p.xValues, x), gridCell(p.yValues, y)
+	u := (x - p.xValues[i]) / (p.xValues[i+1] - p.xValues[i])
+	v := (y - p.yValues[j]) / (p.yValues[j+1] - p.yValues[j])
+
+	return (1-u)*(1-v)*p.values[i][j] + u*(1-v)*p.values[i+1][j] + (1-u)*v*p.values[i][j+1] + u*v*p.values[i+1][j+1]
+}
+
+// Domain returns the corners (xmin, ymin) and (xmax, ymax) of the grid
+func (p *BilinearInterpolant[T]) Domain() (T, T, T, T) {
+	return p.xValues[0], p.yValues[0], p.xValues[len(p.xValues)-1], p.yValues[len(p.yValues)-1]
+}
+
+// BicubicInterpolant interpolates values on a rectilinear grid with a bicubic hermite patch in each cell,
+// the partial derivatives at the grid points are estimated with finite differences
+type BicubicInterpolant[T Float] struct {
+	xValues []T
+	yValues []T
+	patches [][][4][4]T
+}
+
+// NewBicubicInterpolant builds the bicubic interpolant of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing, the interpolant reproduces quadratic functions when each axis has three or more points
+func NewBicubicInterpolant[T Float](xValues []T, yValues []T, functionValues [][]T) (*BicubicInterpolant[T], error) {
+	if err := validateGrid(xValues, yValues, functionValues, 2); err != nil {
+		return nil, err
+	}
+
+	rows, columns := len(xValues), len(yValues)
+	dx := make([][]T, rows)
+	dy := make([][]T, rows)
+	dxy := make([][]T, rows)
+	for i := 0; i < rows; i++ {
+		dy[i] = gridDerivatives(yValues, functionValues[i])
+	}
+
+	column := make([]T, rows)
+	for i := range dx {
+		dx[i] = make([]T, columns)
+		dxy[i] = make([]T, columns)
+	}
+	for j := 0; j < columns; j++ {
+		for i := 0; i < rows; i++ {
+			column[i] = functionValues[i][j]
+		}
+		for i, derivative := range gridDerivatives(xValues, column) {
+			dx[i][j] = derivative
+		}
+		for i := 0; i < rows; i++ {
+			column[i] = dy[i][j]
+		}
+		for i, derivative := range gridDerivatives(xValues, column) {
+			dxy[i][j] = derivative
+		}
+	}
+
+	// the hermite data of each cell scaled to the unit square, rows select the value or the x derivative at
+	// x_i or x_(i+1) and columns the value or the y derivative at y_j or y_(j+1)
+	patches := make([][][4][4]T, rows-1)
+	for i := range patches {
+		patches[i] = make([][4][4]T, columns-1)
+		for j := range patches[i] {
+			hx, hy := xValues[i+1]-xValues[i], yValues[j+1]-yValues[j]
+			for a := 0; a < 2; a++ {
+				for b := 0; b < 2; b++ {
+					patches[i][j][a][b] = functionValues[i+a][j+b]
+					patches[i][j][a+2][b] = dx[i+a][j+b] * hx
+					patches[i][j][a][b+2] = dy[i+a][j+b] * hy
+					patches[i][j][a+2][b+2] = dxy[i+a][j+b] * hx * hy
+				}
+			}
+		}
+	}
+
+	return &BicubicInterpolant[T]{
+		xValues: append([]T(nil), xValues...),
+		yValues: append([]T(nil), yValues...),
+		patches: patches,
+	}, nil
+}
+
+// Eval returns the value of the interpolant at (x, y), outside of the grid the nearest patch is extended
+func (p *BicubicInterpolant[T]) Eval(x T, y T) T {
+	i, j := gridCell(p.xValues, x), gridCell(p.yValues, y)
+	u := (x - p.xValues[i]) / (p.xValues[i+1] - p.xValues[i])
+	v := (y - p.yValues[j]) / (p.yValues[j+1] - p.yValues[j])
+	bu, bv := hermiteBasis(u), hermiteBasis(v)
+
+	var value T
+	for a := 0; a < 4; a++ {
+		for b := 0; b < 4; b++ {
+			value += bu[a] * p.patches[i][j][a][b] * bv[b]
+		}
+	}
+
+	return value
+}
+
+// Domain returns the corners (xmin, ymin) and (xmax, ymax) of the grid
+func (p *BicubicInterpolant[T]) Domain() (T, T, T, T) {
+	return p.xValues[0], p.yValues[0], p.xValues[len(p.xValues)-1], p.yValues[len(p.yValues)-1]
+}
+
+// TensorSplineInterpolant is the tensor product of natural cubic splines on a rectilinear grid, a spline is fitted along y
+// for every x grid value and each evaluation fits a natural cubic spline along x through their values
+// Algorithm from Numerical Recipes - By Press, Teukolsky, Vetterling and Flannery
+type TensorSplineInterpolant[T Float] struct {
+	xValues []T
+	yValues []T
+	splines []*PiecewisePolynomial[T]
+}
+
+// NewTensorSplineInterpolant builds the tensor product spline of functionValues[i][j] = f(xValues[i], yValues[j]),
+// both axes must be strictly increasing
+func NewTensorSplineInterpolant[T Float](xValues []T, yValues []T, functionValues [][]T) (*TensorSplineInterpolant[T], error) {
+	if err := validateGrid(xValues, yValues, functionValues, 2); err != nil {
+		return nil, err
+	}
+
+	splines := make([]*PiecewisePolynomial[T], len(xValues))
+	for i := range splines {
+		spline, err := NewNaturalCubicSplineInterpolant(yValues, functionValues[i])
+		if err != nil {
+			return nil, err
+		}
+		splines[i] = spline
+	}
+
+	return &TensorSplineInterpolant[T]{
+		xValues: append([]T(nil), xValues...),
+		yValues: append([]T(nil), yValues...),
+		splines: splines,
+	}, nil
+}
+
+// Eval returns the value of the interpolant at (x, y)
+func (p *TensorSplineInterpolant[T]) Eval(x T, y T) T {
+	values := make([]T, len(p.splines))
+	for i, spline := range p.splines {
+		values[i] = spline.Eval(y)
+	}
+
+	table, _ := NaturalCubicSpline(p.xValues, values)
+	return newCubicSplineInterpolant(p.xValues, table).Eval(x)
+}
+
+// Domain returns the corners (xmin, ymin) and (xmax, ymax) of the grid
+func (p *TensorSplineInterpolant[T]) Domain() (T, T, T, T) {
+	return p.xValues[0], p.yValues[0], p.xValues[len(p.xValues)-1], p.yValues[len(p.yValues)-1]
+}
+
+// MultilinearInterpolant interpolates values on a rectilinear grid of any dimension,
+// it is linear along each axis inside every grid cell
+type MultilinearInterpolant[T Float] struct {
+	axes   [][]T
+	values []T
+}
+
+// NewMultilinearInterpolant builds the multilinear interpolant of values on the grid spanned by axes, the values are
+// ordered with the last axis varying fastest, so for two axes values[i*len(axes[1])+j] = f(axes[0][i], axes[1][j])
+func NewMultilinearInterpolant[T Float](axes [][]T, values []T) (*MultilinearInterpolant[T], error) {
+	if len(axes) == 0 {
+		return nil, &DimensionError{Name: "axes", Expected: 1, Received: 0}
+	}
+
+	size := 1
+	for k, axis := range axes {
+		if err := validateAxis(fmt.Sprintf("axes[%d]", k), axis, 2); err != nil {
+			return nil, err
+		}
+		size *= len(axis)
+	}
+
+	if len(values) != size {
+		return nil, &DimensionError{Name: "values", Expected: size, Received: len(values)}
+	}
+
+	return &MultilinearInterpolant[T]{axes: copyPoints(axes), values: append([]T(nil), values...)}, nil
+}
+
+// Dimension returns the number of axes of the grid
+func (p *MultilinearInterpolant[T]) Dimension() int {
+	return len(p.axes)
+}
+
+// Eval returns the value of the interpolant at point, outside of the grid the nearest cell is extended,
+// NaN is returned if point does not have one coordinate per axis
+func (p *MultilinearInterpolant[T]) Eval(point []T) T {
+	dimension := len(p.axes)
+	if len(point) != dimension {
+		return T(math.NaN())
+	}
+
+	cells := make([]int, dimension)
+	fractions := make([]T, dimension)
+	for k, axis := range p.axes {
+		cells[k] = gridCell(axis, point[k])
+		fractions[k] = (point[k] - axis[cells[k]]) / (axis[cells[k]+1] - axis[cells[k]])
+	}
+
+	// sum over the 2^dimension corners of the cell, bit k of corner selects the upper grid value on axis k
+	var value T
+	for corner := 0; corner < 1<<dimension; corner++ {
+		weight := T(1)
+		index := 0
+		for k, axis := range p.axes {
+			offset := (corner >> k) & 1
+			if offset == 1 {
+				weight *= fractions[k]
+			} else {
+				weight *= 1 - fractions[k]
+			}
+			index = index*len(axis) + cells[k] + offset
+		}
+		value += weight * p.values[index]
+	}
+
+	return value
+}
+
+// RadialBasisInterpolant interpolates scattered data in any dimension with a sum of radial kernels centred on the data
+// points plus a linear polynomial, which makes the thin plate spline well posed and reproduces linear functions
+type RadialBasisInterpolant[T Float] struct {
+	points       [][]T
+	kernel       func(float64) float64
+	weights      []float64
+	coefficients []float64
+}
+
+// NewRadialBasisInterpolant builds the radial basis function interpolant of values at points with the given kernel
+// and shape parameter epsilon, there must be more points than the dimension and they may not all lie on a hyperplane
+func NewRadialBasisInterpolant[T Float](points [][]T, values []T, basis RadialBasis, epsilon T) (*RadialBasisInterpolant[T], error) {
+	if err := validatePoints("points", points); err != nil {
+		return nil, err
+	}
+
+	size, dimension := len(points), len(points[0])
+	if len(values) != size {
+		return nil, &DimensionError{Name: "values", Expected: size, Received: len(values)}
+	}
+
+	if size < dimension+1 {
+		return nil, &DimensionError{Name: "points", Expected: dimension + 1, Received: size}
+	}
+
+	shape := float64(epsilon)
+	var kernel func(float64) float64
+	switch basis {
+	case GaussianBasis:
+		kernel = func(r float64) float64 { return math.Exp(-(shape * r) * (shape * r)) }
+	case MultiquadricBasis:
+		kernel = func(r float64) float64 { return math.Sqrt(1 + (shape*r)*(shape*r)) }
+	case ThinPlateBasis:
+		kernel = func(r float64) float64 {
+			if r == 0 {
+				return 0
+			}
+			return r * r * math.Log(r)
+		}
+	default:
+		return nil, &ArgumentError{Name: "basis", Value: basis, Reason: "is not a known radial basis"}
+	}
+
+	if basis != ThinPlateBasis && (!(epsilon > 0) || math.IsInf(shape, 0)) {
+		return nil, &ArgumentError{Name: "epsilon", Value: epsilon, Reason: "must be positive and finite"}
+	}
+
+	// the saddle point system [Phi P; P^T 0] [weights; coefficients] = [values; 0] with P = [1 x]
+	order := size + dimension + 1
+	system := make([][]float64, order)
+	rhs := make([]float64, order)
+	for i := range system {
+		system[i] = make([]float64, order)
+	}
+	for i := 0; i < size; i++ {
+		for j := 0; j < size; j++ {
+			system[i][j] = kernel(distance(points[i], points[j]))
+		}
+		system[i][size] = 1
+		system[size][i] = 1
+		for d := 0; d < dimension; d++ {
+			system[i][size+1+d] = float64(points[i][d])
+			system[size+1+d][i] = float64(points[i][d])
+		}
+		rhs[i] = float64(values[i])
+	}
+
+	solution, err := solveLinearSystem(system, rhs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RadialBasisInterpolant[T]{
+		points:       copyPoints(points),
+		kernel:       kernel,
+		weights:      solution[:size],
+		coefficients: solution[size:],
+	}, nil
+}
+
+// Weights returns the weights of the radial kernels centred on the data points
+func (p *RadialBasisInterpolant[T]) Weights() []T {
+	weights := make([]T, len(p.weights))
+	for i, weight := range p.weights {
+		weights[i] = T(weight)
+	}
+	return weights
+}
+
+// Eval returns the value of the interpolant at point, NaN is returned if point does not have the dimension of the data
+func (p *RadialBasisInterpolant[T]) Eval(point []T) T {
+	if len(point) != len(p.points[0]) {
+		return T(math.NaN())
+	}
+
+	value := p.coefficients[0]
+	for d, x := range point {
+		value += p.coefficients[d+1] * float64(x)
+	}
+	for i, center := range p.points {
+		value += p.weights[i] * p.kernel(distance(point, center))
+	}
+
+	return T(value)
+}
+
+// InverseDistanceInterpolant interpolates scattered data in any dimension by shepard's method,
+// a weighted mean of the data with weights 1 / d^power for a point at distance d
+type InverseDistanceInterpolant[T Float] struct {
+	points [][]T
+	values []T
+	power  T
+}
+
+// NewInverseDistanceInterpolant builds the inverse distance weighted interpolant of values at points,
+// larger powers make the interpolant flatter around the data points
+func NewInverseDistanceInterpolant[T Float](points [][]T, values []T, power T) (*InverseDistanceInterpolant[T], error) {
+	if err := validatePoints("points", points); err != nil {
+		return nil, err
+	}
+
+	if len(values) != len(points) {
+		return nil, &DimensionError{Name: "values", Expected: len(points), Received: len(values)}
+	}
+
+	if !(power > 0) || math.IsInf(float64(power), 0) {
+		return nil, &ArgumentError{Name: "power", Value: power, Reason: "must be positive and finite"}
+	}
+
+	return &InverseDistanceInterpolant[T]{points: copyPoints(points), values: append([]T(nil), values...), power: power}, nil
+}
+
+// Eval returns the value of the interpolant at point, NaN is returned if point does not have the dimension of the data
+func (p *InverseDistanceInterpolant[T]) Eval(point []T) T {
+	if len(point) != len(p.points[0]) {
+		return T(math.NaN())
+	}
+
+	var numerator, denominator float64
+	for i, center := range p.points {
+		d := distance(point, center)
+		if d == 0 {
+			return p.values[i]
+		}
+		weight := math.Pow(d, -float64(p.power))
+		numerator += weight * float64(p.values[i])
+		denominator += weight
+	}
+
+	return T(numerator / denominator)
+}
+
+// gridCell returns the index i of the grid cell [axis[i], axis[i+1]] that contains x,
+// values outside of the axis are given the first or last cell
+func gridCell[T Float](axis []T, x T) int {
+	i := sort.Search(len(axis), func(k int) bool { return axis[k] > x }) - 1
+	if i < 0 {
+		return 0
+	}
+	if i > len(axis)-2 {
+		return len(axis) - 2
+	}
+	return i
+}
+
+// gridDerivatives estimates the derivative of values along the strictly increasing axis with three point
+// differences, which are exact for quadratics, or with the secant when the axis only has two points
+func gridDerivatives[T Float](axis []T, values []T) []T {
+	size := len(axis)
+	derivatives := make([]T, size)
+
+	if size == 2 {
+		slope := (values[1] - values[0]) / (axis[1] - axis[0])
+		derivatives[0], derivatives[1] = slope, slope
+		return derivatives
+	}
+
+	// the derivative at the middle point of three is a weighted mean of the two secants,
+	// at the ends the quadratic through the nearest three points is differentiated
+	for i := 0; i < size; i++ {
+		k := i - 1
+		if k < 0 {
+			k = 0
+		}
+		if k > size-3 {
+			k = size - 3
+		}
+		x0, x1, x2 := axis[k], axis[k+1], axis[k+2]
+		f0, f1, f2 := values[k], values[k+1], values[k+2]
+		x := axis[i]
+		derivatives[i] = f0*(2*x-x1-x2)/((x0-x1)*(x0-x2)) + f1*(2*x-x0-x2)/((x1-x0)*(x1-x2)) + f2*(2*x-x0-x1)/((x2-x0)*(x2-x1))
+	}
+
+	return derivatives
+}
+
+// hermiteBasis returns the cubic hermite basis functions on [0, 1] at u, the first two take the values at 0 and 1
+// and the last two the derivatives at 0 and 1
+func hermiteBasis[T Float](u T) [4]T {
+	return [4]T{
+		(2*u-3)*u*u + 1,
+		(3 - 2*u) * u * u,
+		((u-2)*u + 1) * u,
+		(u - 1) * u * u,
+	}
+}
+
+// distance returns the euclidean distance between the points x and y in float64
+func distance[T Float](x []T, y []T) float64 {
+	var norm float64
+	for d := range x {
+		difference := float64(x[d]) - float64(y[d])
+		norm += difference * difference
+	}
+	return math.Sqrt(norm)
+}
+
+// solveLinearSystem solves the square system a x = b by gaussian elimination with partial pivoting,
+// a and b are overwritten and a SingularError is returned for a pivot that is negligible next to the largest entry
+func solveLinearSystem(a [][]float64, b []float64) ([]float64, error) {
+	size := len(b)
+
+	var scale float64
+	for i := range a {
+		for _, value := range a[i] {
+			scale = math.Max(scale, math.Abs(value))
+		}
+	}
+
+	for k := 0; k < size; k++ {
+		pivot := k
+		for i := k + 1; i < size; i++ {
+			if math.Abs(a[i][k]) > math.Abs(a[pivot][k]) {
+				pivot = i
+			}
+		}
+
+		if math.Abs(a[pivot][k]) <= 1e-14*scale {
+			return nil, &SingularError{Pivot: k}
+		}
+
+		a[k], a[pivot] = a[pivot], a[k]
+		b[k], b[pivot] = b[pivot], b[k]
+
+		for i := k + 1; i < size; i++ {
+			factor := a[i][k] / a[k][k]
+			for j := k; j < size; j++ {
+				a[i][j] -= factor * a[k][j]
+			}
+			b[i] -= factor * b[k]
+		}
+	}
+
+	x := make([]float64, size)
+	for i := size - 1; i >= 0; i-- {
+		sum := b[i]
+		for j := i + 1; j < size; j++ {
+			sum -= a[i][j] * x[j]
+		}
+		x[i] = sum / a[i][i]
+	}
+
+	return x, nil
+}
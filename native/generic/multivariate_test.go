@@ -0,0 +1,333 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// gridValues returns the values of f on the grid spanned by xValues and yValues
+func gridValues[T Float](xValues []T, yValues []T, f func(x T, y T) T) [][]T {
+	values := make([][]T, len(xValues))
+	for i, x := range xValues {
+		values[i] = make([]T, len(yValues))
+		for j, y := range yValues {
+			values[i][j] = f(x, y)
+		}
+	}
+	return values
+}
+
+func TestNewBilinearInterpolant(t *testing.T) {
+	t.Run("float32", testNewBilinearInterpolant[float32])
+	t.Run("float64", testNewBilinearInterpolant[float64])
+}
+
+func testNewBilinearInterpolant[T Float](t *testing.T) {
+	bilinear := func(x T, y T) T { return 1 + 2*x + 3*y + x*y }
+	xValues, yValues := []T{0, 1, 3}, []T{0, 2}
+	interpolant, errA := NewBilinearInterpolant(xValues, yValues, gridValues(xValues, yValues, bilinear))
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(2, 1); !approxEqual(10, value, 10) {
+		t.Errorf("Expected %v, received %v", 10, value)
+	}
+
+	// the last cell is extended outside of the grid
+	if value := interpolant.Eval(4, 3); !approxEqual(30, value, 10) {
+		t.Errorf("Expected %v, received %v", 30, value)
+	}
+
+	if xMin, yMin, xMax, yMax := interpolant.Domain(); xMin != 0 || yMin != 0 || xMax != 3 || yMax != 2 {
+		t.Errorf("Expected domain [0, 3] x [0, 2], received [%v, %v] x [%v, %v]", xMin, xMax, yMin, yMax)
+	}
+
+	_, errB := NewBilinearInterpolant(xValues, yValues, [][]T{{1, 2}, {3, 4}})
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NewBilinearInterpolant([]T{0, 0, 3}, yValues, gridValues(xValues, yValues, bilinear))
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestNewBicubicInterpolant(t *testing.T) {
+	t.Run("float32", testNewBicubicInterpolant[float32])
+	t.Run("float64", testNewBicubicInterpolant[float64])
+}
+
+func testNewBicubicInterpolant[T Float](t *testing.T) {
+	// the finite difference derivatives are exact for quadratics, so the patches reproduce them
+	quadratic := func(x T, y T) T { return x*x + 3*x*y - y*y + x }
+	xValues, yValues := []T{0, 0.5, 1.5, 2, 3}, []T{-1, 0, 0.7, 2}
+	interpolant, errA := NewBicubicInterpolant(xValues, yValues, gridValues(xValues, yValues, quadratic))
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	for _, point := range [][2]T{{0.2, -0.5}, {1, 0.3}, {2.7, 1.9}, {3, 2}, {1.5, 0.7}} {
+		expected := float64(quadratic(point[0], point[1]))
+		if value := interpolant.Eval(point[0], point[1]); !approxEqual(expected, value, 100) {
+			t.Errorf("Expected %v at %v, received %v", expected, point, value)
+		}
+	}
+
+	// with two points on an axis the secant is used, which is exact for bilinear functions
+	bilinear := func(x T, y T) T { return 1 + 2*x + 3*y + x*y }
+	coarse, errB := NewBicubicInterpolant([]T{0, 2}, []T{1, 3}, gridValues([]T{0, 2}, []T{1, 3}, bilinear))
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	if value := coarse.Eval(0.5, 2.5); !approxEqual(float64(bilinear(0.5, 2.5)), value, 10) {
+		t.Errorf("Expected %v, received %v", bilinear(0.5, 2.5), value)
+	}
+
+	_, errC := NewBicubicInterpolant([]T{0}, []T{1, 3}, [][]T{{1, 2}})
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestNewTensorSplineInterpolant(t *testing.T) {
+	t.Run("float32", testNewTensorSplineInterpolant[float32])
+	t.Run("float64", testNewTensorSplineInterpolant[float64])
+}
+
+func testNewTensorSplineInterpolant[T Float](t *testing.T) {
+	// natural cubic splines reproduce linear functions along each axis
+	bilinear := func(x T, y T) T { return 2*x + 3*y + x*y }
+	xValues, yValues := []T{0, 1, 2.5, 3}, []T{-1, 0, 1}
+	interpolant, errA := NewTensorSplineInterpolant(xValues, yValues, gridValues(xValues, yValues, bilinear))
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval(1.7, 0.4); !approxEqual(float64(bilinear(1.7, 0.4)), value, 10) {
+		t.Errorf("Expected %v, received %v", bilinear(1.7, 0.4), value)
+	}
+
+	// a smooth function on an 11 x 11 grid
+	smooth := func(x T, y T) T { return T(math.Sin(float64(x)) * math.Cos(float64(y))) }
+	grid := make([]T, 11)
+	for i := range grid {
+		grid[i] = T(i) / 5
+	}
+	smoothInterpolant, errB := NewTensorSplineInterpolant(grid, grid, gridValues(grid, grid, smooth))
+
+	if errB != nil {
+		t.Fatalf("Unexpected error %v", errB)
+	}
+
+	expected := math.Sin(1.03) * math.Cos(0.77)
+	if value := smoothInterpolant.Eval(1.03, 0.77); math.Abs(float64(value)-expected) > 1e-3 {
+		t.Errorf("Expected %v, received %v", expected, value)
+	}
+
+	_, errC := NewTensorSplineInterpolant(xValues, []T{1, 0, -1}, gridValues(xValues, yValues, bilinear))
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+}
+
+func TestNewMultilinearInterpolant(t *testing.T) {
+	t.Run("float32", testNewMultilinearInterpolant[float32])
+	t.Run("float64", testNewMultilinearInterpolant[float64])
+}
+
+func testNewMultilinearInterpolant[T Float](t *testing.T) {
+	trilinear := func(x T, y T, z T) T { return 1 + x + 2*y + 3*z + x*y*z }
+	axes := [][]T{{0, 1}, {0, 1, 2}, {0, 2}}
+
+	var values []T
+	for _, x := range axes[0] {
+		for _, y := range axes[1] {
+			for _, z := range axes[2] {
+				values = append(values, trilinear(x, y, z))
+			}
+		}
+	}
+
+	interpolant, errA := NewMultilinearInterpolant(axes, values)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if dimension := interpolant.Dimension(); dimension != 3 {
+		t.Errorf("Expected dimension 3, received %v", dimension)
+	}
+
+	for _, point := range [][]T{{0.5, 1.5, 0.5}, {0, 2, 2}, {0.25, 0.5, 1.75}} {
+		expected := float64(trilinear(point[0], point[1], point[2]))
+		if value := interpolant.Eval(point); !approxEqual(expected, value, 10) {
+			t.Errorf("Expected %v at %v, received %v", expected, point, value)
+		}
+	}
+
+	if value := interpolant.Eval([]T{0.5, 0.5}); !math.IsNaN(float64(value)) {
+		t.Errorf("Expected NaN, received %v", value)
+	}
+
+	_, errB := NewMultilinearInterpolant(axes, values[1:])
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+
+	_, errC := NewMultilinearInterpolant([][]T{{0, 1}, {1, 0}}, []T{0, 1, 2, 3})
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+
+	_, errD := NewMultilinearInterpolant([][]T{}, []T{})
+
+	if !errors.Is(errD, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errD)
+	}
+}
+
+func TestNewRadialBasisInterpolant(t *testing.T) {
+	t.Run("float32", testNewRadialBasisInterpolant[float32])
+	t.Run("float64", testNewRadialBasisInterpolant[float64])
+}
+
+func testNewRadialBasisInterpolant[T Float](t *testing.T) {
+	points := [][]T{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0.5, 0.3}, {0.2, 0.8}, {0.9, 0.6}}
+	values := make([]T, len(points))
+	for i, point := range points {
+		values[i] = T(math.Sin(float64(point[0]) + 2*float64(point[1])))
+	}
+
+	for _, basis := range []RadialBasis{GaussianBasis, MultiquadricBasis, ThinPlateBasis} {
+		interpolant, err := NewRadialBasisInterpolant(points, values, basis, 1)
+
+		if err != nil {
+			t.Fatalf("Unexpected error %v for basis %v", err, basis)
+		}
+
+		for i, point := range points {
+			if value := interpolant.Eval(point); !approxEqual(float64(values[i]), value, 1000) {
+				t.Errorf("Expected %v at %v for basis %v, received %v", values[i], point, basis, value)
+			}
+		}
+
+		if value := interpolant.Eval([]T{0.5}); !math.IsNaN(float64(value)) {
+			t.Errorf("Expected NaN, received %v", value)
+		}
+	}
+
+	// the linear polynomial term reproduces linear data with zero kernel weights
+	linear := make([]T, len(points))
+	for i, point := range points {
+		linear[i] = 1 + 2*point[0] - point[1]
+	}
+	interpolant, errA := NewRadialBasisInterpolant(points, linear, ThinPlateBasis, 0)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if value := interpolant.Eval([]T{0.3, 0.8}); !approxEqual(0.8, value, 1000) {
+		t.Errorf("Expected %v, received %v", 0.8, value)
+	}
+
+	if weights := interpolant.Weights(); !approxEqualSlice(make([]float64, len(points)), weights) {
+		t.Errorf("Expected zero weights, received %v", weights)
+	}
+
+	_, errB := NewRadialBasisInterpolant([][]T{{0, 0}, {1, 1}, {2, 2}}, []T{0, 1, 2}, ThinPlateBasis, 0)
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+
+	_, errC := NewRadialBasisInterpolant(points, values, GaussianBasis, 0)
+
+	if !errors.Is(errC, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errC)
+	}
+
+	_, errD := NewRadialBasisInterpolant(points, values, RadialBasis(7), 1)
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+
+	_, errE := NewRadialBasisInterpolant(points[:2], values[:2], GaussianBasis, 1)
+
+	if !errors.Is(errE, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errE)
+	}
+}
+
+func TestNewInverseDistanceInterpolant(t *testing.T) {
+	t.Run("float32", testNewInverseDistanceInterpolant[float32])
+	t.Run("float64", testNewInverseDistanceInterpolant[float64])
+}
+
+func testNewInverseDistanceInterpolant[T Float](t *testing.T) {
+	interpolant, errA := NewInverseDistanceInterpolant([][]T{{0}, {2}}, []T{1, 3}, 2)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	// the weights at 0.5 are 1 / 0.25 and 1 / 2.25
+	if value := interpolant.Eval([]T{0.5}); !approxEqual(1.2, value, 10) {
+		t.Errorf("Expected %v, received %v", 1.2, value)
+	}
+
+	if value := interpolant.Eval([]T{2}); value != 3 {
+		t.Errorf("Expected the data value 3, received %v", value)
+	}
+
+	if value := interpolant.Eval([]T{1, 1}); !math.IsNaN(float64(value)) {
+		t.Errorf("Expected NaN, received %v", value)
+	}
+
+	_, errB := NewInverseDistanceInterpolant([][]T{{0}, {2}}, []T{1, 3}, 0)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+
+	_, errC := NewInverseDistanceInterpolant([][]T{{0}, {2}}, []T{1}, 2)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestSolveLinearSystem(t *testing.T) {
+	// the first pivot is zero without row exchanges
+	solution, errA := solveLinearSystem([][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 3}}, []float64{7, 6, 13})
+
+	if errA != nil {
+		t.Errorf("Unexpected error %v", errA)
+	}
+
+	if maxNormDiff([]float64{1, 2, 3}, solution) > 1e-14 {
+		t.Errorf("Expected %v, received %v", []float64{1, 2, 3}, solution)
+	}
+
+	_, errB := solveLinearSystem([][]float64{{1, 2}, {2, 4}}, []float64{1, 2})
+
+	var singularErr *SingularError
+	if !errors.As(errB, &singularErr) || singularErr.Pivot != 1 {
+		t.Errorf("Expected a SingularError at pivot 1, received %v", errB)
+	}
+}
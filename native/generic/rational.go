@@ -0,0 +1,316 @@
+package generic
+
+import (
+	"math"
+	"sort"
+)
+
+var _ Interpolant[float64] = (*BarycentricRational[float64])(nil)
+
+// FloaterHormannWeights returns the barycentric weights of the floater-hormann rational interpolant of blending degree d
+// through the ascending xValues, every difference is divided by a quarter of the width of the nodes as in BarycentricWeights
+func FloaterHormannWeights[T Float](xValues []T, d int) ([]T, error) {
+	if err := validateAxis("xValues", xValues, 1); err != nil {
+		return nil, err
+	}
+
+	if d < 0 || d >= len(xValues) {
+		return nil, &ArgumentError{Name: "d", Value: d, Reason: "must be between 0 and the number of nodes less one"}
+	}
+
+	return floaterHormannWeights(xValues, d, nodeCapacity(xValues)), nil
+}
+
+// BarycentricRational is a rational interpolant in barycentric form, each evaluation costs O(n)
+type BarycentricRational[T Float] struct {
+	nodes   []T
+	values  []T
+	weights []T
+}
+
+// NewFloaterHormannInterpolant builds the floater-hormann rational interpolant of blending degree d through the given points,
+// it has no real poles and blends the polynomials through every d + 1 consecutive points, d = 0 gives berrut's interpolant
+// and d = n - 1 the interpolating polynomial
+func NewFloaterHormannInterpolant[T Float](xValues []T, functionValues []T, d int) (*BarycentricRational[T], error) {
+	weights, err := FloaterHormannWeights(xValues, d)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(xValues) != len(functionValues) {
+		return nil, &DimensionError{Name: "functionValues", Expected: len(xValues), Received: len(functionValues)}
+	}
+
+	return &BarycentricRational[T]{
+		nodes:   append([]T(nil), xValues...),
+		values:  append([]T(nil), functionValues...),
+		weights: weights,
+	}, nil
+}
+
+// Nodes returns a copy of the interpolation nodes
+func (r *BarycentricRational[T]) Nodes() []T {
+	return append([]T(nil), r.nodes...)
+}
+
+// Weights returns a copy of the barycentric weights
+func (r *BarycentricRational[T]) Weights() []T {
+	return append([]T(nil), r.weights...)
+}
+
+// Eval returns the value of the interpolant at x
+func (r *BarycentricRational[T]) Eval(x T) T {
+	return barycentricEval(r.nodes, r.values, r.weights, x)
+}
+
+// EvalMany returns the value of the interpolant at each of xs
+func (r *BarycentricRational[T]) EvalMany(xs []T) []T {
+	return evalMany[T](r, xs)
+}
+
+// Derivative returns the derivative of the given order at x, found as order! times the divided difference
+// on order + 1 copies of x using the recurrences of schneider and werner
+func (r *BarycentricRational[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	node := -1
+	for j, xj := range r.nodes {
+		if x == xj {
+			node = j
+		}
+	}
+
+	// differences[j] holds the divided difference on the current copies of x and the node j
+	differences := append([]T(nil), r.values...)
+	value := r.Eval(x)
+
+	for m := 1; m <= order; m++ {
+		var numerator, denominator T
+		for j, xj := range r.nodes {
+			if j == node {
+				continue
+			}
+			differences[j] = (value - differences[j]) / (x - xj)
+
+			if node < 0 {
+				term := r.weights[j] / (x - xj)
+				numerator += term * differences[j]
+				denominator += term
+			} else {
+				numerator -= r.weights[j] * differences[j]
+			}
+		}
+
+		if node < 0 {
+			value = numerator / denominator
+		} else {
+			value = numerator / r.weights[node]
+		}
+	}
+
+	return factorial[T](order) * value
+}
+
+// Integral returns the integral of the interpolant from a to b using an eight point gauss-legendre rule
+// between every pair of consecutive nodes
+func (r *BarycentricRational[T]) Integral(a T, b T) T {
+	lower, upper, sign := a, b, 1.0
+	if b < a {
+		lower, upper, sign = b, a, -1
+	}
+
+	breaks := []T{lower}
+	for _, x := range r.nodes {
+		if x > lower && x < upper {
+			breaks = append(breaks, x)
+		}
+	}
+	breaks = append(breaks, upper)
+	sort.Slice(breaks, func(i, j int) bool { return breaks[i] < breaks[j] })
+
+	points, weights := gaussLegendre(8)
+
+	var integral float64
+	for k := 1; k < len(breaks); k++ {
+		center := (float64(breaks[k-1]) + float64(breaks[k])) / 2
+		radius := (float64(breaks[k]) - float64(breaks[k-1])) / 2
+		for i := range points {
+			integral += radius * weights[i] * float64(r.Eval(T(center+radius*points[i])))
+		}
+	}
+
+	return T(sign * integral)
+}
+
+// Domain returns the smallest and largest interpolation nodes
+func (r *BarycentricRational[T]) Domain() (T, T) {
+	return r.nodes[0], r.nodes[len(r.nodes)-1]
+}
+
+// Pade returns the numerator of degree L and the denominator of degree M, normalised so that its constant term is 1,
+// of the pade approximant matching the taylor coefficients of ascending powers up to L + M
+func Pade[T Float](coefficients []T, L int, M int) ([]T, []T, error) {
+	if L < 0 {
+		return nil, nil, &ArgumentError{Name: "L", Value: L, Reason: "must not be negative"}
+	}
+
+	if M < 0 {
+		return nil, nil, &ArgumentError{Name: "M", Value: M, Reason: "must not be negative"}
+	}
+
+	if len(coefficients) < L+M+1 {
+		return nil, nil, &DimensionError{Name: "coefficients", Expected: L + M + 1, Received: len(coefficients)}
+	}
+
+	coefficient := func(k int) float64 {
+		if k < 0 {
+			return 0
+		}
+		return float64(coefficients[k])
+	}
+
+	// the denominator makes the coefficients of x^(L+1) to x^(L+M) of denominator * series vanish
+	matrix := make([][]float64, M)
+	rhs := make([]float64, M)
+	for i := 0; i < M; i++ {
+		matrix[i] = make([]float64, M)
+		for j := 0; j < M; j++ {
+			matrix[i][j] = coefficient(L + i - j)
+		}
+		rhs[i] = -coefficient(L + i + 1)
+	}
+
+	solution, err := solveLinearSystem(matrix, rhs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	denominator := make([]T, M+1)
+	denominator[0] = 1
+	for j := 0; j < M; j++ {
+		denominator[j+1] = T(solution[j])
+	}
+
+	numerator := make([]T, L+1)
+	for i := 0; i <= L; i++ {
+		var sum float64
+		for j := 0; j <= M && j <= i; j++ {
+			sum += float64(denominator[j]) * coefficient(i-j)
+		}
+		numerator[i] = T(sum)
+	}
+
+	return numerator, denominator, nil
+}
+
+// RationalFunction is the quotient of two polynomials with coefficients of ascending powers
+type RationalFunction[T Float] struct {
+	numerator   []T
+	denominator []T
+}
+
+// NewPadeApproximant builds the [L/M] pade approximant of the taylor coefficients of ascending powers
+func NewPadeApproximant[T Float](coefficients []T, L int, M int) (*RationalFunction[T], error) {
+	numerator, denominator, err := Pade(coefficients, L, M)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RationalFunction[T]{numerator: numerator, denominator: denominator}, nil
+}
+
+// Numerator returns a copy of the coefficients of the numerator
+func (r *RationalFunction[T]) Numerator() []T {
+	return append([]T(nil), r.numerator...)
+}
+
+// Denominator returns a copy of the coefficients of the denominator
+func (r *RationalFunction[T]) Denominator() []T {
+	return append([]T(nil), r.denominator...)
+}
+
+// Eval returns the value of the rational function at x
+func (r *RationalFunction[T]) Eval(x T) T {
+	return horner(r.numerator, x) / horner(r.denominator, x)
+}
+
+// EvalMany returns the value of the rational function at each of xs
+func (r *RationalFunction[T]) EvalMany(xs []T) []T {
+	values := make([]T, len(xs))
+	for i, x := range xs {
+		values[i] = r.Eval(x)
+	}
+	return values
+}
+
+// Derivative returns the derivative of the given order at x, a negative order returns NaN,
+// differentiating denominator * r = numerator with leibniz's rule gives every order from the lower ones
+func (r *RationalFunction[T]) Derivative(x T, order int) T {
+	if order < 0 {
+		return T(math.NaN())
+	}
+
+	numerator := polynomialDerivatives(r.numerator, x, order)
+	denominator := polynomialDerivatives(r.denominator, x, order)
+
+	derivatives := make([]T, order+1)
+	for k := 0; k <= order; k++ {
+		value := numerator[k]
+		binomial := T(1)
+		for i := 1; i <= k; i++ {
+			binomial = binomial * T(k-i+1) / T(i)
+			value -= binomial * denominator[i] * derivatives[k-i]
+		}
+		derivatives[k] = value / denominator[0]
+	}
+
+	return derivatives[order]
+}
+
+// floaterHormannWeights returns the floater-hormann weights of blending degree d with every difference divided by capacity
+func floaterHormannWeights[T Float](xValues []T, d int, capacity T) []T {
+	n := len(xValues) - 1
+	weights := make([]T, n+1)
+
+	for k := 0; k <= n; k++ {
+		for i := k - d; i <= k; i++ {
+			if i < 0 || i > n-d {
+				continue
+			}
+			term := T(1)
+			for j := i; j <= i+d; j++ {
+				if j != k {
+					term /= T(math.Abs(float64(xValues[k]-xValues[j]))) / capacity
+				}
+			}
+			weights[k] += term
+		}
+
+		if (k+d)%2 == 1 {
+			weights[k] = -weights[k]
+		}
+	}
+
+	return weights
+}
+
+// polynomialDerivatives returns the value and the first order derivatives at x
+// of the polynomial with coefficients of ascending powers
+func polynomialDerivatives[T Float](coefficients []T, x T, order int) []T {
+	derivatives := make([]T, order+1)
+	remaining := append([]T(nil), coefficients...)
+
+	// each synthetic division by (x - t) leaves the next taylor coefficient at x as the remainder
+	for k := 0; k <= order && len(remaining) > 0; k++ {
+		var value T
+		for m := len(remaining) - 1; m >= 0; m-- {
+			value, remaining[m] = value*x+remaining[m], value
+		}
+		derivatives[k] = factorial[T](k) * value
+		remaining = remaining[:len(remaining)-1]
+	}
+
+	return derivatives
+}
@@ -0,0 +1,192 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFloaterHormannWeights(t *testing.T) {
+	t.Run("float32", testFloaterHormannWeights[float32])
+	t.Run("float64", testFloaterHormannWeights[float64])
+}
+
+func testFloaterHormannWeights[T Float](t *testing.T) {
+	xValues := []T{0, 1, 2, 3, 4}
+
+	// berrut's weights alternate in sign
+	if weights, _ := FloaterHormannWeights(xValues, 0); !approxEqualSlice([]float64{1, -1, 1, -1, 1}, weights) {
+		t.Errorf("Expected %v, received %v", []float64{1, -1, 1, -1, 1}, weights)
+	}
+
+	if weights, _ := FloaterHormannWeights(xValues, 1); !approxEqualSlice([]float64{-1, 2, -2, 2, -1}, weights) {
+		t.Errorf("Expected %v, received %v", []float64{-1, 2, -2, 2, -1}, weights)
+	}
+
+	// the largest blending degree gives the polynomial weights
+	polynomial, _ := BarycentricWeights(xValues)
+	if weights, _ := FloaterHormannWeights(xValues, 4); !approxEqualSlice(toFloat64(polynomial), weights) {
+		t.Errorf("Expected %v, received %v", polynomial, weights)
+	}
+
+	_, errA := FloaterHormannWeights(xValues, 5)
+
+	if !errors.Is(errA, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errA)
+	}
+
+	_, errB := FloaterHormannWeights([]T{0, 2, 1}, 1)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
+
+func TestNewFloaterHormannInterpolant(t *testing.T) {
+	t.Run("float32", testNewFloaterHormannInterpolant[float32])
+	t.Run("float64", testNewFloaterHormannInterpolant[float64])
+}
+
+func testNewFloaterHormannInterpolant[T Float](t *testing.T) {
+	// x^3 - 2x is reproduced exactly with a blending degree of 3
+	xValues := []T{-1, -0.5, 0, 0.5, 1, 1.5}
+	functionValues := make([]T, len(xValues))
+	for i, x := range xValues {
+		functionValues[i] = x*x*x - 2*x
+	}
+
+	interpolant, errA := NewFloaterHormannInterpolant(xValues, functionValues, 3)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if values := interpolant.EvalMany([]T{-1, 0.3, 1.2}); !approxEqualSlice([]float64{1, -0.573, -0.672}, values) {
+		t.Errorf("Expected %v, received %v", []float64{1, -0.573, -0.672}, values)
+	}
+
+	derivatives := []T{interpolant.Derivative(0.3, 1), interpolant.Derivative(0.3, 2), interpolant.Derivative(0.3, 3)}
+	if !approxEqualPoint([]float64{-1.73, 1.8, 6}, derivatives, 1000) {
+		t.Errorf("Expected %v, received %v", []float64{-1.73, 1.8, 6}, derivatives)
+	}
+
+	derivatives = []T{interpolant.Derivative(0.5, 1), interpolant.Derivative(0.5, 2), interpolant.Derivative(0.5, 3)}
+	if !approxEqualPoint([]float64{-1.25, 3, 6}, derivatives, 1000) {
+		t.Errorf("Expected %v at a node, received %v", []float64{-1.25, 3, 6}, derivatives)
+	}
+
+	if derivative := interpolant.Derivative(0.3, -1); !math.IsNaN(float64(derivative)) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	if integral := interpolant.Integral(-1, 1.5); !approxEqual(-0.234375, integral, 10) {
+		t.Errorf("Expected %v, received %v", -0.234375, integral)
+	}
+
+	if lower, upper := interpolant.Domain(); lower != -1 || upper != 1.5 {
+		t.Errorf("Expected [-1, 1.5], received [%v, %v]", lower, upper)
+	}
+
+	// runge's function on equispaced nodes, where polynomial interpolation diverges
+	size := 21
+	rungeX := make([]T, size)
+	rungeValues := make([]T, size)
+	for i := range rungeX {
+		rungeX[i] = T(-1 + 2*float64(i)/float64(size-1))
+		rungeValues[i] = 1 / (1 + 25*rungeX[i]*rungeX[i])
+	}
+
+	runge, _ := NewFloaterHormannInterpolant(rungeX, rungeValues, 3)
+	for i := 1; i < size; i++ {
+		x := (float64(rungeX[i-1]) + float64(rungeX[i])) / 2
+		if value := runge.Eval(T(x)); math.Abs(float64(value)-1/(1+25*x*x)) > 5e-3 {
+			t.Errorf("Expected %v at %v, received %v", 1/(1+25*x*x), x, value)
+		}
+	}
+
+	_, errB := NewFloaterHormannInterpolant(xValues, functionValues[:4], 3)
+
+	if !errors.Is(errB, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errB)
+	}
+}
+
+func TestPade(t *testing.T) {
+	t.Run("float32", testPade[float32])
+	t.Run("float64", testPade[float64])
+}
+
+func testPade[T Float](t *testing.T) {
+	// the [2/2] approximant of e^x is (1 + x/2 + x^2/12) / (1 - x/2 + x^2/12)
+	numerator, denominator, errA := Pade([]T{1, 1, 1.0 / 2, 1.0 / 6, 1.0 / 24}, 2, 2)
+
+	if errA != nil {
+		t.Errorf("Error %v", errA)
+	}
+
+	if !approxEqualSlice([]float64{1, 0.5, 1.0 / 12}, numerator) || !approxEqualSlice([]float64{1, -0.5, 1.0 / 12}, denominator) {
+		t.Errorf("Expected [1 0.5 0.0833] and [1 -0.5 0.0833], received %v and %v", numerator, denominator)
+	}
+
+	// a constant series has no [1/2] approximant in normal form
+	_, _, errB := Pade([]T{1, 0, 0, 0}, 1, 2)
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+
+	_, _, errC := Pade([]T{1, 1, 0.5}, 2, 2)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+
+	_, _, errD := Pade([]T{1, 1, 0.5}, -1, 2)
+
+	if !errors.Is(errD, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errD)
+	}
+}
+
+func TestNewPadeApproximant(t *testing.T) {
+	t.Run("float32", testNewPadeApproximant[float32])
+	t.Run("float64", testNewPadeApproximant[float64])
+}
+
+func testNewPadeApproximant[T Float](t *testing.T) {
+	// the [1/1] approximant of ln(1 + x) is 2x / (2 + x)
+	approximant, errA := NewPadeApproximant([]T{0, 1, -0.5}, 1, 1)
+
+	if errA != nil {
+		t.Fatalf("Unexpected error %v", errA)
+	}
+
+	if values := approximant.EvalMany([]T{0, 1, 2}); !approxEqualSlice([]float64{0, 2.0 / 3, 1}, values) {
+		t.Errorf("Expected %v, received %v", []float64{0, 2.0 / 3, 1}, values)
+	}
+
+	derivatives := []T{approximant.Derivative(1, 1), approximant.Derivative(1, 2)}
+	if !approxEqualPoint([]float64{4.0 / 9, -8.0 / 27}, derivatives, 10) {
+		t.Errorf("Expected %v, received %v", []float64{4.0 / 9, -8.0 / 27}, derivatives)
+	}
+
+	if derivative := approximant.Derivative(1, -1); !math.IsNaN(float64(derivative)) {
+		t.Errorf("Expected NaN, received %v", derivative)
+	}
+
+	// at x = 1 the [2/2] approximant of e^x is 19/7, more than twice as close as the taylor polynomial of order 4
+	exponential, _ := NewPadeApproximant([]T{1, 1, 1.0 / 2, 1.0 / 6, 1.0 / 24}, 2, 2)
+	if value := exponential.Eval(1); !approxEqual(19.0/7, value, 10) {
+		t.Errorf("Expected %v, received %v", 19.0/7, value)
+	}
+
+	if numerator, denominator := exponential.Numerator(), exponential.Denominator(); len(numerator) != 3 || len(denominator) != 3 {
+		t.Errorf("Expected degrees 2 and 2, received %v and %v", numerator, denominator)
+	}
+
+	_, errB := NewPadeApproximant([]T{1, 0, 0, 0}, 1, 2)
+
+	if !errors.Is(errB, ErrSingular) {
+		t.Errorf("Expected %v, received %v", ErrSingular, errB)
+	}
+}
@@ -0,0 +1,703 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+)
+
+// RootFindingResult holds the iteration history and convergence diagnostics of a 1D root finding method
+type RootFindingResult[T Float] struct {
+	// Root is the approximated root, only set when the method converged
+	Root T
+	// Iterates holds every approximation in the order they were computed
+	Iterates []T
+	// Residuals holds |f(x)| for each iterate, or |f(x) - x| for fixed point methods
+	Residuals []T
+	// StepSizes holds |x(k) - x(k-1)| for each iterate after the first
+	StepSizes []T
+	// Iterations is the number of iterations performed
+	Iterations int
+	// FunctionEvaluations is the number of evaluations of f and any of its derivatives
+	FunctionEvaluations int
+}
+
+// ConvergenceOrder estimates the order of convergence from the last three step sizes, 0 if it can not be estimated
+func (r RootFindingResult[T]) ConvergenceOrder() T {
+	size := len(r.StepSizes)
+	if size < 3 {
+		return 0
+	}
+
+	stepSize1 := r.StepSizes[size-3]
+	stepSize2 := r.StepSizes[size-2]
+	stepSize3 := r.StepSizes[size-1]
+	if stepSize1 == 0 || stepSize2 == 0 || stepSize3 == 0 || stepSize1 == stepSize2 {
+		return 0
+	}
+
+	return T(math.Log(float64(stepSize3/stepSize2))) / T(math.Log(float64(stepSize2/stepSize1)))
+}
+
+// addIterate records an iterate and its residual, returning ErrDivergence if the iterate is not finite
+func (r *RootFindingResult[T]) addIterate(x T, residual T) error {
+	if size := len(r.Iterates); size > 0 {
+		r.StepSizes = append(r.StepSizes, T(math.Abs(float64(x-r.Iterates[size-1]))))
+	}
+
+	r.Iterates = append(r.Iterates, x)
+	r.Residuals = append(r.Residuals, residual)
+
+	if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+		return ErrDivergence
+	}
+
+	return nil
+}
+
+// iterationError wraps err with the method name and the number of iterations performed
+func (r RootFindingResult[T]) iterationError(method string, err error) error {
+	return &IterationError{Method: method, Iterations: r.Iterations, Err: err}
+}
+
+// Bisection1D is for solving the 1D root finding bisection method
+func Bisection1D[T Float](intervalBegin T, intervalEnd T, TOL T, maxIteration int, f func(x T) T) (T, error) {
+	result, err := Bisection1DWithHistory(intervalBegin, intervalEnd, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Bisection1DWithHistory is Bisection1D, also returning the iteration history
+func Bisection1DWithHistory[T Float](intervalBegin T, intervalEnd T, TOL T, maxIteration int, f func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	if !(intervalBegin < intervalEnd) {
+		return result, &ArgumentError{Name: "intervalEnd", Value: intervalEnd,
+			Reason: fmt.Sprintf("must be greater than intervalBegin = %v", intervalBegin)}
+	}
+
+	fOfA := f(intervalBegin)
+	fOfB := f(intervalEnd)
+	if fOfA*fOfB > 0 {
+		return result, &BracketError[T]{A: intervalBegin, B: intervalEnd, FA: fOfA, FB: fOfB}
+	}
+
+	currentX := intervalBegin + (intervalEnd-intervalBegin)/T(2)
+	fOfCurrentX := f(currentX)
+	result.FunctionEvaluations += 3
+
+	if err := result.addIterate(currentX, T(math.Abs(float64(fOfCurrentX)))); err != nil {
+		return result, result.iterationError("Bisection1D", err)
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		if fOfCurrentX == 0 || (intervalEnd-intervalBegin)/T(2) < TOL {
+			result.Root = currentX
+			return result, nil
+		}
+
+		if fOfA*fOfCurrentX > 0 {
+			intervalBegin = currentX
+			fOfA = fOfCurrentX
+		} else {
+			intervalEnd = currentX
+		}
+
+		currentX = intervalBegin + (intervalEnd-intervalBegin)/T(2)
+		fOfCurrentX = f(currentX)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentX, T(math.Abs(float64(fOfCurrentX)))); err != nil {
+			return result, result.iterationError("Bisection1D", err)
+		}
+	}
+
+	return result, result.iterationError("Bisection1D", ErrMaxIterations)
+}
+
+// FixedPointIteration1D is for solving the 1D root finding fixed point iteration method
+func FixedPointIteration1D[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T) (T, error) {
+	result, err := FixedPointIteration1DWithHistory(initialApprox, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// FixedPointIteration1DWithHistory is FixedPointIteration1D, also returning the iteration history
+func FixedPointIteration1DWithHistory[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox := initialApprox
+	currentApprox := f(previousApprox)
+	result.FunctionEvaluations++
+
+	if err := result.addIterate(previousApprox, T(math.Abs(float64(currentApprox-previousApprox)))); err != nil {
+		return result, result.iterationError("FixedPointIteration1D", err)
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		nextApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(nextApprox-currentApprox)))); err != nil {
+			return result, result.iterationError("FixedPointIteration1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox = currentApprox
+		currentApprox = nextApprox
+	}
+
+	return result, result.iterationError("FixedPointIteration1D", ErrMaxIterations)
+}
+
+// Newton1D is for solving the 1D  root finding newton's method
+func Newton1D[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T, df func(x T) T) (T, error) {
+	result, err := Newton1DWithHistory(initialApprox, TOL, maxIteration, f, df)
+	return result.Root, err
+}
+
+// Newton1DWithHistory is Newton1D, also returning the iteration history
+func Newton1DWithHistory[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T, df func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox := initialApprox
+	fOfPreviousApprox := f(previousApprox)
+	result.FunctionEvaluations++
+
+	if err := result.addIterate(previousApprox, T(math.Abs(float64(fOfPreviousApprox)))); err != nil {
+		return result, result.iterationError("Newton1D", err)
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		dfOfPreviousApprox := df(previousApprox)
+		result.FunctionEvaluations++
+
+		currentApprox := previousApprox
+		if fOfPreviousApprox != 0 {
+			if dfOfPreviousApprox == 0 {
+				return result, result.iterationError("Newton1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox - fOfPreviousApprox/dfOfPreviousApprox
+		}
+
+		fOfCurrentApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(fOfCurrentApprox)))); err != nil {
+			return result, result.iterationError("Newton1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox = currentApprox
+		fOfPreviousApprox = fOfCurrentApprox
+	}
+
+	return result, result.iterationError("Newton1D", ErrMaxIterations)
+}
+
+// ModifiedNewton1D is a modification for solving the 1D  root finding newton's method
+func ModifiedNewton1D[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T,
+	df func(x T) T, ddf func(x T) T) (T, error) {
+	result, err := ModifiedNewton1DWithHistory(initialApprox, TOL, maxIteration, f, df, ddf)
+	return result.Root, err
+}
+
+// ModifiedNewton1DWithHistory is ModifiedNewton1D, also returning the iteration history
+func ModifiedNewton1DWithHistory[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T,
+	df func(x T) T, ddf func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox := initialApprox
+	fOfPreviousApprox := f(previousApprox)
+	result.FunctionEvaluations++
+
+	if err := result.addIterate(previousApprox, T(math.Abs(float64(fOfPreviousApprox)))); err != nil {
+		return result, result.iterationError("ModifiedNewton1D", err)
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		dfOfPreviousApprox := df(previousApprox)
+		ddfOfPreviousApprox := ddf(previousApprox)
+		result.FunctionEvaluations += 2
+
+		numerator := fOfPreviousApprox * dfOfPreviousApprox
+		denominator := T(math.Pow(float64(dfOfPreviousApprox), 2)) - fOfPreviousApprox*ddfOfPreviousApprox
+
+		currentApprox := previousApprox
+		if numerator != 0 {
+			if denominator == 0 {
+				return result, result.iterationError("ModifiedNewton1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox - numerator/denominator
+		}
+
+		fOfCurrentApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(fOfCurrentApprox)))); err != nil {
+			return result, result.iterationError("ModifiedNewton1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox = currentApprox
+		fOfPreviousApprox = fOfCurrentApprox
+	}
+
+	return result, result.iterationError("ModifiedNewton1D", ErrMaxIterations)
+}
+
+// Secant1D is for solving the 1D root finding secant method
+func Secant1D[T Float](initialApprox1 T, intitialApprox2 T, TOL T, maxIteration int, f func(x T) T) (T, error) {
+	result, err := Secant1DWithHistory(initialApprox1, intitialApprox2, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Secant1DWithHistory is Secant1D, also returning the iteration history
+func Secant1DWithHistory[T Float](initialApprox1 T, intitialApprox2 T, TOL T, maxIteration int, f func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox1 := initialApprox1
+	previousApprox2 := intitialApprox2
+	fOfApprox1 := f(previousApprox1)
+	fOfApprox2 := f(previousApprox2)
+	result.FunctionEvaluations += 2
+
+	if err := result.addIterate(previousApprox1, T(math.Abs(float64(fOfApprox1)))); err != nil {
+		return result, result.iterationError("Secant1D", err)
+	}
+
+	if err := result.addIterate(previousApprox2, T(math.Abs(float64(fOfApprox2)))); err != nil {
+		return result, result.iterationError("Secant1D", err)
+	}
+
+	for i := 1; i < maxIteration; i++ {
+		currentApprox := previousApprox2
+		if fOfApprox2 != 0 {
+			if fOfApprox2 == fOfApprox1 {
+				return result, result.iterationError("Secant1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox2 - fOfApprox2*(previousApprox2-previousApprox1)/(fOfApprox2-fOfApprox1)
+		}
+
+		fOfCurrentApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(fOfCurrentApprox)))); err != nil {
+			return result, result.iterationError("Secant1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox2))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox1 = previousApprox2
+		fOfApprox1 = fOfApprox2
+		previousApprox2 = currentApprox
+		fOfApprox2 = fOfCurrentApprox
+	}
+
+	return result, result.iterationError("Secant1D", ErrMaxIterations)
+}
+
+// FalsePosition1D is for solving the 1D root finding false position method
+func FalsePosition1D[T Float](initialApprox1 T, initialApprox2 T, TOL T, maxIteration int, f func(x T) T) (T, error) {
+	result, err := FalsePosition1DWithHistory(initialApprox1, initialApprox2, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// FalsePosition1DWithHistory is FalsePosition1D, also returning the iteration history
+func FalsePosition1DWithHistory[T Float](initialApprox1 T, initialApprox2 T, TOL T, maxIteration int, f func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox1 := initialApprox1
+	previousApprox2 := initialApprox2
+	fOfApprox1 := f(previousApprox1)
+	fOfApprox2 := f(previousApprox2)
+	result.FunctionEvaluations += 2
+
+	if err := result.addIterate(previousApprox1, T(math.Abs(float64(fOfApprox1)))); err != nil {
+		return result, result.iterationError("FalsePosition1D", err)
+	}
+
+	if err := result.addIterate(previousApprox2, T(math.Abs(float64(fOfApprox2)))); err != nil {
+		return result, result.iterationError("FalsePosition1D", err)
+	}
+
+	for i := 1; i < maxIteration; i++ {
+		currentApprox := previousApprox2
+		if fOfApprox2 != 0 {
+			if fOfApprox2 == fOfApprox1 {
+				return result, result.iterationError("FalsePosition1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox2 - fOfApprox2*(previousApprox2-previousApprox1)/(fOfApprox2-fOfApprox1)
+		}
+
+		fOfCurrentApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(fOfCurrentApprox)))); err != nil {
+			return result, result.iterationError("FalsePosition1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox2))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		if fOfCurrentApprox*fOfApprox2 < 0 {
+			previousApprox1 = previousApprox2
+			fOfApprox1 = fOfApprox2
+		}
+
+		previousApprox2 = currentApprox
+		fOfApprox2 = fOfCurrentApprox
+	}
+
+	return result, result.iterationError("FalsePosition1D", ErrMaxIterations)
+}
+
+// Steffensen1D is for solving the 1D root finding Steffensen's mehtod
+func Steffensen1D[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T) (T, error) {
+	result, err := Steffensen1DWithHistory(initialApprox, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Steffensen1DWithHistory is Steffensen1D, also returning the iteration history
+func Steffensen1DWithHistory[T Float](initialApprox T, TOL T, maxIteration int, f func(x T) T) (RootFindingResult[T], error) {
+	var result RootFindingResult[T]
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	previousApprox1 := initialApprox
+	previousApprox2 := f(previousApprox1)
+	result.FunctionEvaluations++
+
+	if err := result.addIterate(previousApprox1, T(math.Abs(float64(previousApprox2-previousApprox1)))); err != nil {
+		return result, result.iterationError("Steffensen1D", err)
+	}
+
+	for i := 0; i < maxIteration; i++ {
+		previousApprox3 := f(previousApprox2)
+		result.FunctionEvaluations++
+
+		currentApprox := previousApprox1
+		if previousApprox2 != previousApprox1 {
+			denominator := previousApprox3 - 2*previousApprox2 + previousApprox1
+			if denominator == 0 {
+				return result, result.iterationError("Steffensen1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox1 - T(math.Pow(float64(previousApprox2-previousApprox1), 2))/denominator
+		}
+
+		fOfCurrentApprox := f(currentApprox)
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, T(math.Abs(float64(fOfCurrentApprox-currentApprox)))); err != nil {
+			return result, result.iterationError("Steffensen1D", err)
+		}
+
+		if T(math.Abs(float64(currentApprox-previousApprox1))) < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox1 = currentApprox
+		previousApprox2 = fOfCurrentApprox
+	}
+
+	return result, result.iterationError("Steffensen1D", ErrMaxIterations)
+}
+
+// FixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+func FixedPointIteration[T Float](initialApprox []T, TOL T, maxIteration int, f func(x []T) []T) ([]T, error) {
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return nil, err
+	}
+
+	previousApprox := initialApprox
+	currentApprox := f(previousApprox)
+
+	if len(currentApprox) != len(previousApprox) {
+		return nil, &DimensionError{Name: "f(x)", Expected: len(previousApprox), Received: len(currentApprox)}
+	}
+
+	var root []T
+	solutionFound := false
+
+	for i := 0; i < maxIteration; i++ {
+		if maxNormDiff(currentApprox, previousApprox) < TOL {
+			root = currentApprox
+			solutionFound = true
+			break
+		}
+
+		previousApprox = currentApprox
+		currentApprox = f(previousApprox)
+	}
+
+	if solutionFound {
+		return root, nil
+	}
+
+	return root, &IterationError{Method: "FixedPointIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// SteffensenFixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+// accelerated by applying Aitken's delta-squared process to each component (Steffensen's method)
+func SteffensenFixedPointIteration[T Float](initialApprox []T, TOL T, maxIteration int, f func(x []T) []T) ([]T, error) {
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return nil, err
+	}
+
+	size := len(initialApprox)
+	previousApprox1 := initialApprox
+	var root []T
+	solutionFound := false
+
+	for i := 0; i < maxIteration; i++ {
+		previousApprox2 := f(previousApprox1)
+		if len(previousApprox2) != size {
+			return nil, &DimensionError{Name: "f(x)", Expected: size, Received: len(previousApprox2)}
+		}
+		previousApprox3 := f(previousApprox2)
+		if len(previousApprox3) != size {
+			return nil, &DimensionError{Name: "f(x)", Expected: size, Received: len(previousApprox3)}
+		}
+
+		currentApprox := make([]T, size)
+		for j := 0; j < size; j++ {
+			denominator := previousApprox3[j] - 2*previousApprox2[j] + previousApprox1[j]
+			if denominator == 0 {
+				currentApprox[j] = previousApprox3[j]
+			} else {
+				currentApprox[j] = previousApprox1[j] - T(math.Pow(float64(previousApprox2[j]-previousApprox1[j]), 2))/denominator
+			}
+		}
+
+		if maxNormDiff(currentApprox, previousApprox1) < TOL {
+			root = currentApprox
+			solutionFound = true
+			break
+		}
+
+		previousApprox1 = currentApprox
+	}
+
+	if solutionFound {
+		return root, nil
+	}
+
+	return root, &IterationError{Method: "SteffensenFixedPointIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// AndersonFixedPointIteration is for solving the multidimensional fixed point iteration method x = G(x)
+// with Anderson acceleration, mixing the last depth iterates. A depth of 0 is plain fixed point iteration.
+func AndersonFixedPointIteration[T Float](initialApprox []T, depth int, TOL T, maxIteration int, f func(x []T) []T) ([]T, error) {
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return nil, err
+	}
+
+	if depth < 0 {
+		return nil, &ArgumentError{Name: "depth", Value: depth, Reason: "must not be negative"}
+	}
+
+	size := len(initialApprox)
+	currentApprox := initialApprox
+	var previousG []T
+	var previousResidual []T
+	var deltaGs [][]T
+	var deltaResiduals [][]T
+	var root []T
+	solutionFound := false
+
+	for i := 0; i < maxIteration; i++ {
+		g := f(currentApprox)
+		if len(g) != size {
+			return nil, &DimensionError{Name: "f(x)", Expected: size, Received: len(g)}
+		}
+
+		residual := make([]T, size)
+		for j := 0; j < size; j++ {
+			residual[j] = g[j] - currentApprox[j]
+		}
+
+		if maxNormDiff(g, currentApprox) < TOL {
+			root = g
+			solutionFound = true
+			break
+		}
+
+		if previousG != nil && depth > 0 {
+			deltaG := make([]T, size)
+			deltaResidual := make([]T, size)
+			for j := 0; j < size; j++ {
+				deltaG[j] = g[j] - previousG[j]
+				deltaResidual[j] = residual[j] - previousResidual[j]
+			}
+
+			deltaGs = append(deltaGs, deltaG)
+			deltaResiduals = append(deltaResiduals, deltaResidual)
+
+			if len(deltaGs) > depth {
+				deltaGs = deltaGs[1:]
+				deltaResiduals = deltaResiduals[1:]
+			}
+		}
+
+		previousG = g
+		previousResidual = residual
+
+		gamma := leastSquaresColumns(deltaResiduals, residual)
+		nextApprox := make([]T, size)
+		copy(nextApprox, g)
+		for k := 0; k < len(gamma); k++ {
+			for j := 0; j < size; j++ {
+				nextApprox[j] -= gamma[k] * deltaGs[k][j]
+			}
+		}
+
+		currentApprox = nextApprox
+	}
+
+	if solutionFound {
+		return root, nil
+	}
+
+	return root, &IterationError{Method: "AndersonFixedPointIteration", Iterations: maxIteration, Err: ErrMaxIterations}
+}
+
+// maxNormDiff returns the infinity norm of x - y
+func maxNormDiff[T Float](x []T, y []T) T {
+	norm := T(0)
+	for i := 0; i < len(x); i++ {
+		if diff := T(math.Abs(float64(x[i] - y[i]))); diff > norm || math.IsNaN(float64(diff)) {
+			norm = diff
+		}
+	}
+	return norm
+}
+
+// dependenceTolerance is the relative norm below which leastSquaresColumns treats a column as dependent,
+// it is looser for float32 where rounding alone leaves residuals around 1e-7
+func dependenceTolerance[T Float]() T {
+	if T(1)+T(1e-10) == T(1) {
+		return 1e-6
+	}
+	return 1e-12
+}
+
+// leastSquaresColumns returns gamma minimizing ||rhs - sum(gamma[k] * columns[k])|| using modified
+// Gram-Schmidt; columns that are numerically dependent on earlier ones are given a zero coefficient
+func leastSquaresColumns[T Float](columns [][]T, rhs []T) []T {
+	size := len(columns)
+	q := make([][]T, size)
+	r := make([][]T, size)
+	dependent := make([]bool, size)
+
+	for k := 0; k < size; k++ {
+		q[k] = make([]T, len(columns[k]))
+		copy(q[k], columns[k])
+		r[k] = make([]T, size)
+
+		columnNorm := T(0)
+		for j := 0; j < len(q[k]); j++ {
+			columnNorm += q[k][j] * q[k][j]
+		}
+		columnNorm = T(math.Sqrt(float64(columnNorm)))
+
+		for i := 0; i < k; i++ {
+			if dependent[i] {
+				continue
+			}
+			for j := 0; j < len(q[k]); j++ {
+				r[i][k] += q[i][j] * q[k][j]
+			}
+			for j := 0; j < len(q[k]); j++ {
+				q[k][j] -= r[i][k] * q[i][j]
+			}
+		}
+
+		norm := T(0)
+		for j := 0; j < len(q[k]); j++ {
+			norm += q[k][j] * q[k][j]
+		}
+		norm = T(math.Sqrt(float64(norm)))
+
+		if norm <= dependenceTolerance[T]()*columnNorm || norm == 0 {
+			dependent[k] = true
+			continue
+		}
+
+		r[k][k] = norm
+		for j := 0; j < len(q[k]); j++ {
+			q[k][j] /= norm
+		}
+	}
+
+	qtb := make([]T, size)
+	for k := 0; k < size; k++ {
+		if dependent[k] {
+			continue
+		}
+		for j := 0; j < len(rhs); j++ {
+			qtb[k] += q[k][j] * rhs[j]
+		}
+	}
+
+	gamma := make([]T, size)
+	for k := size - 1; k >= 0; k-- {
+		if dependent[k] {
+			continue
+		}
+		sum := qtb[k]
+		for i := k + 1; i < size; i++ {
+			sum -= r[k][i] * gamma[i]
+		}
+		gamma[k] = sum / r[k][k]
+	}
+
+	return gamma
+}
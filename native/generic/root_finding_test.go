@@ -0,0 +1,485 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBisection1D(t *testing.T) {
+	t.Run("float32", testBisection1D[float32])
+	t.Run("float64", testBisection1D[float64])
+}
+
+func testBisection1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	rootA, errA := Bisection1D(0, 2, T(math.Pow(10, -4)), 100, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(float64(10), -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := Bisection1D(0, 2, T(math.Pow(10, -4)), 5, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	_, errD := Bisection1D(1, 2, T(math.Pow(10, -4)), 100, testFunction)
+
+	var bracketErr *BracketError[T]
+	if !errors.As(errD, &bracketErr) || !errors.Is(errD, ErrNotBracketed) {
+		t.Errorf("Expected BracketError, received %v", errD)
+	}
+
+	_, errE := Bisection1D(2, 0, T(math.Pow(10, -4)), 100, testFunction)
+
+	if !errors.Is(errE, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errE)
+	}
+
+	_, errF := Bisection1D(0, 2, 0, 100, testFunction)
+
+	if !errors.Is(errF, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errF)
+	}
+
+	_, errG := Bisection1D(0, 2, T(math.Pow(10, -4)), 0, testFunction)
+
+	if !errors.Is(errG, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errG)
+	}
+}
+
+func TestFixedPointIteration1D(t *testing.T) {
+	t.Run("float32", testFixedPointIteration1D[float32])
+	t.Run("float64", testFixedPointIteration1D[float64])
+}
+
+func testFixedPointIteration1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Sqrt(float64((5 - x - T(math.Pow(float64(x), 3))) / 5.0)))
+	}
+
+	rootA, errA := FixedPointIteration1D(0.7, T(math.Pow(10, -4)), 100, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := FixedPointIteration1D(0.7, T(math.Pow(10, -4)), 5, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestNewton1D(t *testing.T) {
+	t.Run("float32", testNewton1D[float32])
+	t.Run("float64", testNewton1D[float64])
+}
+
+func testNewton1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	testFunctionD := func(x T) T {
+		return 3*T(math.Pow(float64(x), 2)) + 10*x + 1
+	}
+
+	rootA, errA := Newton1D(0.7, T(math.Pow(10, -4)), 5, testFunction, testFunctionD)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := Newton1D(0.7, T(math.Pow(10, -4)), 2, testFunction, testFunctionD)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestModifiedNewton1D(t *testing.T) {
+	t.Run("float32", testModifiedNewton1D[float32])
+	t.Run("float64", testModifiedNewton1D[float64])
+}
+
+func testModifiedNewton1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	testFunctionD := func(x T) T {
+		return 3*T(math.Pow(float64(x), 2)) + 10*x + 1
+	}
+
+	testFunctionDD := func(x T) T {
+		return 6*x + 10
+	}
+
+	rootA, errA := ModifiedNewton1D(0.7, T(math.Pow(10, -4)), 5, testFunction, testFunctionD, testFunctionDD)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := ModifiedNewton1D(0.7, T(math.Pow(10, -4)), 2, testFunction, testFunctionD, testFunctionDD)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestSecant1D(t *testing.T) {
+	t.Run("float32", testSecant1D[float32])
+	t.Run("float64", testSecant1D[float64])
+}
+
+func testSecant1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	rootA, errA := Secant1D(0.7, 0.75, T(math.Pow(10, -4)), 5, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := Secant1D(0.7, 0.75, T(math.Pow(10, -4)), 2, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestFalsePosition1D(t *testing.T) {
+	t.Run("float32", testFalsePosition1D[float32])
+	t.Run("float64", testFalsePosition1D[float64])
+}
+
+func testFalsePosition1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	rootA, errA := FalsePosition1D(0.7, 0.75, T(math.Pow(10, -4)), 5, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := FalsePosition1D(0.7, 0.75, T(math.Pow(10, -4)), 2, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestSteffensen1D(t *testing.T) {
+	t.Run("float32", testSteffensen1D[float32])
+	t.Run("float64", testSteffensen1D[float64])
+}
+
+func testSteffensen1D[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Sqrt((5 - float64(x) - math.Pow(float64(x), 3)) / 5))
+	}
+
+	rootA, errA := Steffensen1D(0.7, T(math.Pow(10, -4)), 5, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if math.Abs(float64(rootA-0.8434)) >= math.Pow(10, -4) {
+		t.Errorf("Expected %v, received %v", math.Pow(10, -4), math.Abs(float64(rootA-0.8434)))
+	}
+
+	_, errB := Steffensen1D(0.7, T(math.Pow(10, -4)), 2, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestFixedPointIteration(t *testing.T) {
+	t.Run("float32", testFixedPointIteration[float32])
+	t.Run("float64", testFixedPointIteration[float64])
+}
+
+func testFixedPointIteration[T Float](t *testing.T) {
+	testFunction := func(x []T) []T {
+		return []T{
+			T(math.Cos(float64(x[1]*x[2])))/3.0 + 1.0/6.0,
+			T(math.Sqrt(float64(x[0]*x[0]+T(math.Sin(float64(x[2])))+1.06)))/9.0 - 0.1,
+			-T(math.Exp(float64(-x[0]*x[1])))/20.0 - (10.0*math.Pi-3.0)/60.0,
+		}
+	}
+
+	solution := []T{0.5, 0, -math.Pi / 6}
+
+	rootA, errA := FixedPointIteration([]T{0.1, 0.1, -0.1}, T(math.Pow(10, -6)), 100, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if diff := maxNormDiff(rootA, solution); diff >= T(math.Pow(10, -5)) {
+		t.Errorf("Expected %v, received %v", solution, rootA)
+	}
+
+	_, errB := FixedPointIteration([]T{0.1, 0.1, -0.1}, T(math.Pow(10, -6)), 2, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	testFunctionBad := func(x []T) []T {
+		return x[:1]
+	}
+
+	_, errC := FixedPointIteration([]T{0.1, 0.1, -0.1}, T(math.Pow(10, -6)), 100, testFunctionBad)
+
+	if errC == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestSteffensenFixedPointIteration(t *testing.T) {
+	t.Run("float32", testSteffensenFixedPointIteration[float32])
+	t.Run("float64", testSteffensenFixedPointIteration[float64])
+}
+
+func testSteffensenFixedPointIteration[T Float](t *testing.T) {
+	testFunction := func(x []T) []T {
+		return []T{
+			T(math.Sqrt(float64((5 - x[0] - T(math.Pow(float64(x[0]), 3))) / 5.0))),
+			0.99*x[1] + 0.01,
+		}
+	}
+
+	solution := []T{0.8434, 1}
+
+	rootA, errA := SteffensenFixedPointIteration([]T{0.7, 0}, T(math.Pow(10, -5)), 10, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if diff := maxNormDiff(rootA, solution); diff >= T(math.Pow(10, -4)) {
+		t.Errorf("Expected %v, received %v", solution, rootA)
+	}
+
+	_, errB := FixedPointIteration([]T{0.7, 0}, T(math.Pow(10, -5)), 10, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	// the first evaluation has the right length, the second does not
+	calls := 0
+	shrinking := func(x []T) []T {
+		calls++
+		if calls > 1 {
+			return x[:1]
+		}
+		return testFunction(x)
+	}
+
+	_, errC := SteffensenFixedPointIteration([]T{0.7, 0}, T(math.Pow(10, -5)), 10, shrinking)
+
+	if !errors.Is(errC, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errC)
+	}
+}
+
+func TestAndersonFixedPointIteration(t *testing.T) {
+	t.Run("float32", testAndersonFixedPointIteration[float32])
+	t.Run("float64", testAndersonFixedPointIteration[float64])
+}
+
+func testAndersonFixedPointIteration[T Float](t *testing.T) {
+	testFunction := func(x []T) []T {
+		return []T{
+			0.99*x[0] + 0.005*x[1] + 0.01,
+			0.002*x[0] + 0.98*x[1] - 0.02,
+			0.5 * T(math.Cos(float64(x[2]))),
+		}
+	}
+
+	residual := func(x []T) T {
+		return maxNormDiff(testFunction(x), x)
+	}
+
+	rootA, errA := AndersonFixedPointIteration([]T{0, 0, 0}, 3, T(math.Pow(10, -5)), 50, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if res := residual(rootA); res >= T(math.Pow(10, -4)) {
+		t.Errorf("Expected residual below %v, received %v", math.Pow(10, -4), res)
+	}
+
+	_, errB := AndersonFixedPointIteration([]T{0, 0, 0}, 0, T(math.Pow(10, -5)), 50, testFunction)
+
+	if errB == nil {
+		t.Error("Expected error")
+	}
+
+	_, errC := AndersonFixedPointIteration([]T{0, 0, 0}, -1, T(math.Pow(10, -5)), 50, testFunction)
+
+	var argumentErr *ArgumentError
+	if !errors.As(errC, &argumentErr) || argumentErr.Name != "depth" {
+		t.Errorf("Expected ArgumentError for depth, received %v", errC)
+	}
+}
+
+func TestNewton1DWithHistory(t *testing.T) {
+	t.Run("float32", testNewton1DWithHistory[float32])
+	t.Run("float64", testNewton1DWithHistory[float64])
+}
+
+func testNewton1DWithHistory[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	testFunctionD := func(x T) T {
+		return 3*T(math.Pow(float64(x), 2)) + 10*x + 1
+	}
+
+	resultA, errA := Newton1DWithHistory(0.7, T(math.Pow(10, -5)), 10, testFunction, testFunctionD)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if resultA.Root != resultA.Iterates[len(resultA.Iterates)-1] {
+		t.Errorf("Expected root %v to be the last iterate, received %v", resultA.Root, resultA.Iterates)
+	}
+
+	if len(resultA.Iterates) != resultA.Iterations+1 || len(resultA.Residuals) != len(resultA.Iterates) ||
+		len(resultA.StepSizes) != resultA.Iterations {
+		t.Errorf("Unexpected history lengths %+v", resultA)
+	}
+
+	if resultA.FunctionEvaluations != 2*resultA.Iterations+1 {
+		t.Errorf("Expected %v function evaluations, received %v", 2*resultA.Iterations+1, resultA.FunctionEvaluations)
+	}
+
+	_, errB := Newton1DWithHistory(0.7, T(math.Pow(10, -5)), 2, testFunction, testFunctionD)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	var iterationErr *IterationError
+	if !errors.As(errB, &iterationErr) || iterationErr.Iterations != 2 || iterationErr.Method != "Newton1D" {
+		t.Errorf("Expected IterationError after 2 iterations, received %v", errB)
+	}
+
+	testFunctionDZero := func(x T) T {
+		return 0
+	}
+
+	_, errC := Newton1DWithHistory(0.7, T(math.Pow(10, -5)), 10, testFunction, testFunctionDZero)
+
+	if !errors.Is(errC, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errC)
+	}
+}
+
+func TestSecant1DWithHistory(t *testing.T) {
+	t.Run("float32", testSecant1DWithHistory[float32])
+	t.Run("float64", testSecant1DWithHistory[float64])
+}
+
+func testSecant1DWithHistory[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return T(math.Pow(float64(x), 3)) + 5*T(math.Pow(float64(x), 2)) + x - 5
+	}
+
+	resultA, errA := Secant1DWithHistory(0.5, 1.5, T(math.Pow(10, -5)), 20, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if resultA.FunctionEvaluations != resultA.Iterations+2 {
+		t.Errorf("Expected %v function evaluations, received %v", resultA.Iterations+2, resultA.FunctionEvaluations)
+	}
+
+	testFunctionFlat := func(x T) T {
+		return 1
+	}
+
+	_, errB := Secant1DWithHistory(0.5, 1.5, T(math.Pow(10, -5)), 20, testFunctionFlat)
+
+	if !errors.Is(errB, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errB)
+	}
+}
+
+func TestFixedPointIteration1DWithHistory(t *testing.T) {
+	t.Run("float32", testFixedPointIteration1DWithHistory[float32])
+	t.Run("float64", testFixedPointIteration1DWithHistory[float64])
+}
+
+func testFixedPointIteration1DWithHistory[T Float](t *testing.T) {
+	testFunction := func(x T) T {
+		return x * x
+	}
+
+	resultA, errA := FixedPointIteration1DWithHistory(10, T(math.Pow(10, -4)), 100, testFunction)
+
+	if !errors.Is(errA, ErrDivergence) {
+		t.Errorf("Expected %v, received %v", ErrDivergence, errA)
+	}
+
+	if resultA.Iterations >= 100 {
+		t.Errorf("Expected divergence to be detected early, received %v iterations", resultA.Iterations)
+	}
+
+	resultB, errB := FixedPointIteration1DWithHistory(0.5, T(math.Pow(10, -4)), 100, testFunction)
+
+	if errB != nil {
+		t.Errorf("Unexpected error, %v", errB)
+	}
+
+	for i := 1; i < len(resultB.Residuals); i++ {
+		if resultB.Residuals[i] > resultB.Residuals[i-1] {
+			t.Errorf("Expected decreasing residuals, received %v", resultB.Residuals)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package generic
+
+import (
+	"fmt"
+	"math"
+)
+
+// validateInterval checks that a and b are finite and that a is less than b
+func validateInterval[T Float](a T, b T) error {
+	if math.IsNaN(float64(a)) || math.IsInf(float64(a), 0) {
+		return &ArgumentError{Name: "a", Value: a, Reason: "must be finite"}
+	}
+
+	if math.IsNaN(float64(b)) || math.IsInf(float64(b), 0) {
+		return &ArgumentError{Name: "b", Value: b, Reason: "must be finite"}
+	}
+
+	if a >= b {
+		return &ArgumentError{Name: "b", Value: b, Reason: fmt.Sprintf("must be greater than a = %v", a)}
+	}
+
+	return nil
+}
+
+// validateSteps checks that the number of steps N is at least minimum
+func validateSteps(N int, minimum int) error {
+	if N < minimum {
+		return &ArgumentError{Name: "N", Value: N, Reason: fmt.Sprintf("must be at least %d", minimum)}
+	}
+
+	return nil
+}
+
+// validateTolerance checks that TOL is a positive number
+func validateTolerance[T Float](TOL T) error {
+	if !(TOL > 0) {
+		return &ArgumentError{Name: "TOL", Value: TOL, Reason: "must be positive"}
+	}
+
+	return nil
+}
+
+// validateMaxIteration checks that at least one iteration is allowed
+func validateMaxIteration(maxIteration int) error {
+	if maxIteration <= 0 {
+		return &ArgumentError{Name: "maxIteration", Value: maxIteration, Reason: "must be positive"}
+	}
+
+	return nil
+}
+
+// validateStepBounds checks that minStep is positive and not greater than maxStep
+func validateStepBounds[T Float](minStep T, maxStep T) error {
+	if !(minStep > 0) {
+		return &ArgumentError{Name: "minStep", Value: minStep, Reason: "must be positive"}
+	}
+
+	if !(maxStep >= minStep) || math.IsInf(float64(maxStep), 0) {
+		return &ArgumentError{Name: "maxStep", Value: maxStep, Reason: fmt.Sprintf("must be finite and at least minStep = %v", minStep)}
+	}
+
+	return nil
+}
+
+// validateAdaptive checks the arguments shared by the adaptive step size integrators
+func validateAdaptive[T Float](a T, b T, TOL T, maxStep T, minStep T) error {
+	if err := validateInterval(a, b); err != nil {
+		return err
+	}
+
+	if err := validateTolerance(TOL); err != nil {
+		return err
+	}
+
+	return validateStepBounds(minStep, maxStep)
+}
+
+// validateRootFinding checks the tolerance and iteration limit shared by the root finding methods
+func validateRootFinding[T Float](TOL T, maxIteration int) error {
+	if err := validateTolerance(TOL); err != nil {
+		return err
+	}
+
+	return validateMaxIteration(maxIteration)
+}
+
+// validateNodes checks that there is at least one interpolation node and that the nodes are finite and distinct
+func validateNodes[T Float](xValues []T) error {
+	if len(xValues) == 0 {
+		return &DimensionError{Name: "xValues", Expected: 1, Received: 0}
+	}
+
+	seen := make(map[T]int, len(xValues))
+	for i, x := range xValues {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return &ArgumentError{Name: fmt.Sprintf("xValues[%d]", i), Value: x, Reason: "must be finite"}
+		}
+
+		if j, ok := seen[x]; ok {
+			return &NodeError[T]{Name: "xValues", Index: i, Value: x, Previous: j, Err: ErrDuplicateNodes}
+		}
+		seen[x] = i
+	}
+
+	return nil
+}
+
+// validateSamples checks that there are at least minimum interpolation nodes with a function value each,
+// that no function value is NaN and that the nodes are finite and distinct, or strictly increasing if sorted is set
+func validateSamples[T Float](xValues []T, functionValues []T, minimum int, sorted bool) error {
+	if err := validateValues("functionValues", functionValues, len(xValues)); err != nil {
+		return err
+	}
+
+	if len(xValues) < minimum {
+		return &DimensionError{Name: "xValues", Expected: minimum, Received: len(xValues)}
+	}
+
+	if sorted {
+		return validateAxis("xValues", xValues, minimum)
+	}
+
+	return validateNodes(xValues)
+}
+
+// validateValues checks that values has size entries and that none of them is NaN
+func validateValues[T Float](name string, values []T, size int) error {
+	if len(values) != size {
+		return &DimensionError{Name: name, Expected: size, Received: len(values)}
+	}
+
+	for i, value := range values {
+		if math.IsNaN(float64(value)) {
+			return &ArgumentError{Name: fmt.Sprintf("%s[%d]", name, i), Value: value, Reason: "must not be NaN"}
+		}
+	}
+
+	return nil
+}
+
+// validateKnotArguments checks that the degree is not negative and that there are enough control points for it
+func validateKnotArguments(controlPoints int, degree int) error {
+	if degree < 0 {
+		return &ArgumentError{Name: "degree", Value: degree, Reason: "must not be negative"}
+	}
+
+	if controlPoints < degree+1 {
+		return &ArgumentError{Name: "controlPoints", Value: controlPoints, Reason: fmt.Sprintf("must be at least degree + 1 = %d", degree+1)}
+	}
+
+	return nil
+}
+
+// validateParameters checks that the curve parameters are finite and non-decreasing
+func validateParameters[T Float](parameters []T) error {
+	for i, t := range parameters {
+		if math.IsNaN(float64(t)) || math.IsInf(float64(t), 0) {
+			return &ArgumentError{Name: fmt.Sprintf("parameters[%d]", i), Value: t, Reason: "must be finite"}
+		}
+
+		if i > 0 && t < parameters[i-1] {
+			return &ArgumentError{Name: fmt.Sprintf("parameters[%d]", i), Value: t, Reason: fmt.Sprintf("must not be less than parameters[%d] = %v", i-1, parameters[i-1])}
+		}
+	}
+
+	return nil
+}
+
+// validateKnots checks that the knots are finite and non-decreasing and that none is repeated more than degree + 1 times
+func validateKnots[T Float](knots []T, degree int) error {
+	multiplicity := 0
+	for i, knot := range knots {
+		if math.IsNaN(float64(knot)) || math.IsInf(float64(knot), 0) {
+			return &ArgumentError{Name: fmt.Sprintf("knots[%d]", i), Value: knot, Reason: "must be finite"}
+		}
+
+		if i > 0 && knot < knots[i-1] {
+			return &ArgumentError{Name: fmt.Sprintf("knots[%d]", i), Value: knot, Reason: fmt.Sprintf("must not be less than knots[%d] = %v", i-1, knots[i-1])}
+		}
+
+		multiplicity++
+		if i > 0 && knot != knots[i-1] {
+			multiplicity = 1
+		}
+
+		if multiplicity > degree+1 {
+			return &ArgumentError{Name: fmt.Sprintf("knots[%d]", i), Value: knot, Reason: fmt.Sprintf("is repeated more than degree + 1 = %d times", degree+1)}
+		}
+	}
+
+	return nil
+}
+
+// validatePoints checks that there is at least one point and that the points are finite and share a positive dimension
+func validatePoints[T Float](name string, points [][]T) error {
+	if len(points) == 0 {
+		return &DimensionError{Name: name, Expected: 1, Received: 0}
+	}
+
+	dimension := len(points[0])
+	if dimension == 0 {
+		return &DimensionError{Name: fmt.Sprintf("%s[0]", name), Expected: 1, Received: 0}
+	}
+
+	for i, point := range points {
+		if len(point) != dimension {
+			return &DimensionError{Name: fmt.Sprintf("%s[%d]", name, i), Expected: dimension, Received: len(point)}
+		}
+
+		for d, value := range point {
+			if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+				return &ArgumentError{Name: fmt.Sprintf("%s[%d][%d]", name, i, d), Value: value, Reason: "must be finite"}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAxis checks that a grid axis has at least minimum values and that they are finite and strictly increasing
+func validateAxis[T Float](name string, axis []T, minimum int) error {
+	if len(axis) < minimum {
+		return &DimensionError{Name: name, Expected: minimum, Received: len(axis)}
+	}
+
+	for i, x := range axis {
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return &ArgumentError{Name: fmt.Sprintf("%s[%d]", name, i), Value: x, Reason: "must be finite"}
+		}
+
+		if i > 0 && x == axis[i-1] {
+			return &NodeError[T]{Name: name, Index: i, Value: x, Previous: i - 1, Err: ErrDuplicateNodes}
+		}
+
+		if i > 0 && x < axis[i-1] {
+			return &NodeError[T]{Name: name, Index: i, Value: x, Previous: i - 1, Err: ErrUnsortedNodes}
+		}
+	}
+
+	return nil
+}
+
+// validateGrid checks the axes of a rectilinear grid and that functionValues has a row for every x value
+// and a column for every y value
+func validateGrid[T Float](xValues []T, yValues []T, functionValues [][]T, minimum int) error {
+	if err := validateAxis("xValues", xValues, minimum); err != nil {
+		return err
+	}
+
+	if err := validateAxis("yValues", yValues, minimum); err != nil {
+		return err
+	}
+
+	if len(functionValues) != len(xValues) {
+		return &DimensionError{Name: "functionValues", Expected: len(xValues), Received: len(functionValues)}
+	}
+
+	for i, row := range functionValues {
+		if len(row) != len(yValues) {
+			return &DimensionError{Name: fmt.Sprintf("functionValues[%d]", i), Expected: len(yValues), Received: len(row)}
+		}
+	}
+
+	return nil
+}
+
+// validateDense checks that n is positive and that the row-major matrix a has n x n entries, none of them NaN
+func validateDense[T Float](name string, a []T, n int) error {
+	if n <= 0 {
+		return &ArgumentError{Name: "n", Value: n, Reason: "must be positive"}
+	}
+
+	return validateValues(name, a, n*n)
+}
+
+// validateSymmetric checks the n x n row-major matrix a with validateDense and that it equals its transpose
+func validateSymmetric[T Float](a []T, n int) error {
+	if err := validateDense("a", a, n); err != nil {
+		return err
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if a[i*n+j] != a[j*n+i] {
+				return &SymmetricError{Row: i, Col: j}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package generic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestValidateInterval(t *testing.T) {
+	t.Run("float32", testValidateInterval[float32])
+	t.Run("float64", testValidateInterval[float64])
+}
+
+func testValidateInterval[T Float](t *testing.T) {
+	if err := validateInterval[T](0, 1); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateInterval[T](1, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval[T](2, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval[T](T(math.NaN()), 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval[T](0, T(math.Inf(1))); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateSteps(t *testing.T) {
+	if err := validateSteps(3, 3); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateSteps(2, 3); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+}
+
+func TestValidateTolerance(t *testing.T) {
+	t.Run("float32", testValidateTolerance[float32])
+	t.Run("float64", testValidateTolerance[float64])
+}
+
+func testValidateTolerance[T Float](t *testing.T) {
+	if err := validateTolerance[T](1e-5); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateTolerance[T](0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateTolerance[T](T(math.NaN())); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateMaxIteration(t *testing.T) {
+	if err := validateMaxIteration(1); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateMaxIteration(0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateStepBounds(t *testing.T) {
+	t.Run("float32", testValidateStepBounds[float32])
+	t.Run("float64", testValidateStepBounds[float64])
+}
+
+func testValidateStepBounds[T Float](t *testing.T) {
+	if err := validateStepBounds[T](0.01, 0.01); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateStepBounds[T](0, 0.25); !errors.As(err, &argumentErr) || argumentErr.Name != "minStep" {
+		t.Errorf("Expected ArgumentError for minStep, received %v", err)
+	}
+
+	if err := validateStepBounds[T](0.5, 0.25); !errors.As(err, &argumentErr) || argumentErr.Name != "maxStep" {
+		t.Errorf("Expected ArgumentError for maxStep, received %v", err)
+	}
+}
+
+func TestValidateNodes(t *testing.T) {
+	t.Run("float32", testValidateNodes[float32])
+	t.Run("float64", testValidateNodes[float64])
+}
+
+func testValidateNodes[T Float](t *testing.T) {
+	if err := validateNodes([]T{0, 1, 0.5}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateNodes([]T{}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	var nodeErr *NodeError[T]
+	if err := validateNodes([]T{0, 1, 0}); !errors.As(err, &nodeErr) || nodeErr.Index != 2 || nodeErr.Previous != 0 || !errors.Is(err, ErrDuplicateNodes) {
+		t.Errorf("Expected NodeError for xValues[2], received %v", err)
+	}
+
+	var argumentErr *ArgumentError
+
+	if err := validateNodes([]T{0, T(math.NaN())}); !errors.As(err, &argumentErr) || argumentErr.Name != "xValues[1]" {
+		t.Errorf("Expected ArgumentError for xValues[1], received %v", err)
+	}
+}
+
+func TestValidateSamples(t *testing.T) {
+	t.Run("float32", testValidateSamples[float32])
+	t.Run("float64", testValidateSamples[float64])
+}
+
+func testValidateSamples[T Float](t *testing.T) {
+	if err := validateSamples([]T{0, 2, 1}, []T{1, 2, 3}, 1, false); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateSamples([]T{0, 2, 1}, []T{1, 2, 3}, 2, true); !errors.Is(err, ErrUnsortedNodes) {
+		t.Errorf("Expected %v, received %v", ErrUnsortedNodes, err)
+	}
+
+	if err := validateSamples([]T{0, 1, 1}, []T{1, 2, 3}, 2, true); !errors.Is(err, ErrDuplicateNodes) {
+		t.Errorf("Expected %v, received %v", ErrDuplicateNodes, err)
+	}
+
+	if err := validateSamples([]T{0, 1, 0}, []T{1, 2, 3}, 1, false); !errors.Is(err, ErrDuplicateNodes) {
+		t.Errorf("Expected %v, received %v", ErrDuplicateNodes, err)
+	}
+
+	if err := validateSamples([]T{0}, []T{1}, 2, true); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := validateSamples([]T{0, 1}, []T{1}, 1, false); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateSamples([]T{0, 1}, []T{1, T(math.NaN())}, 1, false); !errors.As(err, &argumentErr) || argumentErr.Name != "functionValues[1]" {
+		t.Errorf("Expected ArgumentError for functionValues[1], received %v", err)
+	}
+
+	if err := validateSamples([]T{T(math.Inf(1)), 1}, []T{1, 2}, 1, true); !errors.As(err, &argumentErr) || argumentErr.Name != "xValues[0]" {
+		t.Errorf("Expected ArgumentError for xValues[0], received %v", err)
+	}
+}
+
+func TestValidateKnots(t *testing.T) {
+	t.Run("float32", testValidateKnots[float32])
+	t.Run("float64", testValidateKnots[float64])
+}
+
+func testValidateKnots[T Float](t *testing.T) {
+	if err := validateKnots([]T{0, 0, 0, 0.5, 1, 1, 1}, 2); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateKnots([]T{0, 0.5, 0.25, 1}, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateKnots([]T{0, 0, 0, 0, 1}, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateKnots([]T{0, T(math.NaN()), 1}, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidatePoints(t *testing.T) {
+	t.Run("float32", testValidatePoints[float32])
+	t.Run("float64", testValidatePoints[float64])
+}
+
+func testValidatePoints[T Float](t *testing.T) {
+	if err := validatePoints("points", [][]T{{0, 1}, {2, 3}}); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validatePoints("points", [][]T{}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := validatePoints("points", [][]T{{0, 1}, {2}}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := validatePoints("points", [][]T{{0, T(math.Inf(-1))}}); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateGrid(t *testing.T) {
+	t.Run("float32", testValidateGrid[float32])
+	t.Run("float64", testValidateGrid[float64])
+}
+
+func testValidateGrid[T Float](t *testing.T) {
+	if err := validateGrid([]T{0, 1}, []T{0, 1, 2}, [][]T{{0, 1, 2}, {3, 4, 5}}, 2); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateGrid([]T{0, 1}, []T{0, 2, 1}, [][]T{{0, 1, 2}, {3, 4, 5}}, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateGrid([]T{0}, []T{0, 1, 2}, [][]T{{0, 1, 2}}, 2); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := validateGrid([]T{0, 1}, []T{0, 1, 2}, [][]T{{0, 1, 2}, {3, 4}}, 2); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+}
+
+func TestValidateDense(t *testing.T) {
+	t.Run("float32", testValidateDense[float32])
+	t.Run("float64", testValidateDense[float64])
+}
+
+func testValidateDense[T Float](t *testing.T) {
+	if err := validateDense("a", []T{1, 2, 3, 4}, 2); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateDense("a", []T{1, 2, 3}, 2); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	if err := validateDense("a", []T{}, 0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateDense("a", []T{1, T(math.NaN()), 3, 4}, 2); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateSymmetric(t *testing.T) {
+	t.Run("float32", testValidateSymmetric[float32])
+	t.Run("float64", testValidateSymmetric[float64])
+}
+
+func testValidateSymmetric[T Float](t *testing.T) {
+	if err := validateSymmetric([]T{2, 1, 0, 1, 3, -1, 0, -1, 4}, 3); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	var symmetricErr *SymmetricError
+	if err := validateSymmetric([]T{2, 1, 0, 1, 3, -1, 5, -1, 4}, 3); !errors.As(err, &symmetricErr) || symmetricErr.Row != 0 || symmetricErr.Col != 2 {
+		t.Errorf("Expected SymmetricError at (0, 2), received %v", err)
+	}
+}
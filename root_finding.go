@@ -1,35 +1,117 @@
 package methods
 
 import (
-	"errors"
+	"fmt"
 	"math"
+	"math/cmplx"
 
 	gcf "github.com/NumberXNumbers/types/gc/functions"
 	gcv "github.com/NumberXNumbers/types/gc/values"
 	gcvops "github.com/NumberXNumbers/types/gc/values/ops"
 )
 
+// RootFindingResult holds the iteration history and convergence diagnostics of a 1D root finding method
+type RootFindingResult struct {
+	// Root is the approximated root, only set when the method converged
+	Root gcv.Value
+	// Iterates holds every approximation in the order they were computed
+	Iterates []gcv.Value
+	// Residuals holds |f(x)| for each iterate, or |f(x) - x| for fixed point methods
+	Residuals []float64
+	// StepSizes holds |x(k) - x(k-1)| for each iterate after the first
+	StepSizes []float64
+	// Iterations is the number of iterations performed
+	Iterations int
+	// FunctionEvaluations is the number of evaluations of f and any of its derivatives
+	FunctionEvaluations int
+}
+
+// ConvergenceOrder estimates the order of convergence from the last three step sizes, 0 if it can not be estimated
+func (r RootFindingResult) ConvergenceOrder() float64 {
+	size := len(r.StepSizes)
+	if size < 3 {
+		return 0
+	}
+
+	stepSize1 := r.StepSizes[size-3]
+	stepSize2 := r.StepSizes[size-2]
+	stepSize3 := r.StepSizes[size-1]
+	if stepSize1 == 0 || stepSize2 == 0 || stepSize3 == 0 || stepSize1 == stepSize2 {
+		return 0
+	}
+
+	return math.Log(stepSize3/stepSize2) / math.Log(stepSize2/stepSize1)
+}
+
+// addIterate records an iterate and its residual, returning ErrDivergence if the iterate is not finite
+func (r *RootFindingResult) addIterate(x gcv.Value, residual gcv.Value) error {
+	if size := len(r.Iterates); size > 0 {
+		r.StepSizes = append(r.StepSizes, gcvops.Abs(gcvops.Sub(x, r.Iterates[size-1])).Real())
+	}
+
+	r.Iterates = append(r.Iterates, x)
+	r.Residuals = append(r.Residuals, gcvops.Abs(residual).Real())
+
+	if cmplx.IsNaN(x.Complex()) || cmplx.IsInf(x.Complex()) {
+		return ErrDivergence
+	}
+
+	return nil
+}
+
+// iterationError wraps err with the method name and the number of iterations performed
+func (r RootFindingResult) iterationError(method string, err error) error {
+	return &IterationError{Method: method, Iterations: r.Iterations, Err: err}
+}
+
 // Bisection1D is for solving the 1D root finding bisection method
 func Bisection1D(intervalBegin float64, intervalEnd float64, TOL float64, maxIteration int, f *gcf.Function) (gcv.Value, error) {
+	result, err := Bisection1DWithHistory(intervalBegin, intervalEnd, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Bisection1DWithHistory is Bisection1D, also returning the iteration history
+func Bisection1DWithHistory(intervalBegin float64, intervalEnd float64, TOL float64, maxIteration int, f *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
+	if !(intervalBegin < intervalEnd) {
+		return result, &ArgumentError{Name: "intervalEnd", Value: intervalEnd,
+			Reason: fmt.Sprintf("must be greater than intervalBegin = %v", intervalBegin)}
+	}
+
 	fOfA, errfA := evalV(f, intervalBegin)
 	if errfA != nil {
-		return nil, errfA
+		return result, errfA
+	}
+
+	fOfB, errfB := evalV(f, intervalEnd)
+	if errfB != nil {
+		return result, errfB
+	}
+
+	if fOfA.Real()*fOfB.Real() > 0 {
+		return result, &BracketError{A: intervalBegin, B: intervalEnd, FA: fOfA.Real(), FB: fOfB.Real()}
 	}
 
 	currentX := intervalBegin + (intervalEnd-intervalBegin)/float64(2)
 	fOfCurrentX, errCurrentX := evalV(f, currentX)
 	if errCurrentX != nil {
-		return nil, errCurrentX
+		return result, errCurrentX
 	}
+	result.FunctionEvaluations += 3
 
-	var root gcv.Value
-	solutionFound := false
+	if err := result.addIterate(gcv.MakeValue(currentX), fOfCurrentX); err != nil {
+		return result, result.iterationError("Bisection1D", err)
+	}
 
 	for i := 0; i < maxIteration; i++ {
 		if fOfCurrentX.Real() == 0 || (intervalEnd-intervalBegin)/float64(2) < TOL {
-			root = gcv.MakeValue(currentX)
-			solutionFound = true
-			break
+			result.Root = gcv.MakeValue(currentX)
+			return result, nil
 		}
 
 		if gcvops.Mult(fOfA, fOfCurrentX).Real() > 0 {
@@ -42,239 +124,340 @@ func Bisection1D(intervalBegin float64, intervalEnd float64, TOL float64, maxIte
 		currentX = intervalBegin + (intervalEnd-intervalBegin)/float64(2)
 		fOfCurrentX, errCurrentX = evalV(f, currentX)
 		if errCurrentX != nil {
-			return nil, errCurrentX
+			return result, errCurrentX
 		}
-	}
+		result.FunctionEvaluations++
+		result.Iterations++
 
-	if solutionFound {
-		return root, nil
+		if err := result.addIterate(gcv.MakeValue(currentX), fOfCurrentX); err != nil {
+			return result, result.iterationError("Bisection1D", err)
+		}
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("Bisection1D", ErrMaxIterations)
 }
 
 // FixedPointIteration1D is for solving the 1D root finding fixed point iteration method
 func FixedPointIteration1D(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function) (gcv.Value, error) {
+	result, err := FixedPointIteration1DWithHistory(initialApprox, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// FixedPointIteration1DWithHistory is FixedPointIteration1D, also returning the iteration history
+func FixedPointIteration1DWithHistory(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox := gcv.MakeValue(initialApprox)
 	currentApprox, errCurrentApprox := evalV(f, previousApprox)
 	if errCurrentApprox != nil {
-		return nil, errCurrentApprox
+		return result, errCurrentApprox
 	}
+	result.FunctionEvaluations++
 
-	var root gcv.Value
-	solutionFound := false
+	if err := result.addIterate(previousApprox, gcvops.Sub(currentApprox, previousApprox)); err != nil {
+		return result, result.iterationError("FixedPointIteration1D", err)
+	}
 
 	for i := 0; i < maxIteration; i++ {
-		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
-			root = gcv.MakeValue(currentApprox)
-			solutionFound = true
-			break
+		nextApprox, errNextApprox := evalV(f, currentApprox)
+		if errNextApprox != nil {
+			return result, errNextApprox
 		}
+		result.FunctionEvaluations++
+		result.Iterations++
 
-		previousApprox = currentApprox
-		currentApprox, errCurrentApprox = evalV(f, previousApprox)
-		if errCurrentApprox != nil {
-			return nil, errCurrentApprox
+		if err := result.addIterate(currentApprox, gcvops.Sub(nextApprox, currentApprox)); err != nil {
+			return result, result.iterationError("FixedPointIteration1D", err)
+		}
+
+		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
+			result.Root = gcv.MakeValue(currentApprox)
+			return result, nil
 		}
-	}
 
-	if solutionFound {
-		return root, nil
+		previousApprox = currentApprox
+		currentApprox = nextApprox
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("FixedPointIteration1D", ErrMaxIterations)
 }
 
 // Newton1D is for solving the 1D  root finding newton's method
 func Newton1D(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function, df *gcf.Function) (gcv.Value, error) {
+	result, err := Newton1DWithHistory(initialApprox, TOL, maxIteration, f, df)
+	return result.Root, err
+}
+
+// Newton1DWithHistory is Newton1D, also returning the iteration history
+func Newton1DWithHistory(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function, df *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox := gcv.MakeValue(initialApprox)
 	fPA, errfPA := evalV(f, previousApprox)
 	if errfPA != nil {
-		return nil, errfPA
+		return result, errfPA
 	}
+	result.FunctionEvaluations++
 
-	dfPA, errdfPA := evalV(df, previousApprox)
-	if errdfPA != nil {
-		return nil, errdfPA
+	if err := result.addIterate(previousApprox, fPA); err != nil {
+		return result, result.iterationError("Newton1D", err)
 	}
 
-	currentApprox := gcvops.Sub(previousApprox, gcvops.Div(fPA, dfPA))
-	var root gcv.Value
-	solutionFound := false
-
 	for i := 0; i < maxIteration; i++ {
-		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
+		dfPA, errdfPA := evalV(df, previousApprox)
+		if errdfPA != nil {
+			return result, errdfPA
+		}
+		result.FunctionEvaluations++
+
+		currentApprox := previousApprox
+		if fPA.Complex() != 0 {
+			if dfPA.Complex() == 0 {
+				return result, result.iterationError("Newton1D", ErrDivisionByZero)
+			}
+			currentApprox = gcvops.Sub(previousApprox, gcvops.Div(fPA, dfPA))
 		}
 
-		previousApprox = currentApprox
-
-		fPA, errfPA = evalV(f, previousApprox)
-		if errfPA != nil {
-			return nil, errfPA
+		fCA, errfCA := evalV(f, currentApprox)
+		if errfCA != nil {
+			return result, errfCA
 		}
+		result.FunctionEvaluations++
+		result.Iterations++
 
-		dfPA, errdfPA = evalV(df, previousApprox)
-		if errdfPA != nil {
-			return nil, errdfPA
+		if err := result.addIterate(currentApprox, fCA); err != nil {
+			return result, result.iterationError("Newton1D", err)
 		}
 
-		currentApprox = gcvops.Sub(previousApprox, gcvops.Div(fPA, dfPA))
-	}
+		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
 
-	if solutionFound {
-		return root, nil
+		previousApprox = currentApprox
+		fPA = fCA
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("Newton1D", ErrMaxIterations)
 }
 
 // ModifiedNewton1D is a modification for solving the 1D  root finding newton's method
 func ModifiedNewton1D(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function,
 	df *gcf.Function, ddf *gcf.Function) (gcv.Value, error) {
+	result, err := ModifiedNewton1DWithHistory(initialApprox, TOL, maxIteration, f, df, ddf)
+	return result.Root, err
+}
+
+// ModifiedNewton1DWithHistory is ModifiedNewton1D, also returning the iteration history
+func ModifiedNewton1DWithHistory(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function,
+	df *gcf.Function, ddf *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox := gcv.MakeValue(initialApprox)
 	two := gcv.MakeValue(2)
 
 	fPA, errfPA := evalV(f, previousApprox)
 	if errfPA != nil {
-		return nil, errfPA
-	}
-
-	dfPA, errdfPA := evalV(df, previousApprox)
-	if errdfPA != nil {
-		return nil, errdfPA
+		return result, errfPA
 	}
+	result.FunctionEvaluations++
 
-	ddfPA, errddfPA := evalV(ddf, previousApprox)
-	if errddfPA != nil {
-		return nil, errddfPA
+	if err := result.addIterate(previousApprox, fPA); err != nil {
+		return result, result.iterationError("ModifiedNewton1D", err)
 	}
 
-	ratioA := gcvops.Mult(fPA, dfPA)
-	ratioB := gcvops.Mult(fPA, ddfPA)
-	currentApprox := gcvops.Sub(previousApprox, gcvops.Div(ratioA, gcvops.Sub(gcvops.Pow(dfPA, two), ratioB)))
-	var root gcv.Value
-	solutionFound := false
-
 	for i := 0; i < maxIteration; i++ {
-		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
-		}
-
-		previousApprox = currentApprox
-		fPA, errfPA = evalV(f, previousApprox)
-		if errfPA != nil {
-			return nil, errfPA
-		}
-
-		dfPA, errdfPA = evalV(df, previousApprox)
+		dfPA, errdfPA := evalV(df, previousApprox)
 		if errdfPA != nil {
-			return nil, errdfPA
+			return result, errdfPA
 		}
 
-		ddfPA, errddfPA = evalV(ddf, previousApprox)
+		ddfPA, errddfPA := evalV(ddf, previousApprox)
 		if errddfPA != nil {
-			return nil, errddfPA
+			return result, errddfPA
 		}
+		result.FunctionEvaluations += 2
 
 		ratioA := gcvops.Mult(fPA, dfPA)
 		ratioB := gcvops.Mult(fPA, ddfPA)
-		currentApprox = gcvops.Sub(previousApprox, gcvops.Div(ratioA, gcvops.Sub(gcvops.Pow(dfPA, two), ratioB)))
-	}
+		denominator := gcvops.Sub(gcvops.Pow(dfPA, two), ratioB)
+
+		currentApprox := previousApprox
+		if ratioA.Complex() != 0 {
+			if denominator.Complex() == 0 {
+				return result, result.iterationError("ModifiedNewton1D", ErrDivisionByZero)
+			}
+			currentApprox = gcvops.Sub(previousApprox, gcvops.Div(ratioA, denominator))
+		}
+
+		fCA, errfCA := evalV(f, currentApprox)
+		if errfCA != nil {
+			return result, errfCA
+		}
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, fCA); err != nil {
+			return result, result.iterationError("ModifiedNewton1D", err)
+		}
 
-	if solutionFound {
-		return root, nil
+		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox)).Real() < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
+
+		previousApprox = currentApprox
+		fPA = fCA
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("ModifiedNewton1D", ErrMaxIterations)
 }
 
 // Secant1D is for solving the 1D root finding secant method
 func Secant1D(initialApprox1 float64, initialApprox2 float64, TOL float64, maxIteration int, f *gcf.Function) (gcv.Value, error) {
+	result, err := Secant1DWithHistory(initialApprox1, initialApprox2, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Secant1DWithHistory is Secant1D, also returning the iteration history
+func Secant1DWithHistory(initialApprox1 float64, initialApprox2 float64, TOL float64, maxIteration int, f *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox1 := gcv.MakeValue(initialApprox1)
 	previousApprox2 := gcv.MakeValue(initialApprox2)
 
 	fPA1, errfPA1 := evalV(f, previousApprox1)
 	if errfPA1 != nil {
-		return nil, errfPA1
+		return result, errfPA1
 	}
 
 	fPA2, errfPA2 := evalV(f, previousApprox2)
 	if errfPA2 != nil {
-		return nil, errfPA2
+		return result, errfPA2
 	}
+	result.FunctionEvaluations += 2
 
-	ratioA := gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), gcvops.Sub(fPA2, fPA1))
-	currentApprox := gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
-	var root gcv.Value
-	solutionFound := false
+	if err := result.addIterate(previousApprox1, fPA1); err != nil {
+		return result, result.iterationError("Secant1D", err)
+	}
+
+	if err := result.addIterate(previousApprox2, fPA2); err != nil {
+		return result, result.iterationError("Secant1D", err)
+	}
 
 	for i := 1; i < maxIteration; i++ {
-		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox2)).Real() < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
+		currentApprox := previousApprox2
+		if fPA2.Complex() != 0 {
+			denominator := gcvops.Sub(fPA2, fPA1)
+			if denominator.Complex() == 0 {
+				return result, result.iterationError("Secant1D", ErrDivisionByZero)
+			}
+			ratioA := gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), denominator)
+			currentApprox = gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
 		}
 
-		previousApprox1 = previousApprox2
-		previousApprox2 = currentApprox
-
-		fPA1, errfPA1 = evalV(f, previousApprox1)
-		if errfPA1 != nil {
-			return nil, errfPA1
+		fCA, errfCA := evalV(f, currentApprox)
+		if errfCA != nil {
+			return result, errfCA
 		}
+		result.FunctionEvaluations++
+		result.Iterations++
 
-		fPA2, errfPA2 = evalV(f, previousApprox2)
-		if errfPA2 != nil {
-			return nil, errfPA2
+		if err := result.addIterate(currentApprox, fCA); err != nil {
+			return result, result.iterationError("Secant1D", err)
 		}
 
-		ratioA := gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), gcvops.Sub(fPA2, fPA1))
-		currentApprox = gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
-	}
+		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox2)).Real() < TOL {
+			result.Root = currentApprox
+			return result, nil
+		}
 
-	if solutionFound {
-		return root, nil
+		previousApprox1 = previousApprox2
+		fPA1 = fPA2
+		previousApprox2 = currentApprox
+		fPA2 = fCA
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("Secant1D", ErrMaxIterations)
 }
 
 // FalsePosition1D is for solving the 1D root finding false position method
 func FalsePosition1D(initialApprox1 float64, initialApprox2 float64, TOL float64, maxIteration int, f *gcf.Function) (gcv.Value, error) {
+	result, err := FalsePosition1DWithHistory(initialApprox1, initialApprox2, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// FalsePosition1DWithHistory is FalsePosition1D, also returning the iteration history
+func FalsePosition1DWithHistory(initialApprox1 float64, initialApprox2 float64, TOL float64, maxIteration int, f *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox1 := gcv.MakeValue(initialApprox1)
 	previousApprox2 := gcv.MakeValue(initialApprox2)
 
 	fPA1, errfPA1 := evalV(f, previousApprox1)
 	if errfPA1 != nil {
-		return nil, errfPA1
+		return result, errfPA1
 	}
 
 	fPA2, errfPA2 := evalV(f, previousApprox2)
 	if errfPA2 != nil {
-		return nil, errfPA2
+		return result, errfPA2
 	}
+	result.FunctionEvaluations += 2
 
-	ratioA := gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), gcvops.Sub(fPA2, fPA1))
-	currentApprox := gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
-
-	fCA, errfCA := evalV(f, currentApprox)
-	if errfCA != nil {
-		return nil, errfCA
+	if err := result.addIterate(previousApprox1, fPA1); err != nil {
+		return result, result.iterationError("FalsePosition1D", err)
 	}
 
-	var root gcv.Value
-	solutionFound := false
+	if err := result.addIterate(previousApprox2, fPA2); err != nil {
+		return result, result.iterationError("FalsePosition1D", err)
+	}
 
 	for i := 1; i < maxIteration; i++ {
+		currentApprox := previousApprox2
+		if fPA2.Complex() != 0 {
+			denominator := gcvops.Sub(fPA2, fPA1)
+			if denominator.Complex() == 0 {
+				return result, result.iterationError("FalsePosition1D", ErrDivisionByZero)
+			}
+			ratioA := gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), denominator)
+			currentApprox = gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
+		}
+
+		fCA, errfCA := evalV(f, currentApprox)
+		if errfCA != nil {
+			return result, errfCA
+		}
+		result.FunctionEvaluations++
+		result.Iterations++
+
+		if err := result.addIterate(currentApprox, fCA); err != nil {
+			return result, result.iterationError("FalsePosition1D", err)
+		}
+
 		if gcvops.Abs(gcvops.Sub(currentApprox, previousApprox2)).Real() < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
+			result.Root = currentApprox
+			return result, nil
 		}
 
 		if gcvops.Mult(fCA, fPA2).Real() < 0 {
@@ -284,49 +467,74 @@ func FalsePosition1D(initialApprox1 float64, initialApprox2 float64, TOL float64
 
 		previousApprox2 = currentApprox
 		fPA2 = fCA
-
-		ratioA = gcvops.Div(gcvops.Sub(previousApprox2, previousApprox1), gcvops.Sub(fPA2, fPA1))
-		currentApprox = gcvops.Sub(previousApprox2, gcvops.Mult(fPA2, ratioA))
-
-		fCA, errfCA = evalV(f, currentApprox)
-		if errfCA != nil {
-			return nil, errfCA
-		}
-	}
-
-	if solutionFound {
-		return root, nil
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("FalsePosition1D", ErrMaxIterations)
 }
 
 // Steffensen1D is for solving the 1D root finding Steffensen's mehtod
 func Steffensen1D(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function) (gcv.Value, error) {
+	result, err := Steffensen1DWithHistory(initialApprox, TOL, maxIteration, f)
+	return result.Root, err
+}
+
+// Steffensen1DWithHistory is Steffensen1D, also returning the iteration history
+func Steffensen1DWithHistory(initialApprox float64, TOL float64, maxIteration int, f *gcf.Function) (RootFindingResult, error) {
+	var result RootFindingResult
+
+	if err := validateRootFinding(TOL, maxIteration); err != nil {
+		return result, err
+	}
+
 	previousApprox1 := initialApprox
-	previousApprox2 := f.MustEval(previousApprox1).Value().Real()
-	previousApprox3 := f.MustEval(previousApprox2).Value().Real()
-	currentApprox := previousApprox1 - math.Pow((previousApprox2-previousApprox1), 2)/(previousApprox3-2*previousApprox2+previousApprox1)
-	root := float64(0)
-	solutionFound := false
+	fPA1, errfPA1 := evalV(f, previousApprox1)
+	if errfPA1 != nil {
+		return result, errfPA1
+	}
+	previousApprox2 := fPA1.Real()
+	result.FunctionEvaluations++
+
+	if err := result.addIterate(gcv.MakeValue(previousApprox1), gcv.MakeValue(previousApprox2-previousApprox1)); err != nil {
+		return result, result.iterationError("Steffensen1D", err)
+	}
 
 	for i := 0; i < maxIteration; i++ {
-		if math.Abs(currentApprox-previousApprox1) < TOL {
-			root = currentApprox
-			solutionFound = true
-			break
+		fPA2, errfPA2 := evalV(f, previousApprox2)
+		if errfPA2 != nil {
+			return result, errfPA2
+		}
+		previousApprox3 := fPA2.Real()
+		result.FunctionEvaluations++
+
+		currentApprox := previousApprox1
+		if previousApprox2 != previousApprox1 {
+			denominator := previousApprox3 - 2*previousApprox2 + previousApprox1
+			if denominator == 0 {
+				return result, result.iterationError("Steffensen1D", ErrDivisionByZero)
+			}
+			currentApprox = previousApprox1 - math.Pow((previousApprox2-previousApprox1), 2)/denominator
 		}
 
-		previousApprox1 = currentApprox
-		previousApprox2 = f.MustEval(previousApprox1).Value().Real()
-		previousApprox3 = f.MustEval(previousApprox2).Value().Real()
+		fCA, errfCA := evalV(f, currentApprox)
+		if errfCA != nil {
+			return result, errfCA
+		}
+		fOfCurrentApprox := fCA.Real()
+		result.FunctionEvaluations++
+		result.Iterations++
 
-		currentApprox = previousApprox1 - math.Pow((previousApprox2-previousApprox1), 2)/(previousApprox3-2*previousApprox2+previousApprox1)
-	}
+		if err := result.addIterate(gcv.MakeValue(currentApprox), gcv.MakeValue(fOfCurrentApprox-currentApprox)); err != nil {
+			return result, result.iterationError("Steffensen1D", err)
+		}
 
-	if solutionFound {
-		return gcv.MakeValue(root), nil
+		if math.Abs(currentApprox-previousApprox1) < TOL {
+			result.Root = gcv.MakeValue(currentApprox)
+			return result, nil
+		}
+
+		previousApprox1 = currentApprox
+		previousApprox2 = fOfCurrentApprox
 	}
 
-	return nil, errors.New("Unable to find root of given function")
+	return result, result.iterationError("Steffensen1D", ErrMaxIterations)
 }
@@ -1,6 +1,7 @@
 package methods
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -36,6 +37,31 @@ func TestBisection1D(t *testing.T) {
 	if errC == nil {
 		t.Error("Expected error")
 	}
+
+	_, errD := Bisection1D(1, 2, math.Pow(10, -4), 100, testFunction)
+
+	var bracketErr *BracketError
+	if !errors.As(errD, &bracketErr) || !errors.Is(errD, ErrNotBracketed) {
+		t.Errorf("Expected BracketError, received %v", errD)
+	}
+
+	_, errE := Bisection1D(2, 0, math.Pow(10, -4), 100, testFunction)
+
+	if !errors.Is(errE, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errE)
+	}
+
+	_, errF := Bisection1D(0, 2, 0, 100, testFunction)
+
+	if !errors.Is(errF, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errF)
+	}
+
+	_, errG := Bisection1D(0, 2, math.Pow(10, -4), 0, testFunction)
+
+	if !errors.Is(errG, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errG)
+	}
 }
 
 func TestFixedPointIteration1D(t *testing.T) {
@@ -233,4 +259,102 @@ func TestSteffensen1D(t *testing.T) {
 	if errB == nil {
 		t.Error("Expected error")
 	}
+
+	y := gcfargs.NewVar(gcfargs.Value)
+	testFunctionBad := gcf.MakeFuncPanic([]gcfargs.Var{x, y}, x, "+", y)
+
+	_, errC := Steffensen1D(0.7, math.Pow(10, -4), 5, testFunctionBad)
+
+	if errC == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestNewton1DWithHistory(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	regVars := []gcfargs.Var{x}
+	testFunction := gcf.MakeFuncPanic(regVars, x, "^", 3, "+", 5, "*", x, "^", 2, "+", x, "-", 5)
+	testFunctionD := gcf.MakeFuncPanic(regVars, 3, "*", x, "^", 2, "+", 10, "*", x, "+", 1)
+
+	resultA, errA := Newton1DWithHistory(0.7, math.Pow(10, -8), 10, testFunction, testFunctionD)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if len(resultA.Iterates) != resultA.Iterations+1 || len(resultA.Residuals) != len(resultA.Iterates) ||
+		len(resultA.StepSizes) != resultA.Iterations {
+		t.Errorf("Unexpected history lengths %+v", resultA)
+	}
+
+	if resultA.FunctionEvaluations != 2*resultA.Iterations+1 {
+		t.Errorf("Expected %v function evaluations, received %v", 2*resultA.Iterations+1, resultA.FunctionEvaluations)
+	}
+
+	if order := resultA.ConvergenceOrder(); order < 1.5 || order > 2.5 {
+		t.Errorf("Expected convergence order near 2, received %v", order)
+	}
+
+	_, errB := Newton1DWithHistory(0.7, math.Pow(10, -8), 2, testFunction, testFunctionD)
+
+	if !errors.Is(errB, ErrMaxIterations) {
+		t.Errorf("Expected %v, received %v", ErrMaxIterations, errB)
+	}
+
+	var iterationErr *IterationError
+	if !errors.As(errB, &iterationErr) || iterationErr.Iterations != 2 || iterationErr.Method != "Newton1D" {
+		t.Errorf("Expected IterationError after 2 iterations, received %v", errB)
+	}
+
+	testFunctionDZero := gcf.MakeFuncPanic(regVars, x, "-", x)
+
+	_, errC := Newton1DWithHistory(0.7, math.Pow(10, -8), 10, testFunction, testFunctionDZero)
+
+	if !errors.Is(errC, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errC)
+	}
+}
+
+func TestSecant1DWithHistory(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	regVars := []gcfargs.Var{x}
+	testFunction := gcf.MakeFuncPanic(regVars, x, "^", 3, "+", 5, "*", x, "^", 2, "+", x, "-", 5)
+
+	resultA, errA := Secant1DWithHistory(0.5, 1.5, math.Pow(10, -10), 20, testFunction)
+
+	if errA != nil {
+		t.Errorf("Unexpected error, %v", errA)
+	}
+
+	if order := resultA.ConvergenceOrder(); order < 1.3 || order > 2 {
+		t.Errorf("Expected convergence order near 1.618, received %v", order)
+	}
+
+	if resultA.FunctionEvaluations != resultA.Iterations+2 {
+		t.Errorf("Expected %v function evaluations, received %v", resultA.Iterations+2, resultA.FunctionEvaluations)
+	}
+
+	testFunctionFlat := gcf.MakeFuncPanic(regVars, x, "-", x, "+", 1)
+
+	_, errB := Secant1DWithHistory(0.5, 1.5, math.Pow(10, -10), 20, testFunctionFlat)
+
+	if !errors.Is(errB, ErrDivisionByZero) {
+		t.Errorf("Expected %v, received %v", ErrDivisionByZero, errB)
+	}
+}
+
+func TestFixedPointIteration1DWithHistory(t *testing.T) {
+	x := gcfargs.NewVar(gcfargs.Value)
+	regVars := []gcfargs.Var{x}
+	testFunction := gcf.MakeFuncPanic(regVars, x, "*", x)
+
+	resultA, errA := FixedPointIteration1DWithHistory(10, math.Pow(10, -4), 100, testFunction)
+
+	if !errors.Is(errA, ErrDivergence) {
+		t.Errorf("Expected %v, received %v", ErrDivergence, errA)
+	}
+
+	if resultA.Iterations >= 100 {
+		t.Errorf("Expected divergence to be detected early, received %v iterations", resultA.Iterations)
+	}
 }
@@ -0,0 +1,292 @@
+package methods
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// SVD will return the thin singular value decomposition A = U S V* of the m x n matrix A, where U is m x k and V is n x k
+// with orthonormal columns, k = min(m, n), and the singular values on the diagonal of S are returned in decreasing order,
+// the columns are orthogonalized by one-sided jacobi rotations, which find even the small singular values to high relative
+// accuracy, else error
+// Algorithm from Matrix Computations - By Golub and Van Loan
+func SVD(A m.Matrix) (U m.Matrix, singularValues v.Vector, V m.Matrix, err error) {
+	u, sigma, right, err := singularValueDecomposition(A)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	singularValues = v.NewVector(v.ColSpace, len(sigma))
+	for i, value := range sigma {
+		singularValues.Set(i, value)
+	}
+
+	return fromComplex(u), singularValues, fromComplex(right), nil
+}
+
+// PseudoInverse will return the moore-penrose pseudoinverse V S+ U* of A, where S+ inverts the singular values above
+// the rank tolerance of Rank and leaves the others zero, else error
+func PseudoInverse(A m.Matrix) (m.Matrix, error) {
+	u, sigma, right, err := singularValueDecomposition(A)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := A.Dim()
+	rank := numericalRank(sigma, rows, cols)
+	inverse := make([][]complex128, cols)
+	for i := range inverse {
+		inverse[i] = make([]complex128, rows)
+		for j := range inverse[i] {
+			for k := 0; k < rank; k++ {
+				inverse[i][j] += right[i][k] * cmplx.Conj(u[j][k]) / complex(sigma[k], 0)
+			}
+		}
+	}
+
+	return fromComplex(inverse), nil
+}
+
+// Rank will return the numerical rank of A, the number of singular values larger than max(m, n) times the machine
+// epsilon times the largest singular value, else error
+func Rank(A m.Matrix) (int, error) {
+	_, sigma, _, err := singularValueDecomposition(A)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, cols := A.Dim()
+	return numericalRank(sigma, rows, cols), nil
+}
+
+// ConditionNumber will return the 2-norm condition number of A, the ratio of its largest to its smallest singular value,
+// which is +Inf if A is rank deficient, else error
+func ConditionNumber(A m.Matrix) (float64, error) {
+	_, sigma, _, err := singularValueDecomposition(A)
+	if err != nil {
+		return 0, err
+	}
+
+	if smallest := sigma[len(sigma)-1]; smallest != 0 {
+		return sigma[0] / smallest, nil
+	}
+	return math.Inf(1), nil
+}
+
+// NullSpace will return the n x (n - rank) matrix whose orthonormal columns span the null space of A, else error
+func NullSpace(A m.Matrix) (m.Matrix, error) {
+	_, sigma, right, err := singularValueDecomposition(A)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := A.Dim()
+	rank := numericalRank(sigma, rows, cols)
+
+	// the right singular vectors of the nonzero singular values span the orthogonal complement of the null space
+	basis := make([][]complex128, cols)
+	for i := range basis {
+		basis[i] = append(make([]complex128, 0, cols), right[i][:rank]...)
+	}
+	for len(basis[0]) < cols {
+		basis = appendOrthonormal(basis)
+	}
+
+	null := make([][]complex128, cols)
+	for i := range null {
+		null[i] = basis[i][rank:]
+	}
+
+	return fromComplex(null), nil
+}
+
+// Range will return the m x rank matrix whose orthonormal columns span the range of A, else error
+func Range(A m.Matrix) (m.Matrix, error) {
+	u, sigma, _, err := singularValueDecomposition(A)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := A.Dim()
+	rank := numericalRank(sigma, rows, cols)
+
+	basis := make([][]complex128, rows)
+	for i := range basis {
+		basis[i] = u[i][:rank]
+	}
+
+	return fromComplex(basis), nil
+}
+
+// LowRankApproximation will return the matrix of the given rank nearest to A in the 2-norm and the frobenius norm,
+// the sum of the first rank terms of the singular value decomposition, else error
+func LowRankApproximation(A m.Matrix, rank int) (m.Matrix, error) {
+	u, sigma, right, err := singularValueDecomposition(A)
+	if err != nil {
+		return nil, err
+	}
+
+	if rank < 0 || rank > len(sigma) {
+		return nil, &ArgumentError{Name: "rank", Value: rank, Reason: "must be between 0 and the smaller dimension of A"}
+	}
+
+	rows, cols := A.Dim()
+	approximation := make([][]complex128, rows)
+	for i := range approximation {
+		approximation[i] = make([]complex128, cols)
+		for j := range approximation[i] {
+			for k := 0; k < rank; k++ {
+				approximation[i][j] += u[i][k] * complex(sigma[k], 0) * cmplx.Conj(right[j][k])
+			}
+		}
+	}
+
+	return fromComplex(approximation), nil
+}
+
+// singularValueDecomposition computes the factors of SVD, a wide matrix is decomposed through its conjugate transpose
+func singularValueDecomposition(A m.Matrix) (u [][]complex128, sigma []float64, right [][]complex128, err error) {
+	rows, cols := A.Dim()
+	if rows == 0 || cols == 0 {
+		return nil, nil, nil, &DimensionError{Name: "A", Expected: 1, Received: 0}
+	}
+
+	w := toComplex(A)
+	if rows < cols {
+		w = conjugateTransposeEntries(w)
+	}
+
+	u, sigma, right, err = oneSidedJacobi(w)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if rows < cols {
+		u, right = right, u
+	}
+	return u, sigma, right, nil
+}
+
+// oneSidedJacobi computes the thin singular value decomposition of the tall w, which it overwrites,
+// by rotating pairs of columns until every pair is orthogonal to working precision
+func oneSidedJacobi(w [][]complex128) (u [][]complex128, sigma []float64, right [][]complex128, err error) {
+	rows, cols := len(w), len(w[0])
+	right = identity(cols)
+
+	converged := false
+	for sweep := 0; sweep < 60 && !converged; sweep++ {
+		converged = true
+		for p := 0; p < cols-1; p++ {
+			for q := p + 1; q < cols; q++ {
+				var alpha, beta float64
+				var gamma complex128
+				for i := 0; i < rows; i++ {
+					alpha += real(w[i][p])*real(w[i][p]) + imag(w[i][p])*imag(w[i][p])
+					beta += real(w[i][q])*real(w[i][q]) + imag(w[i][q])*imag(w[i][q])
+					gamma += cmplx.Conj(w[i][p]) * w[i][q]
+				}
+
+				magnitude := cmplx.Abs(gamma)
+				if magnitude == 0 || magnitude <= machineEpsilon*math.Sqrt(alpha*beta) {
+					continue
+				}
+				converged = false
+
+				// the phase turns the inner product real so that a real rotation orthogonalizes the pair
+				phase := cmplx.Conj(gamma) / complex(magnitude, 0)
+				zeta := (beta - alpha) / (2 * magnitude)
+				sign := 1.0
+				if zeta < 0 {
+					sign = -1
+				}
+				t := sign / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				s := c * t
+
+				rotatePair(w, p, q, c, s, phase)
+				rotatePair(right, p, q, c, s, phase)
+			}
+		}
+	}
+
+	if !converged {
+		return nil, nil, nil, &IterationError{Method: "SVD", Iterations: 60, Err: ErrMaxIterations}
+	}
+
+	sigma = make([]float64, cols)
+	order := make([]int, cols)
+	for j := range sigma {
+		sigma[j] = columnNorm(w, j, 0, rows)
+		order[j] = j
+	}
+	sort.SliceStable(order, func(i, j int) bool { return sigma[order[i]] > sigma[order[j]] })
+
+	sorted := make([]float64, cols)
+	u = make([][]complex128, rows)
+	for i := range u {
+		u[i] = make([]complex128, 0, cols)
+	}
+	rightSorted := make([][]complex128, cols)
+	for i := range rightSorted {
+		rightSorted[i] = make([]complex128, cols)
+	}
+
+	for j, k := range order {
+		sorted[j] = sigma[k]
+		for i := range rightSorted {
+			rightSorted[i][j] = right[i][k]
+		}
+
+		// a zero column leaves the left singular vector free, it is any unit vector orthogonal to the previous ones
+		if sigma[k] == 0 {
+			u = appendOrthonormal(u)
+			continue
+		}
+		for i := range u {
+			u[i] = append(u[i], w[i][k]/complex(sigma[k], 0))
+		}
+	}
+
+	return u, sorted, rightSorted, nil
+}
+
+// rotatePair multiplies columns p and q of a from the right by diag(1, phase) times the rotation [c s; -s c]
+func rotatePair(a [][]complex128, p, q int, c, s float64, phase complex128) {
+	for i := range a {
+		x, y := a[i][p], phase*a[i][q]
+		a[i][p] = complex(c, 0)*x - complex(s, 0)*y
+		a[i][q] = complex(s, 0)*x + complex(c, 0)*y
+	}
+}
+
+// numericalRank returns the number of singular values, in decreasing order, above max(rows, cols) times the machine
+// epsilon times the largest
+func numericalRank(sigma []float64, rows, cols int) int {
+	largest := rows
+	if cols > largest {
+		largest = cols
+	}
+
+	TOL := float64(largest) * machineEpsilon * sigma[0]
+	rank := 0
+	for rank < len(sigma) && sigma[rank] > TOL {
+		rank++
+	}
+	return rank
+}
+
+// conjugateTransposeEntries returns the conjugate transpose of the entries a
+func conjugateTransposeEntries(a [][]complex128) [][]complex128 {
+	transpose := make([][]complex128, len(a[0]))
+	for j := range transpose {
+		transpose[j] = make([]complex128, len(a))
+		for i := range a {
+			transpose[j][i] = cmplx.Conj(a[i][j])
+		}
+	}
+	return transpose
+}
@@ -0,0 +1,269 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	mops "github.com/NumberXNumbers/types/gc/matrices/ops"
+)
+
+// diagonal returns the rows x cols matrix with the given values on its diagonal
+func diagonal(rows, cols int, values []float64) m.Matrix {
+	D := m.NewMatrix(rows, cols)
+	for i, value := range values {
+		D.Set(i, i, value)
+	}
+	return D
+}
+
+func TestSVD(t *testing.T) {
+	examples := map[string][][]complex128{
+		"tall":    {{3, 2, 2}, {2, 3, -2}, {1, 0, 4}, {0, 1, 1}},
+		"wide":    {{3, 2, 2}, {2, 3, -2}},
+		"complex": {{1 + 1i, 2, 0}, {-1i, 1, 3 - 2i}, {0.5, 2i, 1}},
+		// the third column is the sum of the first two
+		"deficient": {{1, 2, 3}, {4, 5, 9}, {7, 8, 15}, {1, 0, 1}},
+	}
+
+	for name, example := range examples {
+		A := fromComplex(example)
+		rows, cols := A.Dim()
+		size := rows
+		if cols < size {
+			size = cols
+		}
+
+		U, singularValues, V, err := SVD(A)
+
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", name, err)
+		}
+
+		if singularValues.Len() != size {
+			t.Errorf("%v: expected %d singular values, received %v", name, size, singularValues)
+		}
+
+		values := make([]float64, size)
+		for i := range values {
+			values[i] = singularValues.Get(i).Real()
+			if values[i] < 0 || (i > 0 && values[i] > values[i-1]) {
+				t.Errorf("%v: expected decreasing non-negative singular values, received %v", name, singularValues)
+			}
+		}
+
+		identity := [][]complex128{{1}, {0, 1}, {0, 0, 1}}[:size]
+		if product := mops.MustMultSimple(conjugateTranspose(U), U); !matrixApproxEqual(identity, product, 1e-12) {
+			t.Errorf("%v: expected orthonormal columns of U, received %v", name, entries(product))
+		}
+
+		if product := mops.MustMultSimple(conjugateTranspose(V), V); !matrixApproxEqual(identity, product, 1e-12) {
+			t.Errorf("%v: expected orthonormal columns of V, received %v", name, entries(product))
+		}
+
+		product := mops.MustMultSimple(mops.MustMultSimple(U, diagonal(size, size, values)), conjugateTranspose(V))
+		if !matrixApproxEqual(example, product, 1e-12) {
+			t.Errorf("%v: expected U S V* = %v, received %v", name, example, entries(product))
+		}
+	}
+
+	// the singular values of [[3 2 2] [2 3 -2]] are 5 and 3
+	_, singularValues, _, _ := SVD(fromComplex([][]complex128{{3, 2, 2}, {2, 3, -2}}))
+
+	if !vectorApproxEqual([]complex128{5, 3}, singularValues, 1e-12) {
+		t.Errorf("Expected [5 3], received %v", singularValues)
+	}
+
+	// a zero matrix has a zero singular value for every column
+	U, zeroValues, _, _ := SVD(m.NewMatrix(3, 2))
+
+	if !vectorApproxEqual([]complex128{0, 0}, zeroValues, 0) {
+		t.Errorf("Expected [0 0], received %v", zeroValues)
+	}
+
+	if product := mops.MustMultSimple(conjugateTranspose(U), U); !matrixApproxEqual([][]complex128{{1}, {0, 1}}, product, 1e-12) {
+		t.Errorf("Expected orthonormal columns of U, received %v", entries(product))
+	}
+
+	_, _, _, errA := SVD(m.NewMatrix(0, 2))
+
+	if !errors.Is(errA, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, errA)
+	}
+}
+
+func TestPseudoInverse(t *testing.T) {
+	// a matrix of full column rank has the left inverse (A* A)^-1 A*
+	A := fromComplex([][]complex128{{1, 0}, {1, 1}, {1, 2}})
+
+	inverse, errA := PseudoInverse(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	expected := [][]complex128{{5.0 / 6, 1.0 / 3, -1.0 / 6}, {-0.5, 0, 0.5}}
+	if !matrixApproxEqual(expected, inverse, 1e-12) {
+		t.Errorf("Expected %v, received %v", expected, entries(inverse))
+	}
+
+	// the moore-penrose conditions hold for a rank deficient complex matrix
+	B := fromComplex([][]complex128{{1, 1i, 2}, {2, 2i, 4}, {1i, -1, 2i}})
+	pseudo, errB := PseudoInverse(B)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if product := mops.MustMultSimple(mops.MustMultSimple(B, pseudo), B); !matrixApproxEqual(entries(B), product, 1e-12) {
+		t.Errorf("Expected B B+ B = B, received %v", entries(product))
+	}
+
+	if product := mops.MustMultSimple(mops.MustMultSimple(pseudo, B), pseudo); !matrixApproxEqual(entries(pseudo), product, 1e-12) {
+		t.Errorf("Expected B+ B B+ = B+, received %v", entries(product))
+	}
+
+	projection := mops.MustMultSimple(B, pseudo)
+	if !matrixApproxEqual(entries(conjugateTranspose(projection)), projection, 1e-12) {
+		t.Errorf("Expected B B+ to be hermitian, received %v", entries(projection))
+	}
+
+	// the pseudoinverse of a zero matrix is its transpose
+	if zero, err := PseudoInverse(m.NewMatrix(2, 3)); err != nil || !matrixApproxEqual([][]complex128{{0, 0}, {0, 0}, {0, 0}}, zero, 0) {
+		t.Errorf("Expected a 3 x 2 zero matrix, received %v and %v", zero, err)
+	}
+}
+
+func TestRank(t *testing.T) {
+	if rank, err := Rank(fromComplex([][]complex128{{1, 2, 3}, {4, 5, 9}, {7, 8, 15}, {1, 0, 1}})); err != nil || rank != 2 {
+		t.Errorf("Expected 2, received %v and %v", rank, err)
+	}
+
+	if rank, err := Rank(fromComplex([][]complex128{{1, 1i}, {1i, -1}})); err != nil || rank != 1 {
+		t.Errorf("Expected 1, received %v and %v", rank, err)
+	}
+
+	if rank, err := Rank(m.NewIdentityMatrix(4)); err != nil || rank != 4 {
+		t.Errorf("Expected 4, received %v and %v", rank, err)
+	}
+
+	if rank, err := Rank(m.NewMatrix(2, 2)); err != nil || rank != 0 {
+		t.Errorf("Expected 0, received %v and %v", rank, err)
+	}
+}
+
+func TestConditionNumber(t *testing.T) {
+	if condition, err := ConditionNumber(fromComplex([][]complex128{{3, 2, 2}, {2, 3, -2}})); err != nil || math.Abs(condition-5.0/3) > 1e-12 {
+		t.Errorf("Expected %v, received %v and %v", 5.0/3, condition, err)
+	}
+
+	if condition, err := ConditionNumber(fromComplex([][]complex128{{1, 2}, {2, 4}})); err != nil || !math.IsInf(condition, 1) {
+		t.Errorf("Expected +Inf, received %v and %v", condition, err)
+	}
+
+	// the condition number of the hilbert matrix of order 4 is about 15514
+	hilbert := m.NewMatrix(4, 4)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			hilbert.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+
+	if condition, err := ConditionNumber(hilbert); err != nil || math.Abs(condition-15513.738738929)/15513.738738929 > 1e-9 {
+		t.Errorf("Expected 15513.738738929, received %v and %v", condition, err)
+	}
+}
+
+func TestNullSpace(t *testing.T) {
+	A := fromComplex([][]complex128{{1, 2, 3}, {4, 5, 9}, {7, 8, 15}, {1, 0, 1}})
+
+	null, errA := NullSpace(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if rows, cols := null.Dim(); rows != 3 || cols != 1 {
+		t.Errorf("Expected a 3 x 1 basis, received %v", entries(null))
+	}
+
+	if product := mops.MustMultSimple(A, null); !matrixApproxEqual([][]complex128{{0}, {0}, {0}, {0}}, product, 1e-12) {
+		t.Errorf("Expected A N = 0, received %v", entries(product))
+	}
+
+	// a wide matrix has a null space of dimension at least n - m
+	wide := fromComplex([][]complex128{{1, 1i, 0, 2}, {0, 1, 1, -1i}})
+	wideNull, errB := NullSpace(wide)
+
+	if errB != nil {
+		t.Errorf("Unexpected error: %v", errB)
+	}
+
+	if rows, cols := wideNull.Dim(); rows != 4 || cols != 2 {
+		t.Errorf("Expected a 4 x 2 basis, received %v", entries(wideNull))
+	}
+
+	if product := mops.MustMultSimple(wide, wideNull); !matrixApproxEqual([][]complex128{{0, 0}, {0, 0}}, product, 1e-12) {
+		t.Errorf("Expected A N = 0, received %v", entries(product))
+	}
+
+	if product := mops.MustMultSimple(conjugateTranspose(wideNull), wideNull); !matrixApproxEqual([][]complex128{{1}, {0, 1}}, product, 1e-12) {
+		t.Errorf("Expected orthonormal columns, received %v", entries(product))
+	}
+
+	if empty, err := NullSpace(m.NewIdentityMatrix(3)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	} else if _, cols := empty.Dim(); cols != 0 {
+		t.Errorf("Expected an empty basis, received %v", entries(empty))
+	}
+}
+
+func TestRange(t *testing.T) {
+	A := fromComplex([][]complex128{{1, 2, 3}, {4, 5, 9}, {7, 8, 15}, {1, 0, 1}})
+
+	basis, errA := Range(A)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if rows, cols := basis.Dim(); rows != 4 || cols != 2 {
+		t.Errorf("Expected a 4 x 2 basis, received %v", entries(basis))
+	}
+
+	// projecting onto the range leaves the columns of A unchanged
+	projection := mops.MustMultSimple(basis, conjugateTranspose(basis))
+	if product := mops.MustMultSimple(projection, A); !matrixApproxEqual(entries(A), product, 1e-12) {
+		t.Errorf("Expected P A = A, received %v", entries(product))
+	}
+}
+
+func TestLowRankApproximation(t *testing.T) {
+	A := fromComplex([][]complex128{{3, 2, 2}, {2, 3, -2}})
+
+	// the best rank one approximation keeps the singular value 5 with u = (1, 1) / sqrt(2) and v = (1, 1, 0) / sqrt(2)
+	approximation, errA := LowRankApproximation(A, 1)
+
+	if errA != nil {
+		t.Errorf("Unexpected error: %v", errA)
+	}
+
+	if !matrixApproxEqual([][]complex128{{2.5, 2.5, 0}, {2.5, 2.5, 0}}, approximation, 1e-12) {
+		t.Errorf("Expected [[2.5 2.5 0] [2.5 2.5 0]], received %v", entries(approximation))
+	}
+
+	if full, err := LowRankApproximation(A, 2); err != nil || !matrixApproxEqual(entries(A), full, 1e-12) {
+		t.Errorf("Expected %v, received %v and %v", entries(A), full, err)
+	}
+
+	if zero, err := LowRankApproximation(A, 0); err != nil || !matrixApproxEqual([][]complex128{{0, 0, 0}, {0, 0, 0}}, zero, 0) {
+		t.Errorf("Expected a zero matrix, received %v and %v", zero, err)
+	}
+
+	_, errB := LowRankApproximation(A, 3)
+
+	if !errors.Is(errB, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, errB)
+	}
+}
@@ -0,0 +1,209 @@
+package methods
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	m "github.com/NumberXNumbers/types/gc/matrices"
+	gcv "github.com/NumberXNumbers/types/gc/values"
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+// validateInterval checks that a and b are finite and that a is less than b
+func validateInterval(a float64, b float64) error {
+	if math.IsNaN(a) || math.IsInf(a, 0) {
+		return &ArgumentError{Name: "a", Value: a, Reason: "must be finite"}
+	}
+
+	if math.IsNaN(b) || math.IsInf(b, 0) {
+		return &ArgumentError{Name: "b", Value: b, Reason: "must be finite"}
+	}
+
+	if a >= b {
+		return &ArgumentError{Name: "b", Value: b, Reason: fmt.Sprintf("must be greater than a = %v", a)}
+	}
+
+	return nil
+}
+
+// validateSteps checks that the number of steps N is at least minimum
+func validateSteps(N int, minimum int) error {
+	if N < minimum {
+		return &ArgumentError{Name: "N", Value: N, Reason: fmt.Sprintf("must be at least %d", minimum)}
+	}
+
+	return nil
+}
+
+// validateTolerance checks that TOL is a positive number
+func validateTolerance(TOL float64) error {
+	if !(TOL > 0) {
+		return &ArgumentError{Name: "TOL", Value: TOL, Reason: "must be positive"}
+	}
+
+	return nil
+}
+
+// validateMaxIteration checks that at least one iteration is allowed
+func validateMaxIteration(maxIteration int) error {
+	if maxIteration <= 0 {
+		return &ArgumentError{Name: "maxIteration", Value: maxIteration, Reason: "must be positive"}
+	}
+
+	return nil
+}
+
+// validateStepBounds checks that minStep is positive and not greater than maxStep
+func validateStepBounds(minStep float64, maxStep float64) error {
+	if !(minStep > 0) {
+		return &ArgumentError{Name: "minStep", Value: minStep, Reason: "must be positive"}
+	}
+
+	if !(maxStep >= minStep) || math.IsInf(maxStep, 0) {
+		return &ArgumentError{Name: "maxStep", Value: maxStep, Reason: fmt.Sprintf("must be finite and at least minStep = %v", minStep)}
+	}
+
+	return nil
+}
+
+// validateAdaptive checks the arguments shared by the adaptive step size integrators
+func validateAdaptive(a float64, b float64, TOL float64, maxStep float64, minStep float64) error {
+	if err := validateInterval(a, b); err != nil {
+		return err
+	}
+
+	if err := validateTolerance(TOL); err != nil {
+		return err
+	}
+
+	return validateStepBounds(minStep, maxStep)
+}
+
+// validateRootFinding checks the tolerance and iteration limit shared by the root finding methods
+func validateRootFinding(TOL float64, maxIteration int) error {
+	if err := validateTolerance(TOL); err != nil {
+		return err
+	}
+
+	return validateMaxIteration(maxIteration)
+}
+
+// validateSamples checks that there are at least minimum interpolation nodes with a function value each,
+// that no function value is NaN and that the nodes are finite and distinct,
+// or real and strictly increasing if sorted is set
+func validateSamples(xValues v.Vector, functionValues v.Vector, minimum int, sorted bool) error {
+	size := xValues.Len()
+
+	if size < minimum {
+		return &DimensionError{Name: "xValues", Expected: minimum, Received: size}
+	}
+
+	if err := validateValues("functionValues", functionValues, size); err != nil {
+		return err
+	}
+
+	seen := make(map[complex128]int, size)
+	for i := 0; i < size; i++ {
+		x := xValues.Get(i).Complex()
+		if cmplx.IsNaN(x) || cmplx.IsInf(x) {
+			return &ArgumentError{Name: fmt.Sprintf("xValues[%d]", i), Value: x, Reason: "must be finite"}
+		}
+
+		if sorted && imag(x) != 0 {
+			return &ArgumentError{Name: fmt.Sprintf("xValues[%d]", i), Value: x, Reason: "must be real"}
+		}
+
+		if j, ok := seen[x]; ok {
+			return &NodeError{Name: "xValues", Index: i, Value: xValues.Get(i), Previous: j, Err: ErrDuplicateNodes}
+		}
+		seen[x] = i
+
+		if sorted && i > 0 && real(x) < xValues.Get(i-1).Real() {
+			return &NodeError{Name: "xValues", Index: i, Value: xValues.Get(i), Previous: i - 1, Err: ErrUnsortedNodes}
+		}
+	}
+
+	return nil
+}
+
+// validateValues checks that values has size entries and that none of them is NaN
+func validateValues(name string, values v.Vector, size int) error {
+	if values.Len() != size {
+		return &DimensionError{Name: name, Expected: size, Received: values.Len()}
+	}
+
+	for i := 0; i < size; i++ {
+		if cmplx.IsNaN(values.Get(i).Complex()) {
+			return &ArgumentError{Name: fmt.Sprintf("%s[%d]", name, i), Value: values.Get(i), Reason: "must not be NaN"}
+		}
+	}
+
+	return nil
+}
+
+// validateHermitian checks that A is square and equal to its conjugate transpose
+func validateHermitian(A m.Matrix) error {
+	if !A.IsSquare() {
+		return ErrNotSquare
+	}
+	degree, _ := A.Dim()
+	for i := 0; i < degree; i++ {
+		for j := i; j < degree; j++ {
+			if A.Get(i, j).Complex() != cmplx.Conj(A.Get(j, i).Complex()) {
+				return &HermitianError{Row: i, Col: j}
+			}
+		}
+	}
+	return nil
+}
+
+// validateDefinitePivot checks that the pivot of a hermitian factorization is positive, a zero pivot is singular
+func validateDefinitePivot(pivot gcv.Value, i int) error {
+	if pivot.Real() == 0 {
+		return &SingularError{Pivot: i}
+	}
+	if pivot.Real() < 0 {
+		return &DefiniteError{Pivot: i, Value: pivot.Real()}
+	}
+	return nil
+}
+
+// validateLinearSystem checks that A is square and that b and the initial guess x0, unless it is nil, match its size
+func validateLinearSystem(A m.Matrix, b v.Vector, x0 v.Vector) error {
+	if !A.IsSquare() {
+		return ErrNotSquare
+	}
+
+	degree, _ := A.Dim()
+	if b.Len() != degree {
+		return &DimensionError{Name: "b", Expected: degree, Received: b.Len()}
+	}
+
+	if x0 != nil && x0.Len() != degree {
+		return &DimensionError{Name: "x0", Expected: degree, Received: x0.Len()}
+	}
+
+	return nil
+}
+
+// validateDiagonal checks that no diagonal entry of the square matrix A is zero
+func validateDiagonal(A m.Matrix) error {
+	degree, _ := A.Dim()
+	for i := 0; i < degree; i++ {
+		if A.Get(i, i).Complex() == 0 {
+			return &ArgumentError{Name: fmt.Sprintf("A(%d, %d)", i, i), Value: 0, Reason: "must not be zero on the diagonal"}
+		}
+	}
+
+	return nil
+}
+
+// validateRelaxation checks that the relaxation factor omega is between 0 and 2
+func validateRelaxation(omega float64) error {
+	if !(omega > 0 && omega < 2) {
+		return &ArgumentError{Name: "omega", Value: omega, Reason: "must be between 0 and 2"}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package methods
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	v "github.com/NumberXNumbers/types/gc/vectors"
+)
+
+func TestValidateInterval(t *testing.T) {
+	if err := validateInterval(0, 1); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateInterval(1, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval(2, 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval(math.NaN(), 1); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateInterval(0, math.Inf(1)); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateSteps(t *testing.T) {
+	if err := validateSteps(3, 3); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateSteps(2, 3); !errors.As(err, &argumentErr) || argumentErr.Name != "N" {
+		t.Errorf("Expected ArgumentError for N, received %v", err)
+	}
+}
+
+func TestValidateTolerance(t *testing.T) {
+	if err := validateTolerance(1e-5); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateTolerance(0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateTolerance(math.NaN()); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateMaxIteration(t *testing.T) {
+	if err := validateMaxIteration(1); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateMaxIteration(0); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+}
+
+func TestValidateStepBounds(t *testing.T) {
+	if err := validateStepBounds(0.01, 0.01); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateStepBounds(0, 0.25); !errors.As(err, &argumentErr) || argumentErr.Name != "minStep" {
+		t.Errorf("Expected ArgumentError for minStep, received %v", err)
+	}
+
+	if err := validateStepBounds(0.5, 0.25); !errors.As(err, &argumentErr) || argumentErr.Name != "maxStep" {
+		t.Errorf("Expected ArgumentError for maxStep, received %v", err)
+	}
+}
+
+func TestValidateSamples(t *testing.T) {
+	functionValues := v.MakeVector(v.RowSpace, 1, 2, 3)
+
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 2, 1i), functionValues, 1, false); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 2, 1), functionValues, 2, true); !errors.Is(err, ErrUnsortedNodes) {
+		t.Errorf("Expected %v, received %v", ErrUnsortedNodes, err)
+	}
+
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 1, 0), functionValues, 1, false); !errors.Is(err, ErrDuplicateNodes) {
+		t.Errorf("Expected %v, received %v", ErrDuplicateNodes, err)
+	}
+
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 1i, 2), functionValues, 2, true); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected %v, received %v", ErrInvalidArgument, err)
+	}
+
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 1), functionValues, 1, false); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Expected %v, received %v", ErrDimensionMismatch, err)
+	}
+
+	var argumentErr *ArgumentError
+	if err := validateSamples(v.MakeVector(v.RowSpace, 0, 1, 2), v.MakeVector(v.RowSpace, 1, math.NaN(), 3), 1, false); !errors.As(err, &argumentErr) || argumentErr.Name != "functionValues[1]" {
+		t.Errorf("Expected ArgumentError for functionValues[1], received %v", err)
+	}
+}